@@ -0,0 +1,101 @@
+package aws
+
+import (
+	"net/netip"
+)
+
+// cidrPrefixTrie is a binary trie of IP prefixes, used to find overlapping
+// CIDR blocks without comparing every pair. IPv4 and IPv6 prefixes are kept in
+// separate trees since they can never overlap each other.
+type cidrPrefixTrie struct {
+	v4 *cidrPrefixTrieNode
+	v6 *cidrPrefixTrieNode
+}
+
+type cidrPrefixTrieNode struct {
+	children [2]*cidrPrefixTrieNode
+	// Values stored against the prefix ending at this node
+	values []int
+}
+
+func newCidrPrefixTrie() *cidrPrefixTrie {
+	return &cidrPrefixTrie{
+		v4: &cidrPrefixTrieNode{},
+		v6: &cidrPrefixTrieNode{},
+	}
+}
+
+func (t *cidrPrefixTrie) root(prefix netip.Prefix) *cidrPrefixTrieNode {
+	if prefix.Addr().Is4() {
+		return t.v4
+	}
+	return t.v6
+}
+
+// Insert stores value against the prefix. The prefix is masked first, so
+// 10.0.0.1/16 and 10.0.0.0/16 are treated as the same block.
+func (t *cidrPrefixTrie) Insert(prefix netip.Prefix, value int) {
+	prefix = prefix.Masked()
+	node := t.root(prefix)
+	addr := prefix.Addr().AsSlice()
+	for i := 0; i < prefix.Bits(); i++ {
+		bit := prefixBit(addr, i)
+		if node.children[bit] == nil {
+			node.children[bit] = &cidrPrefixTrieNode{}
+		}
+		node = node.children[bit]
+	}
+	node.values = append(node.values, value)
+}
+
+// Overlapping returns the values of every stored prefix that overlaps the
+// given prefix, i.e. every prefix that contains it or is contained by it.
+func (t *cidrPrefixTrie) Overlapping(prefix netip.Prefix) []int {
+	prefix = prefix.Masked()
+	node := t.root(prefix)
+	addr := prefix.Addr().AsSlice()
+
+	var values []int
+	// Walk down the path of the prefix, collecting the blocks that contain it
+	for i := 0; i < prefix.Bits(); i++ {
+		values = append(values, node.values...)
+		node = node.children[prefixBit(addr, i)]
+		if node == nil {
+			return values
+		}
+	}
+
+	// Everything at or below the final node is contained by the prefix
+	stack := []*cidrPrefixTrieNode{node}
+	for len(stack) > 0 {
+		n := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		values = append(values, n.values...)
+		for _, child := range n.children {
+			if child != nil {
+				stack = append(stack, child)
+			}
+		}
+	}
+
+	return values
+}
+
+// prefixBit returns the i-th most significant bit of the address.
+func prefixBit(addr []byte, i int) int {
+	return int(addr[i/8]>>(7-uint(i%8))) & 1
+}
+
+// cidrOverlap returns the intersection of two overlapping prefixes, which is
+// always the more specific of the two. The second return value is false if
+// the prefixes do not overlap.
+func cidrOverlap(a, b netip.Prefix) (netip.Prefix, bool) {
+	a, b = a.Masked(), b.Masked()
+	if !a.Overlaps(b) {
+		return netip.Prefix{}, false
+	}
+	if a.Bits() >= b.Bits() {
+		return a, true
+	}
+	return b, true
+}
@@ -0,0 +1,67 @@
+package aws
+
+import (
+	"net/netip"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestCidrPrefixTrieOverlapping(t *testing.T) {
+	blocks := []string{
+		"10.0.0.0/16",
+		"10.0.1.0/24",
+		"10.1.0.0/16",
+		"172.16.0.0/12",
+		"2600:1f18:1234::/56",
+	}
+
+	trie := newCidrPrefixTrie()
+	for i, block := range blocks {
+		trie.Insert(netip.MustParsePrefix(block), i)
+	}
+
+	testCases := []struct {
+		name     string
+		prefix   string
+		expected []int
+	}{
+		{"contained by one block", "10.0.2.0/24", []int{0}},
+		{"contained by two blocks", "10.0.1.128/25", []int{0, 1}},
+		{"contains several blocks", "10.0.0.0/8", []int{0, 1, 2}},
+		{"exact match", "10.1.0.0/16", []int{2}},
+		{"unmasked prefix", "172.20.5.9/16", []int{3}},
+		{"no overlap", "192.168.0.0/16", nil},
+		{"ipv6 contained", "2600:1f18:1234:10::/64", []int{4}},
+		{"ipv6 does not match ipv4", "::/0", []int{4}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := trie.Overlapping(netip.MustParsePrefix(tc.prefix))
+			sort.Ints(got)
+			if !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("Overlapping(%q) = %v, want %v", tc.prefix, got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestCidrOverlap(t *testing.T) {
+	testCases := []struct {
+		a, b     string
+		expected string
+		ok       bool
+	}{
+		{"10.0.0.0/16", "10.0.128.0/17", "10.0.128.0/17", true},
+		{"10.0.128.0/17", "10.0.0.0/16", "10.0.128.0/17", true},
+		{"10.0.0.0/16", "10.1.0.0/16", "", false},
+	}
+
+	for _, tc := range testCases {
+		got, ok := cidrOverlap(netip.MustParsePrefix(tc.a), netip.MustParsePrefix(tc.b))
+		if ok != tc.ok || (ok && got.String() != tc.expected) {
+			t.Errorf("cidrOverlap(%q, %q) = %v, %v, want %q, %v", tc.a, tc.b, got, ok, tc.expected, tc.ok)
+		}
+	}
+}
@@ -8,18 +8,23 @@ import (
 )
 
 type awsConfig struct {
-	Regions               []string `hcl:"regions,optional"`
-	DefaultRegion         *string  `hcl:"default_region"`
-	Profile               *string  `hcl:"profile"`
-	AccessKey             *string  `hcl:"access_key"`
-	SecretKey             *string  `hcl:"secret_key"`
-	SessionToken          *string  `hcl:"session_token"`
-	MaxErrorRetryAttempts *int     `hcl:"max_error_retry_attempts"`
-	MinErrorRetryDelay    *int     `hcl:"min_error_retry_delay"`
-	IgnoreErrorMessages   []string `hcl:"ignore_error_messages,optional"`
-	IgnoreErrorCodes      []string `hcl:"ignore_error_codes,optional"`
-	EndpointUrl           *string  `hcl:"endpoint_url"`
-	S3ForcePathStyle      *bool    `hcl:"s3_force_path_style"`
+	Regions                           []string `hcl:"regions,optional"`
+	DefaultRegion                     *string  `hcl:"default_region"`
+	Profile                           *string  `hcl:"profile"`
+	AccessKey                         *string  `hcl:"access_key"`
+	SecretKey                         *string  `hcl:"secret_key"`
+	SessionToken                      *string  `hcl:"session_token"`
+	MaxErrorRetryAttempts             *int     `hcl:"max_error_retry_attempts"`
+	MinErrorRetryDelay                *int     `hcl:"min_error_retry_delay"`
+	IgnoreErrorMessages               []string `hcl:"ignore_error_messages,optional"`
+	IgnoreErrorCodes                  []string `hcl:"ignore_error_codes,optional"`
+	EndpointUrl                       *string  `hcl:"endpoint_url"`
+	S3ForcePathStyle                  *bool    `hcl:"s3_force_path_style"`
+	Inspector2SbomBucket              *string  `hcl:"inspector2_sbom_bucket"`
+	Inspector2SbomKmsKeyArn           *string  `hcl:"inspector2_sbom_kms_key_arn"`
+	Inspector2SbomKeyPrefix           *string  `hcl:"inspector2_sbom_key_prefix"`
+	SecretsManagerIncludeSecretValues *bool    `hcl:"secretsmanager_include_secret_values"`
+	AcmPcaAuditReportBucket           *string  `hcl:"acmpca_audit_report_bucket"`
 }
 
 func ConfigInstance() interface{} {
@@ -0,0 +1,279 @@
+package aws
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+)
+
+// Inspector2SbomPackage is a software package of a resource, as listed in an
+// SBOM exported by Amazon Inspector
+type Inspector2SbomPackage struct {
+	ReportId          string
+	ReportFormat      string
+	S3Key             string
+	ResourceId        string
+	ResourceType      string
+	ResourceAccountId string
+	Name              string
+	Version           string
+	Purl              string
+	PackageType       string
+	Licenses          []string
+	VulnerabilityIds  []string
+}
+
+// inspector2SbomResource is the resource an SBOM file describes
+type inspector2SbomResource struct {
+	Id, Type, AccountId string
+}
+
+type cycloneDxBom struct {
+	BomFormat string `json:"bomFormat"`
+	Metadata  struct {
+		Component  cycloneDxComponent  `json:"component"`
+		Properties []cycloneDxProperty `json:"properties"`
+	} `json:"metadata"`
+	Components      []cycloneDxComponent `json:"components"`
+	Vulnerabilities []struct {
+		Id      string `json:"id"`
+		Affects []struct {
+			Ref string `json:"ref"`
+		} `json:"affects"`
+	} `json:"vulnerabilities"`
+}
+
+type cycloneDxComponent struct {
+	BomRef   string `json:"bom-ref"`
+	Type     string `json:"type"`
+	Name     string `json:"name"`
+	Version  string `json:"version"`
+	Purl     string `json:"purl"`
+	Licenses []struct {
+		License struct {
+			Id   string `json:"id"`
+			Name string `json:"name"`
+		} `json:"license"`
+		Expression string `json:"expression"`
+	} `json:"licenses"`
+	Properties []cycloneDxProperty  `json:"properties"`
+	Components []cycloneDxComponent `json:"components"`
+}
+
+type cycloneDxProperty struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type spdxDocument struct {
+	SpdxVersion string `json:"spdxVersion"`
+	Name        string `json:"name"`
+	Packages    []struct {
+		SpdxId           string `json:"SPDXID"`
+		Name             string `json:"name"`
+		VersionInfo      string `json:"versionInfo"`
+		LicenseConcluded string `json:"licenseConcluded"`
+		LicenseDeclared  string `json:"licenseDeclared"`
+		ExternalRefs     []struct {
+			ReferenceCategory string `json:"referenceCategory"`
+			ReferenceType     string `json:"referenceType"`
+			ReferenceLocator  string `json:"referenceLocator"`
+		} `json:"externalRefs"`
+	} `json:"packages"`
+}
+
+// parseInspector2Sbom parses one SBOM file of an Amazon Inspector export into
+// its packages. The format is detected from the document, so both CycloneDX
+// and SPDX files can be passed.
+func parseInspector2Sbom(key string, content []byte) ([]Inspector2SbomPackage, error) {
+	var probe struct {
+		BomFormat   string `json:"bomFormat"`
+		SpdxVersion string `json:"spdxVersion"`
+	}
+	if err := json.Unmarshal(content, &probe); err != nil {
+		return nil, fmt.Errorf("failed to parse SBOM %s: %v", key, err)
+	}
+
+	var packages []Inspector2SbomPackage
+	var err error
+	resource := inspector2SbomResourceFromKey(key)
+	switch {
+	case probe.BomFormat == "CycloneDX":
+		packages, err = parseInspector2CycloneDx(key, content, resource)
+	case probe.SpdxVersion != "":
+		packages, err = parseInspector2Spdx(key, content, resource)
+	default:
+		return nil, fmt.Errorf("unsupported SBOM format in %s", key)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range packages {
+		packages[i].ResourceType = inspector2SbomResourceType(packages[i].ResourceType)
+	}
+	return packages, nil
+}
+
+func parseInspector2CycloneDx(key string, content []byte, resource inspector2SbomResource) ([]Inspector2SbomPackage, error) {
+	var bom cycloneDxBom
+	if err := json.Unmarshal(content, &bom); err != nil {
+		return nil, fmt.Errorf("failed to parse CycloneDX SBOM %s: %v", key, err)
+	}
+
+	// Properties set by Inspector take precedence over the S3 key
+	properties := append(bom.Metadata.Properties, bom.Metadata.Component.Properties...)
+	if v := cycloneDxPropertyValue(properties, "resource_id", "resource_arn", "instance_id"); v != "" {
+		resource.Id = v
+	}
+	if v := cycloneDxPropertyValue(properties, "resource_type"); v != "" {
+		resource.Type = v
+	}
+	if v := cycloneDxPropertyValue(properties, "account_id"); v != "" {
+		resource.AccountId = v
+	}
+
+	vulnerabilities := map[string][]string{}
+	for _, v := range bom.Vulnerabilities {
+		for _, a := range v.Affects {
+			vulnerabilities[a.Ref] = append(vulnerabilities[a.Ref], v.Id)
+		}
+	}
+
+	var packages []Inspector2SbomPackage
+	var walk func(components []cycloneDxComponent)
+	walk = func(components []cycloneDxComponent) {
+		for _, c := range components {
+			p := Inspector2SbomPackage{
+				S3Key:             key,
+				ResourceId:        resource.Id,
+				ResourceType:      resource.Type,
+				ResourceAccountId: resource.AccountId,
+				Name:              c.Name,
+				Version:           c.Version,
+				Purl:              c.Purl,
+				PackageType:       purlType(c.Purl),
+				VulnerabilityIds:  uniqueSortedStrings(vulnerabilities[c.BomRef]),
+			}
+			for _, l := range c.Licenses {
+				switch {
+				case l.License.Id != "":
+					p.Licenses = append(p.Licenses, l.License.Id)
+				case l.License.Name != "":
+					p.Licenses = append(p.Licenses, l.License.Name)
+				case l.Expression != "":
+					p.Licenses = append(p.Licenses, l.Expression)
+				}
+			}
+			packages = append(packages, p)
+			walk(c.Components)
+		}
+	}
+	walk(bom.Components)
+
+	return packages, nil
+}
+
+func parseInspector2Spdx(key string, content []byte, resource inspector2SbomResource) ([]Inspector2SbomPackage, error) {
+	var doc spdxDocument
+	if err := json.Unmarshal(content, &doc); err != nil {
+		return nil, fmt.Errorf("failed to parse SPDX SBOM %s: %v", key, err)
+	}
+
+	var packages []Inspector2SbomPackage
+	for _, pkg := range doc.Packages {
+		p := Inspector2SbomPackage{
+			S3Key:             key,
+			ResourceId:        resource.Id,
+			ResourceType:      resource.Type,
+			ResourceAccountId: resource.AccountId,
+			Name:              pkg.Name,
+			Version:           pkg.VersionInfo,
+		}
+		for _, ref := range pkg.ExternalRefs {
+			switch {
+			case ref.ReferenceType == "purl":
+				p.Purl = ref.ReferenceLocator
+			case ref.ReferenceCategory == "SECURITY" && ref.ReferenceType == "advisory":
+				// The advisory is a URL that ends with the vulnerability ID
+				p.VulnerabilityIds = append(p.VulnerabilityIds, path.Base(ref.ReferenceLocator))
+			}
+		}
+		p.PackageType = purlType(p.Purl)
+		p.VulnerabilityIds = uniqueSortedStrings(p.VulnerabilityIds)
+
+		license := pkg.LicenseConcluded
+		if license == "" || license == "NOASSERTION" {
+			license = pkg.LicenseDeclared
+		}
+		if license != "" && license != "NOASSERTION" && license != "NONE" {
+			p.Licenses = []string{license}
+		}
+		packages = append(packages, p)
+	}
+
+	return packages, nil
+}
+
+// inspector2SbomResourceFromKey reads the resource from the S3 key of an SBOM
+// file, e.g. <prefix>/<report id>/account=<id>/resource=<type>/<resource id>.json
+func inspector2SbomResourceFromKey(key string) inspector2SbomResource {
+	var resource inspector2SbomResource
+	for _, segment := range strings.Split(path.Dir(key), "/") {
+		if v, ok := strings.CutPrefix(segment, "account="); ok {
+			resource.AccountId = v
+		}
+		if v, ok := strings.CutPrefix(segment, "resource="); ok {
+			resource.Type = v
+		}
+	}
+	resource.Id = strings.TrimSuffix(path.Base(key), path.Ext(key))
+	return resource
+}
+
+// inspector2SbomResourceType normalizes a resource type to the values used by
+// the Inspector filters, e.g. ec2_instance becomes AWS_EC2_INSTANCE
+func inspector2SbomResourceType(resourceType string) string {
+	if resourceType == "" {
+		return ""
+	}
+	resourceType = strings.ToUpper(strings.ReplaceAll(resourceType, "-", "_"))
+	if !strings.HasPrefix(resourceType, "AWS_") {
+		resourceType = "AWS_" + resourceType
+	}
+	return resourceType
+}
+
+// cycloneDxPropertyValue returns the value of the first property whose name
+// ends with one of the suffixes, e.g. amazon:inspector:sbom_exporter:resource_type
+func cycloneDxPropertyValue(properties []cycloneDxProperty, suffixes ...string) string {
+	for _, suffix := range suffixes {
+		for _, p := range properties {
+			if strings.HasSuffix(p.Name, ":"+suffix) {
+				return p.Value
+			}
+		}
+	}
+	return ""
+}
+
+// purlType returns the type of a package URL, e.g. npm for pkg:npm/lodash@4.17.21
+func purlType(purl string) string {
+	t, ok := strings.CutPrefix(purl, "pkg:")
+	if !ok {
+		return ""
+	}
+	t, _, _ = strings.Cut(t, "/")
+	return t
+}
+
+func uniqueSortedStrings(values []string) []string {
+	if len(values) == 0 {
+		return nil
+	}
+	unique := uniqueStrings(values)
+	sort.Strings(unique)
+	return unique
+}
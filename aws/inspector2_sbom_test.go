@@ -0,0 +1,174 @@
+package aws
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseInspector2SbomCycloneDx(t *testing.T) {
+	content := `{
+		"bomFormat": "CycloneDX",
+		"specVersion": "1.4",
+		"metadata": {
+			"component": {
+				"bom-ref": "comp-1",
+				"type": "operating-system",
+				"name": "amazon_linux",
+				"properties": [
+					{"name": "amazon:inspector:sbom_exporter:account_id", "value": "111111111111"},
+					{"name": "amazon:inspector:sbom_exporter:resource_type", "value": "AWS_EC2_INSTANCE"}
+				]
+			}
+		},
+		"components": [
+			{
+				"bom-ref": "comp-2",
+				"type": "library",
+				"name": "log4j-core",
+				"version": "2.14.1",
+				"purl": "pkg:maven/org.apache.logging.log4j/log4j-core@2.14.1",
+				"licenses": [{"license": {"id": "Apache-2.0"}}],
+				"components": [
+					{"bom-ref": "comp-3", "name": "log4j-api", "version": "2.14.1"}
+				]
+			},
+			{
+				"bom-ref": "comp-4",
+				"name": "openssl",
+				"version": "1.0.2k",
+				"purl": "pkg:rpm/amzn/openssl@1.0.2k",
+				"licenses": [{"expression": "OpenSSL"}]
+			}
+		],
+		"vulnerabilities": [
+			{"id": "CVE-2021-45046", "affects": [{"ref": "comp-2"}]},
+			{"id": "CVE-2021-44228", "affects": [{"ref": "comp-2"}, {"ref": "comp-3"}]},
+			{"id": "CVE-2021-44228", "affects": [{"ref": "comp-2"}]}
+		]
+	}`
+	key := "sbom/abcd-1234/account=111111111111/resource=ec2_instance/i-0123456789abcdef0.json"
+
+	got, err := parseInspector2Sbom(key, []byte(content))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []Inspector2SbomPackage{
+		{
+			S3Key:             key,
+			ResourceId:        "i-0123456789abcdef0",
+			ResourceType:      "AWS_EC2_INSTANCE",
+			ResourceAccountId: "111111111111",
+			Name:              "log4j-core",
+			Version:           "2.14.1",
+			Purl:              "pkg:maven/org.apache.logging.log4j/log4j-core@2.14.1",
+			PackageType:       "maven",
+			Licenses:          []string{"Apache-2.0"},
+			VulnerabilityIds:  []string{"CVE-2021-44228", "CVE-2021-45046"},
+		},
+		{
+			S3Key:             key,
+			ResourceId:        "i-0123456789abcdef0",
+			ResourceType:      "AWS_EC2_INSTANCE",
+			ResourceAccountId: "111111111111",
+			Name:              "log4j-api",
+			Version:           "2.14.1",
+			VulnerabilityIds:  []string{"CVE-2021-44228"},
+		},
+		{
+			S3Key:             key,
+			ResourceId:        "i-0123456789abcdef0",
+			ResourceType:      "AWS_EC2_INSTANCE",
+			ResourceAccountId: "111111111111",
+			Name:              "openssl",
+			Version:           "1.0.2k",
+			Purl:              "pkg:rpm/amzn/openssl@1.0.2k",
+			PackageType:       "rpm",
+			Licenses:          []string{"OpenSSL"},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("parseInspector2Sbom() =\n%+v\nwant\n%+v", got, want)
+	}
+}
+
+func TestParseInspector2SbomSpdx(t *testing.T) {
+	content := `{
+		"spdxVersion": "SPDX-2.3",
+		"name": "my-function",
+		"packages": [
+			{
+				"SPDXID": "SPDXRef-Package-1",
+				"name": "requests",
+				"versionInfo": "2.25.0",
+				"licenseConcluded": "NOASSERTION",
+				"licenseDeclared": "Apache-2.0",
+				"externalRefs": [
+					{"referenceCategory": "PACKAGE-MANAGER", "referenceType": "purl", "referenceLocator": "pkg:pypi/requests@2.25.0"},
+					{"referenceCategory": "SECURITY", "referenceType": "advisory", "referenceLocator": "https://nvd.nist.gov/vuln/detail/CVE-2023-32681"}
+				]
+			},
+			{
+				"SPDXID": "SPDXRef-Package-2",
+				"name": "urllib3",
+				"versionInfo": "1.26.5",
+				"licenseConcluded": "NONE"
+			}
+		]
+	}`
+	key := "abcd-1234/account=222222222222/resource=lambda_function/my-function.json"
+
+	got, err := parseInspector2Sbom(key, []byte(content))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []Inspector2SbomPackage{
+		{
+			S3Key:             key,
+			ResourceId:        "my-function",
+			ResourceType:      "AWS_LAMBDA_FUNCTION",
+			ResourceAccountId: "222222222222",
+			Name:              "requests",
+			Version:           "2.25.0",
+			Purl:              "pkg:pypi/requests@2.25.0",
+			PackageType:       "pypi",
+			Licenses:          []string{"Apache-2.0"},
+			VulnerabilityIds:  []string{"CVE-2023-32681"},
+		},
+		{
+			S3Key:             key,
+			ResourceId:        "my-function",
+			ResourceType:      "AWS_LAMBDA_FUNCTION",
+			ResourceAccountId: "222222222222",
+			Name:              "urllib3",
+			Version:           "1.26.5",
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("parseInspector2Sbom() =\n%+v\nwant\n%+v", got, want)
+	}
+}
+
+func TestParseInspector2SbomUnsupportedFormat(t *testing.T) {
+	if _, err := parseInspector2Sbom("report.json", []byte(`{"foo": "bar"}`)); err == nil {
+		t.Error("expected an error for an unknown SBOM format")
+	}
+}
+
+func TestInspector2SbomReportKeyPrefix(t *testing.T) {
+	testCases := []struct {
+		keyPrefix string
+		expected  string
+	}{
+		{"", "abcd-1234/"},
+		{"steampipe", "steampipe/abcd-1234/"},
+		{"steampipe/", "steampipe/abcd-1234/"},
+	}
+
+	for _, tc := range testCases {
+		if got := inspector2SbomReportKeyPrefix(tc.keyPrefix, "abcd-1234"); got != tc.expected {
+			t.Errorf("inspector2SbomReportKeyPrefix(%q) = %q, want %q", tc.keyPrefix, got, tc.expected)
+		}
+	}
+}
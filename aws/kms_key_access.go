@@ -0,0 +1,208 @@
+package aws
+
+import (
+	"encoding/json"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kms/types"
+)
+
+// KmsKeyDecryptAccess is a principal that is allowed to decrypt with a KMS key,
+// either through a statement of the key policy or through a grant
+type KmsKeyDecryptAccess struct {
+	// The principal, as written in the key policy or grant
+	Principal string
+	// The type of the principal, e.g. AWS, Service or Federated
+	PrincipalType string
+	// KeyPolicy or Grant
+	Source string
+	// The Sid of the key policy statement, if any
+	Sid string `json:",omitempty"`
+	// The ID of the grant
+	GrantId string `json:",omitempty"`
+	// True if the access is limited by policy conditions or grant constraints
+	Conditional bool
+}
+
+// kmsDecryptAction is the lower case action that policy statements are matched
+// against, since canonical policies lower case all actions
+const kmsDecryptAction = "kms:decrypt"
+
+var awsAccountIdRegex = regexp.MustCompile(`^\d{12}$`)
+
+// kmsKeyDecryptAccess merges the key policy and grants of a KMS key into a
+// single list of the principals that can use the key to decrypt.
+//
+// Only the key policy and grants are considered. When the key policy allows an
+// account root principal, IAM policies in that account further decide who can
+// decrypt, and those are not evaluated here. Deny statements without conditions
+// remove the principals they name; a Deny for every principal removes all access.
+func kmsKeyDecryptAccess(policy string, grants []types.GrantListEntry) ([]KmsKeyDecryptAccess, error) {
+	access := []KmsKeyDecryptAccess{}
+	denied := map[string]bool{}
+
+	if policy != "" {
+		var p Policy
+		if err := json.Unmarshal([]byte(policy), &p); err != nil {
+			return nil, err
+		}
+
+		for _, s := range p.Statements {
+			if !kmsStatementCoversDecrypt(s) {
+				continue
+			}
+
+			switch s.Effect {
+			case "Deny":
+				if len(s.Condition) > 0 || len(s.NotPrincipal) > 0 {
+					continue
+				}
+				for _, principal := range kmsPrincipalList(s.Principal) {
+					denied[principal.Principal] = true
+				}
+			case "Allow":
+				// NotPrincipal is not supported with Allow in key policies
+				if len(s.NotPrincipal) > 0 {
+					continue
+				}
+				for _, principal := range kmsPrincipalList(s.Principal) {
+					principal.Source = "KeyPolicy"
+					principal.Sid = s.Sid
+					principal.Conditional = len(s.Condition) > 0
+					access = append(access, principal)
+				}
+			}
+		}
+	}
+
+	for _, grant := range grants {
+		if grant.GranteePrincipal == nil || !kmsGrantAllowsDecrypt(grant) {
+			continue
+		}
+		access = append(access, KmsKeyDecryptAccess{
+			Principal:     *grant.GranteePrincipal,
+			PrincipalType: kmsGranteePrincipalType(*grant.GranteePrincipal),
+			Source:        "Grant",
+			GrantId:       aws.ToString(grant.GrantId),
+			Conditional:   grant.Constraints != nil,
+		})
+	}
+
+	if denied["*"] {
+		return []KmsKeyDecryptAccess{}, nil
+	}
+
+	result := []KmsKeyDecryptAccess{}
+	for _, a := range access {
+		if !denied[a.Principal] {
+			result = append(result, a)
+		}
+	}
+
+	return result, nil
+}
+
+// kmsKeyDecryptPrincipals returns the sorted, unique principals in the access list
+func kmsKeyDecryptPrincipals(access []KmsKeyDecryptAccess) []string {
+	principals := []string{}
+	for _, a := range access {
+		principals = append(principals, a.Principal)
+	}
+	principals = uniqueStrings(principals)
+	sort.Strings(principals)
+	return principals
+}
+
+// kmsKeyDecryptAccounts returns the sorted, unique AWS account IDs of the AWS
+// principals in the access list. A wildcard principal is returned as *.
+func kmsKeyDecryptAccounts(access []KmsKeyDecryptAccess) []string {
+	accounts := []string{}
+	for _, a := range access {
+		if a.PrincipalType != "AWS" {
+			continue
+		}
+		switch {
+		case a.Principal == "*":
+			accounts = append(accounts, "*")
+		case awsAccountIdRegex.MatchString(a.Principal):
+			accounts = append(accounts, a.Principal)
+		case strings.HasPrefix(a.Principal, "arn:"):
+			parts := strings.SplitN(a.Principal, ":", 6)
+			if len(parts) == 6 && parts[4] != "" {
+				accounts = append(accounts, parts[4])
+			}
+		}
+	}
+	accounts = uniqueStrings(accounts)
+	sort.Strings(accounts)
+	return accounts
+}
+
+// kmsStatementCoversDecrypt reports whether the statement's Action or NotAction
+// applies to kms:Decrypt
+func kmsStatementCoversDecrypt(s Statement) bool {
+	if len(s.Action) > 0 {
+		return kmsActionsMatch(s.Action, kmsDecryptAction)
+	}
+	if len(s.NotAction) > 0 {
+		return !kmsActionsMatch(s.NotAction, kmsDecryptAction)
+	}
+	return false
+}
+
+func kmsActionsMatch(patterns []string, action string) bool {
+	for _, pattern := range patterns {
+		if matched, _ := path.Match(pattern, action); matched {
+			return true
+		}
+	}
+	return false
+}
+
+// kmsPrincipalList flattens a canonical Principal map into one entry per principal
+func kmsPrincipalList(principal Principal) []KmsKeyDecryptAccess {
+	var principals []KmsKeyDecryptAccess
+
+	principalTypes := make([]string, 0, len(principal))
+	for principalType := range principal {
+		principalTypes = append(principalTypes, principalType)
+	}
+	sort.Strings(principalTypes)
+
+	for _, principalType := range principalTypes {
+		values, ok := principal[principalType].([]string)
+		if !ok {
+			continue
+		}
+		for _, value := range values {
+			principals = append(principals, KmsKeyDecryptAccess{
+				Principal:     value,
+				PrincipalType: principalType,
+			})
+		}
+	}
+
+	return principals
+}
+
+func kmsGrantAllowsDecrypt(grant types.GrantListEntry) bool {
+	for _, operation := range grant.Operations {
+		if operation == types.GrantOperationDecrypt {
+			return true
+		}
+	}
+	return false
+}
+
+// kmsGranteePrincipalType returns Service for AWS service principals, such as
+// dynamodb.us-east-1.amazonaws.com, and AWS for account and IAM principals
+func kmsGranteePrincipalType(principal string) string {
+	if !strings.HasPrefix(principal, "arn:") && strings.HasSuffix(principal, ".amazonaws.com") {
+		return "Service"
+	}
+	return "AWS"
+}
@@ -0,0 +1,140 @@
+package aws
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kms/types"
+)
+
+func TestKmsKeyDecryptAccess(t *testing.T) {
+	policy := `{
+		"Version": "2012-10-17",
+		"Statement": [
+			{
+				"Sid": "Enable IAM User Permissions",
+				"Effect": "Allow",
+				"Principal": {"AWS": "arn:aws:iam::111111111111:root"},
+				"Action": "kms:*",
+				"Resource": "*"
+			},
+			{
+				"Sid": "Allow use of the key",
+				"Effect": "Allow",
+				"Principal": {"AWS": ["arn:aws:iam::222222222222:role/App", "arn:aws:iam::333333333333:role/Denied"]},
+				"Action": ["kms:Encrypt", "kms:Decrypt*"],
+				"Resource": "*",
+				"Condition": {"StringEquals": {"kms:ViaService": "s3.us-east-1.amazonaws.com"}}
+			},
+			{
+				"Sid": "Allow administration only",
+				"Effect": "Allow",
+				"Principal": {"AWS": "arn:aws:iam::111111111111:role/Admin"},
+				"Action": ["kms:Create*", "kms:Describe*"],
+				"Resource": "*"
+			},
+			{
+				"Sid": "Deny role",
+				"Effect": "Deny",
+				"Principal": {"AWS": "arn:aws:iam::333333333333:role/Denied"},
+				"Action": "kms:Decrypt",
+				"Resource": "*"
+			}
+		]
+	}`
+
+	grants := []types.GrantListEntry{
+		{
+			GrantId:          aws.String("grant-1"),
+			GranteePrincipal: aws.String("arn:aws:iam::444444444444:role/Grantee"),
+			Operations:       []types.GrantOperation{types.GrantOperationDecrypt, types.GrantOperationEncrypt},
+		},
+		{
+			GrantId:          aws.String("grant-2"),
+			GranteePrincipal: aws.String("dynamodb.us-east-1.amazonaws.com"),
+			Operations:       []types.GrantOperation{types.GrantOperationDecrypt},
+			Constraints:      &types.GrantConstraints{EncryptionContextSubset: map[string]string{"table": "orders"}},
+		},
+		{
+			GrantId:          aws.String("grant-3"),
+			GranteePrincipal: aws.String("arn:aws:iam::555555555555:role/EncryptOnly"),
+			Operations:       []types.GrantOperation{types.GrantOperationEncrypt},
+		},
+	}
+
+	access, err := kmsKeyDecryptAccess(policy, grants)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []KmsKeyDecryptAccess{
+		{Principal: "arn:aws:iam::111111111111:root", PrincipalType: "AWS", Source: "KeyPolicy", Sid: "Enable IAM User Permissions"},
+		{Principal: "arn:aws:iam::222222222222:role/App", PrincipalType: "AWS", Source: "KeyPolicy", Sid: "Allow use of the key", Conditional: true},
+		{Principal: "arn:aws:iam::444444444444:role/Grantee", PrincipalType: "AWS", Source: "Grant", GrantId: "grant-1"},
+		{Principal: "dynamodb.us-east-1.amazonaws.com", PrincipalType: "Service", Source: "Grant", GrantId: "grant-2", Conditional: true},
+	}
+	if !reflect.DeepEqual(access, expected) {
+		t.Errorf("unexpected access\n got: %+v\nwant: %+v", access, expected)
+	}
+
+	accounts := kmsKeyDecryptAccounts(access)
+	if !reflect.DeepEqual(accounts, []string{"111111111111", "222222222222", "444444444444"}) {
+		t.Errorf("unexpected accounts: %v", accounts)
+	}
+
+	principals := kmsKeyDecryptPrincipals(access)
+	if len(principals) != 4 || principals[3] != "dynamodb.us-east-1.amazonaws.com" {
+		t.Errorf("unexpected principals: %v", principals)
+	}
+}
+
+func TestKmsKeyDecryptAccessNotActionAndWildcards(t *testing.T) {
+	policy := `{
+		"Version": "2012-10-17",
+		"Statement": [
+			{
+				"Effect": "Allow",
+				"Principal": "*",
+				"NotAction": "kms:Encrypt",
+				"Resource": "*"
+			},
+			{
+				"Effect": "Allow",
+				"Principal": {"Service": "logs.amazonaws.com"},
+				"NotAction": ["kms:Decrypt"],
+				"Resource": "*"
+			}
+		]
+	}`
+
+	access, err := kmsKeyDecryptAccess(policy, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(access) != 1 || access[0].Principal != "*" {
+		t.Fatalf("unexpected access: %+v", access)
+	}
+	if accounts := kmsKeyDecryptAccounts(access); !reflect.DeepEqual(accounts, []string{"*"}) {
+		t.Errorf("unexpected accounts: %v", accounts)
+	}
+
+	// An unconditional Deny for every principal removes all access, including grants
+	deny := `{
+		"Version": "2012-10-17",
+		"Statement": [
+			{"Effect": "Allow", "Principal": {"AWS": "111111111111"}, "Action": "kms:*", "Resource": "*"},
+			{"Effect": "Deny", "Principal": "*", "Action": "kms:Decrypt", "Resource": "*"}
+		]
+	}`
+	grants := []types.GrantListEntry{
+		{GranteePrincipal: aws.String("111111111111"), Operations: []types.GrantOperation{types.GrantOperationDecrypt}},
+	}
+	access, err = kmsKeyDecryptAccess(deny, grants)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(access) != 0 {
+		t.Errorf("expected no access, got: %+v", access)
+	}
+}
@@ -0,0 +1,293 @@
+//go:build !dev
+
+package aws
+
+func getLambdaRuntimeCatalog() LambdaRuntimeCatalog {
+	catalog := LambdaRuntimeCatalog{
+		Version: "2025-06-30",
+		Source:  "https://docs.aws.amazon.com/lambda/latest/dg/lambda-runtimes.html",
+		Runtimes: []LambdaRuntime{
+			{
+				Runtime:                 "dotnet6",
+				Name:                    ".NET 6",
+				OperatingSystem:         "Amazon Linux 2",
+				DeprecationDate:         "2024-12-20",
+				BlockFunctionCreateDate: "",
+				BlockFunctionUpdateDate: "",
+			},
+			{
+				Runtime:                 "dotnet8",
+				Name:                    ".NET 8",
+				OperatingSystem:         "Amazon Linux 2023",
+				DeprecationDate:         "2026-11-10",
+				BlockFunctionCreateDate: "2026-12-10",
+				BlockFunctionUpdateDate: "2027-01-11",
+			},
+			{
+				Runtime:                 "dotnetcore2.1",
+				Name:                    ".NET Core 2.1",
+				OperatingSystem:         "Amazon Linux",
+				DeprecationDate:         "2022-01-05",
+				BlockFunctionCreateDate: "",
+				BlockFunctionUpdateDate: "",
+			},
+			{
+				Runtime:                 "dotnetcore3.1",
+				Name:                    ".NET Core 3.1",
+				OperatingSystem:         "Amazon Linux 2",
+				DeprecationDate:         "2023-04-03",
+				BlockFunctionCreateDate: "",
+				BlockFunctionUpdateDate: "",
+			},
+			{
+				Runtime:                 "go1.x",
+				Name:                    "Go 1.x",
+				OperatingSystem:         "Amazon Linux",
+				DeprecationDate:         "2024-01-08",
+				BlockFunctionCreateDate: "",
+				BlockFunctionUpdateDate: "",
+			},
+			{
+				Runtime:                 "java11",
+				Name:                    "Java 11",
+				OperatingSystem:         "Amazon Linux 2",
+				DeprecationDate:         "2026-06-30",
+				BlockFunctionCreateDate: "2026-07-31",
+				BlockFunctionUpdateDate: "2026-08-31",
+			},
+			{
+				Runtime:                 "java17",
+				Name:                    "Java 17",
+				OperatingSystem:         "Amazon Linux 2",
+				DeprecationDate:         "2026-06-30",
+				BlockFunctionCreateDate: "2026-07-31",
+				BlockFunctionUpdateDate: "2026-08-31",
+			},
+			{
+				Runtime:                 "java21",
+				Name:                    "Java 21",
+				OperatingSystem:         "Amazon Linux 2023",
+				DeprecationDate:         "2029-06-30",
+				BlockFunctionCreateDate: "2029-07-31",
+				BlockFunctionUpdateDate: "2029-08-31",
+			},
+			{
+				Runtime:                 "java8",
+				Name:                    "Java 8",
+				OperatingSystem:         "Amazon Linux",
+				DeprecationDate:         "2024-01-08",
+				BlockFunctionCreateDate: "",
+				BlockFunctionUpdateDate: "",
+			},
+			{
+				Runtime:                 "java8.al2",
+				Name:                    "Java 8",
+				OperatingSystem:         "Amazon Linux 2",
+				DeprecationDate:         "2026-06-30",
+				BlockFunctionCreateDate: "2026-07-31",
+				BlockFunctionUpdateDate: "2026-08-31",
+			},
+			{
+				Runtime:                 "nodejs10.x",
+				Name:                    "Node.js 10",
+				OperatingSystem:         "Amazon Linux 2",
+				DeprecationDate:         "2021-07-30",
+				BlockFunctionCreateDate: "",
+				BlockFunctionUpdateDate: "",
+			},
+			{
+				Runtime:                 "nodejs12.x",
+				Name:                    "Node.js 12",
+				OperatingSystem:         "Amazon Linux 2",
+				DeprecationDate:         "2023-03-31",
+				BlockFunctionCreateDate: "",
+				BlockFunctionUpdateDate: "",
+			},
+			{
+				Runtime:                 "nodejs14.x",
+				Name:                    "Node.js 14",
+				OperatingSystem:         "Amazon Linux 2",
+				DeprecationDate:         "2023-12-04",
+				BlockFunctionCreateDate: "",
+				BlockFunctionUpdateDate: "",
+			},
+			{
+				Runtime:                 "nodejs16.x",
+				Name:                    "Node.js 16",
+				OperatingSystem:         "Amazon Linux 2",
+				DeprecationDate:         "2024-06-12",
+				BlockFunctionCreateDate: "",
+				BlockFunctionUpdateDate: "",
+			},
+			{
+				Runtime:                 "nodejs18.x",
+				Name:                    "Node.js 18",
+				OperatingSystem:         "Amazon Linux 2",
+				DeprecationDate:         "2025-09-01",
+				BlockFunctionCreateDate: "2025-10-01",
+				BlockFunctionUpdateDate: "2025-11-01",
+			},
+			{
+				Runtime:                 "nodejs20.x",
+				Name:                    "Node.js 20",
+				OperatingSystem:         "Amazon Linux 2023",
+				DeprecationDate:         "2026-04-30",
+				BlockFunctionCreateDate: "2026-06-01",
+				BlockFunctionUpdateDate: "2026-07-01",
+			},
+			{
+				Runtime:                 "nodejs22.x",
+				Name:                    "Node.js 22",
+				OperatingSystem:         "Amazon Linux 2023",
+				DeprecationDate:         "2027-04-30",
+				BlockFunctionCreateDate: "2027-06-01",
+				BlockFunctionUpdateDate: "2027-07-01",
+			},
+			{
+				Runtime:                 "nodejs8.10",
+				Name:                    "Node.js 8.10",
+				OperatingSystem:         "Amazon Linux",
+				DeprecationDate:         "2020-03-06",
+				BlockFunctionCreateDate: "",
+				BlockFunctionUpdateDate: "",
+			},
+			{
+				Runtime:                 "provided",
+				Name:                    "OS-only Runtime",
+				OperatingSystem:         "Amazon Linux",
+				DeprecationDate:         "2024-01-08",
+				BlockFunctionCreateDate: "",
+				BlockFunctionUpdateDate: "",
+			},
+			{
+				Runtime:                 "provided.al2",
+				Name:                    "OS-only Runtime",
+				OperatingSystem:         "Amazon Linux 2",
+				DeprecationDate:         "2026-06-30",
+				BlockFunctionCreateDate: "2026-07-31",
+				BlockFunctionUpdateDate: "2026-08-31",
+			},
+			{
+				Runtime:                 "provided.al2023",
+				Name:                    "OS-only Runtime",
+				OperatingSystem:         "Amazon Linux 2023",
+				DeprecationDate:         "2029-06-30",
+				BlockFunctionCreateDate: "2029-07-31",
+				BlockFunctionUpdateDate: "2029-08-31",
+			},
+			{
+				Runtime:                 "python2.7",
+				Name:                    "Python 2.7",
+				OperatingSystem:         "Amazon Linux",
+				DeprecationDate:         "2021-07-15",
+				BlockFunctionCreateDate: "",
+				BlockFunctionUpdateDate: "",
+			},
+			{
+				Runtime:                 "python3.10",
+				Name:                    "Python 3.10",
+				OperatingSystem:         "Amazon Linux 2",
+				DeprecationDate:         "2026-06-30",
+				BlockFunctionCreateDate: "2026-07-31",
+				BlockFunctionUpdateDate: "2026-08-31",
+			},
+			{
+				Runtime:                 "python3.11",
+				Name:                    "Python 3.11",
+				OperatingSystem:         "Amazon Linux 2",
+				DeprecationDate:         "2026-06-30",
+				BlockFunctionCreateDate: "2026-07-31",
+				BlockFunctionUpdateDate: "2026-08-31",
+			},
+			{
+				Runtime:                 "python3.12",
+				Name:                    "Python 3.12",
+				OperatingSystem:         "Amazon Linux 2023",
+				DeprecationDate:         "2028-10-31",
+				BlockFunctionCreateDate: "2028-11-30",
+				BlockFunctionUpdateDate: "2029-01-10",
+			},
+			{
+				Runtime:                 "python3.13",
+				Name:                    "Python 3.13",
+				OperatingSystem:         "Amazon Linux 2023",
+				DeprecationDate:         "2029-06-30",
+				BlockFunctionCreateDate: "2029-07-31",
+				BlockFunctionUpdateDate: "2029-08-31",
+			},
+			{
+				Runtime:                 "python3.6",
+				Name:                    "Python 3.6",
+				OperatingSystem:         "Amazon Linux",
+				DeprecationDate:         "2022-07-18",
+				BlockFunctionCreateDate: "",
+				BlockFunctionUpdateDate: "",
+			},
+			{
+				Runtime:                 "python3.7",
+				Name:                    "Python 3.7",
+				OperatingSystem:         "Amazon Linux",
+				DeprecationDate:         "2023-12-04",
+				BlockFunctionCreateDate: "",
+				BlockFunctionUpdateDate: "",
+			},
+			{
+				Runtime:                 "python3.8",
+				Name:                    "Python 3.8",
+				OperatingSystem:         "Amazon Linux 2",
+				DeprecationDate:         "2024-10-14",
+				BlockFunctionCreateDate: "",
+				BlockFunctionUpdateDate: "",
+			},
+			{
+				Runtime:                 "python3.9",
+				Name:                    "Python 3.9",
+				OperatingSystem:         "Amazon Linux 2",
+				DeprecationDate:         "2025-12-15",
+				BlockFunctionCreateDate: "2026-01-15",
+				BlockFunctionUpdateDate: "2026-02-15",
+			},
+			{
+				Runtime:                 "ruby2.5",
+				Name:                    "Ruby 2.5",
+				OperatingSystem:         "Amazon Linux",
+				DeprecationDate:         "2021-07-30",
+				BlockFunctionCreateDate: "",
+				BlockFunctionUpdateDate: "",
+			},
+			{
+				Runtime:                 "ruby2.7",
+				Name:                    "Ruby 2.7",
+				OperatingSystem:         "Amazon Linux 2",
+				DeprecationDate:         "2023-12-07",
+				BlockFunctionCreateDate: "",
+				BlockFunctionUpdateDate: "",
+			},
+			{
+				Runtime:                 "ruby3.2",
+				Name:                    "Ruby 3.2",
+				OperatingSystem:         "Amazon Linux 2",
+				DeprecationDate:         "2026-03-31",
+				BlockFunctionCreateDate: "2026-04-30",
+				BlockFunctionUpdateDate: "2026-05-31",
+			},
+			{
+				Runtime:                 "ruby3.3",
+				Name:                    "Ruby 3.3",
+				OperatingSystem:         "Amazon Linux 2023",
+				DeprecationDate:         "2027-03-31",
+				BlockFunctionCreateDate: "2027-04-30",
+				BlockFunctionUpdateDate: "2027-05-31",
+			},
+			{
+				Runtime:                 "ruby3.4",
+				Name:                    "Ruby 3.4",
+				OperatingSystem:         "Amazon Linux 2023",
+				DeprecationDate:         "2028-03-31",
+				BlockFunctionCreateDate: "2028-04-30",
+				BlockFunctionUpdateDate: "2028-05-31",
+			},
+		},
+	}
+	return catalog
+}
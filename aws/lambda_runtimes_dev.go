@@ -0,0 +1,77 @@
+//go:build dev
+
+package aws
+
+func getLambdaRuntimeCatalog() LambdaRuntimeCatalog {
+	catalog := LambdaRuntimeCatalog{
+		Version: "2025-06-30",
+		Source:  "https://docs.aws.amazon.com/lambda/latest/dg/lambda-runtimes.html",
+		Runtimes: []LambdaRuntime{
+			{
+				Runtime:                 "java21",
+				Name:                    "Java 21",
+				OperatingSystem:         "Amazon Linux 2023",
+				DeprecationDate:         "2029-06-30",
+				BlockFunctionCreateDate: "2029-07-31",
+				BlockFunctionUpdateDate: "2029-08-31",
+			},
+			{
+				Runtime:                 "nodejs16.x",
+				Name:                    "Node.js 16",
+				OperatingSystem:         "Amazon Linux 2",
+				DeprecationDate:         "2024-06-12",
+				BlockFunctionCreateDate: "",
+				BlockFunctionUpdateDate: "",
+			},
+			{
+				Runtime:                 "nodejs20.x",
+				Name:                    "Node.js 20",
+				OperatingSystem:         "Amazon Linux 2023",
+				DeprecationDate:         "2026-04-30",
+				BlockFunctionCreateDate: "2026-06-01",
+				BlockFunctionUpdateDate: "2026-07-01",
+			},
+			{
+				Runtime:                 "nodejs22.x",
+				Name:                    "Node.js 22",
+				OperatingSystem:         "Amazon Linux 2023",
+				DeprecationDate:         "2027-04-30",
+				BlockFunctionCreateDate: "2027-06-01",
+				BlockFunctionUpdateDate: "2027-07-01",
+			},
+			{
+				Runtime:                 "provided.al2023",
+				Name:                    "OS-only Runtime",
+				OperatingSystem:         "Amazon Linux 2023",
+				DeprecationDate:         "2029-06-30",
+				BlockFunctionCreateDate: "2029-07-31",
+				BlockFunctionUpdateDate: "2029-08-31",
+			},
+			{
+				Runtime:                 "python3.12",
+				Name:                    "Python 3.12",
+				OperatingSystem:         "Amazon Linux 2023",
+				DeprecationDate:         "2028-10-31",
+				BlockFunctionCreateDate: "2028-11-30",
+				BlockFunctionUpdateDate: "2029-01-10",
+			},
+			{
+				Runtime:                 "python3.13",
+				Name:                    "Python 3.13",
+				OperatingSystem:         "Amazon Linux 2023",
+				DeprecationDate:         "2029-06-30",
+				BlockFunctionCreateDate: "2029-07-31",
+				BlockFunctionUpdateDate: "2029-08-31",
+			},
+			{
+				Runtime:                 "python3.8",
+				Name:                    "Python 3.8",
+				OperatingSystem:         "Amazon Linux 2",
+				DeprecationDate:         "2024-10-14",
+				BlockFunctionCreateDate: "",
+				BlockFunctionUpdateDate: "",
+			},
+		},
+	}
+	return catalog
+}
@@ -0,0 +1,16 @@
+package aws
+
+type LambdaRuntime struct {
+	Runtime                 string
+	Name                    string
+	OperatingSystem         string
+	DeprecationDate         string
+	BlockFunctionCreateDate string
+	BlockFunctionUpdateDate string
+}
+
+type LambdaRuntimeCatalog struct {
+	Version  string
+	Source   string
+	Runtimes []LambdaRuntime
+}
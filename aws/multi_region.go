@@ -100,6 +100,12 @@ func CloudWatchRegionsMatrix(ctx context.Context, d *plugin.QueryData) []map[str
 	return SupportedRegionMatrixWithExclusions(AWS_MONITORING_SERVICE_ID, []string{})(ctx, d)
 }
 
+// EC2 Image Builder is not listed in the SDK endpoints data, so its tables
+// target the regions of EC2, whose images it builds.
+func ImageBuilderRegionsMatrix(ctx context.Context, d *plugin.QueryData) []map[string]interface{} {
+	return SupportedRegionMatrixWithExclusions(AWS_EC2_SERVICE_ID, []string{})(ctx, d)
+}
+
 func S3TablesRegionsMatrix(ctx context.Context, d *plugin.QueryData) []map[string]interface{} {
 
 	commonColumnData, err := getCommonColumns(ctx, d, nil)
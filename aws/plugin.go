@@ -222,12 +222,17 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 		},
 		TableMap: map[string]*plugin.Table{
 			"aws_accessanalyzer_analyzer":                                  tableAwsAccessAnalyzer(ctx),
+			"aws_accessanalyzer_check_access_not_granted":                  tableAwsAccessAnalyzerCheckAccessNotGranted(ctx),
+			"aws_accessanalyzer_check_no_new_access":                       tableAwsAccessAnalyzerCheckNoNewAccess(ctx),
 			"aws_accessanalyzer_finding":                                   tableAwsAccessAnalyzerFinding(ctx),
+			"aws_accessanalyzer_policy_validation":                         tableAwsAccessAnalyzerPolicyValidation(ctx),
+			"aws_accessanalyzer_unused_access_finding":                     tableAwsAccessAnalyzerUnusedAccessFinding(ctx),
 			"aws_account_alternate_contact":                                tableAwsAccountAlternateContact(ctx),
 			"aws_account_contact":                                          tableAwsAccountContact(ctx),
 			"aws_account":                                                  tableAwsAccount(ctx),
 			"aws_acm_certificate":                                          tableAwsAcmCertificate(ctx),
 			"aws_acmpca_certificate_authority":                             tableAwsAcmPcaCertificateAuthority(ctx),
+			"aws_acmpca_certificate":                                       tableAwsAcmPcaCertificate(ctx),
 			"aws_amplify_app":                                              tableAwsAmplifyApp(ctx),
 			"aws_api_gateway_account":                                      tableAwsAPIGatewayAccount(ctx),
 			"aws_api_gateway_api_key":                                      tableAwsAPIGatewayAPIKey(ctx),
@@ -267,7 +272,11 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"aws_backup_report_plan":                                       tableAwsBackupReportPlan(ctx),
 			"aws_backup_selection":                                         tableAwsBackupSelection(ctx),
 			"aws_backup_vault":                                             tableAwsBackupVault(ctx),
+			"aws_batch_compute_environment":                                tableAwsBatchComputeEnvironment(ctx),
+			"aws_batch_job_definition":                                     tableAwsBatchJobDefinition(ctx),
+			"aws_batch_job":                                                tableAwsBatchJob(ctx),
 			"aws_batch_queue":                                              tableAwsBatchQueue(ctx),
+			"aws_batch_scheduling_policy":                                  tableAwsBatchSchedulingPolicy(ctx),
 			"aws_bedrock_agent":                                            tableAwsBedrockAgent(ctx),
 			"aws_bedrock_knowledge_base":                                   tableAwsBedrockKnowledgeBase(ctx),
 			"aws_bedrock_custom_model":                                     tableAwsBedrockCustomModel(ctx),
@@ -310,6 +319,12 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"aws_cloudwatch_metric_data_point":                             tableAwsCloudWatchMetricDataPoint(ctx),
 			"aws_cloudwatch_metric_statistic_data_point":                   tableAwsCloudWatchMetricStatisticDataPoint(ctx),
 			"aws_cloudwatch_metric":                                        tableAwsCloudWatchMetric(ctx),
+			"aws_cognito_resource_server":                                  tableAwsCognitoResourceServer(ctx),
+			"aws_cognito_risk_configuration":                               tableAwsCognitoRiskConfiguration(ctx),
+			"aws_cognito_user_in_group":                                    tableAwsCognitoUserInGroup(ctx),
+			"aws_cognito_user_pool_client":                                 tableAwsCognitoUserPoolClient(ctx),
+			"aws_cognito_user_pool_domain":                                 tableAwsCognitoUserPoolDomain(ctx),
+			"aws_cognito_user":                                             tableAwsCognitoUser(ctx),
 			"aws_connect_instance_attribute":                               tableAwsConnectInstanceAttribute(ctx),
 			"aws_connect_instance":                                         tableAwsConnectInstance(ctx),
 			"aws_codeartifact_domain":                                      tableAwsCodeArtifactDomain(ctx),
@@ -336,6 +351,7 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"aws_config_retention_configuration":                           tableAwsConfigRetentionConfiguration(ctx),
 			"aws_config_rule":                                              tableAwsConfigRule(ctx),
 			"aws_config_rule_compliance_detail":                            tableAwsConfigRuleComplianceDetail(ctx),
+			"aws_config_secret_finding":                                    tableAwsConfigSecretFinding(ctx),
 			"aws_cost_by_account_daily":                                    tableAwsCostByLinkedAccountDaily(ctx),
 			"aws_cost_by_account_monthly":                                  tableAwsCostByLinkedAccountMonthly(ctx),
 			"aws_cost_by_record_type_daily":                                tableAwsCostByRecordTypeDaily(ctx),
@@ -358,6 +374,20 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"aws_dax_parameter_group":                                      tableAwsDaxParameterGroup(ctx),
 			"aws_dax_parameter":                                            tableAwsDaxParameter(ctx),
 			"aws_dax_subnet_group":                                         tableAwsDaxSubnetGroup(ctx),
+			"aws_detective_datasource_package":                             tableAwsDetectiveDatasourcePackage(ctx),
+			"aws_detective_graph":                                          tableAwsDetectiveGraph(ctx),
+			"aws_detective_investigation":                                  tableAwsDetectiveInvestigation(ctx),
+			"aws_detective_member":                                         tableAwsDetectiveMember(ctx),
+			"aws_detective_organization_admin_account":                     tableAwsDetectiveOrganizationAdminAccount(ctx),
+			"aws_directconnect_connection_metric_bps_egress":               tableAwsDirectConnectConnectionMetricBpsEgress(ctx),
+			"aws_directconnect_connection_metric_bps_ingress":              tableAwsDirectConnectConnectionMetricBpsIngress(ctx),
+			"aws_directconnect_connection_metric_connection_state":         tableAwsDirectConnectConnectionMetricConnectionState(ctx),
+			"aws_directconnect_connection":                                 tableAwsDirectConnectConnection(ctx),
+			"aws_directconnect_gateway_association":                        tableAwsDirectConnectGatewayAssociation(ctx),
+			"aws_directconnect_gateway":                                    tableAwsDirectConnectGateway(ctx),
+			"aws_directconnect_lag":                                        tableAwsDirectConnectLag(ctx),
+			"aws_directconnect_location":                                   tableAwsDirectConnectLocation(ctx),
+			"aws_directconnect_virtual_interface":                          tableAwsDirectConnectVirtualInterface(ctx),
 			"aws_directory_service_certificate":                            tableAwsDirectoryServiceCertificate(ctx),
 			"aws_directory_service_directory":                              tableAwsDirectoryServiceDirectory(ctx),
 			"aws_directory_service_log_subscription":                       tableAwsDirectoryServiceLogSubscription(ctx),
@@ -392,7 +422,12 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"aws_ec2_application_load_balancer_metric_request_count_daily": tableAwsEc2ApplicationLoadBalancerMetricRequestCountDaily(ctx),
 			"aws_ec2_application_load_balancer_metric_request_count":       tableAwsEc2ApplicationLoadBalancerMetricRequestCount(ctx),
 			"aws_ec2_application_load_balancer":                            tableAwsEc2ApplicationLoadBalancer(ctx),
+			"aws_ec2_autoscaling_activity":                                 tableAwsEc2AutoScalingActivity(ctx),
 			"aws_ec2_autoscaling_group":                                    tableAwsEc2ASG(ctx),
+			"aws_ec2_autoscaling_instance_refresh":                         tableAwsEc2AutoScalingInstanceRefresh(ctx),
+			"aws_ec2_autoscaling_lifecycle_hook":                           tableAwsEc2AutoScalingLifecycleHook(ctx),
+			"aws_ec2_autoscaling_scheduled_action":                         tableAwsEc2AutoScalingScheduledAction(ctx),
+			"aws_ec2_autoscaling_warm_pool_instance":                       tableAwsEc2AutoScalingWarmPoolInstance(ctx),
 			"aws_ec2_capacity_reservation":                                 tableAwsEc2CapacityReservation(ctx),
 			"aws_ec2_classic_load_balancer":                                tableAwsEc2ClassicLoadBalancer(ctx),
 			"aws_ec2_client_vpn_endpoint":                                  tableAwsEC2ClientVPNEndpoint(ctx),
@@ -423,6 +458,13 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"aws_ec2_spot_price":                                           tableAwsEc2SpotPrice(ctx),
 			"aws_ec2_ssl_policy":                                           tableAwsEc2SslPolicy(ctx),
 			"aws_ec2_target_group":                                         tableAwsEc2TargetGroup(ctx),
+			"aws_ec2_transit_gateway_connect_attachment":                   tableAwsEc2TransitGatewayConnectAttachment(ctx),
+			"aws_ec2_transit_gateway_connect_peer":                         tableAwsEc2TransitGatewayConnectPeer(ctx),
+			"aws_ec2_transit_gateway_multicast_domain":                     tableAwsEc2TransitGatewayMulticastDomain(ctx),
+			"aws_ec2_transit_gateway_peering_attachment":                   tableAwsEc2TransitGatewayPeeringAttachment(ctx),
+			"aws_ec2_transit_gateway_policy_table":                         tableAwsEc2TransitGatewayPolicyTable(ctx),
+			"aws_ec2_transit_gateway_route_table_association":              tableAwsEc2TransitGatewayRouteTableAssociation(ctx),
+			"aws_ec2_transit_gateway_route_table_propagation":              tableAwsEc2TransitGatewayRouteTablePropagation(ctx),
 			"aws_ec2_transit_gateway_route_table":                          tableAwsEc2TransitGatewayRouteTable(ctx),
 			"aws_ec2_transit_gateway_route":                                tableAwsEc2TransitGatewayRoute(ctx),
 			"aws_ec2_transit_gateway_vpc_attachment":                       tableAwsEc2TransitGatewayVpcAttachment(ctx),
@@ -433,24 +475,31 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"aws_ecr_registry_scanning_configuration":                      tableAwsEcrRegistryScanningConfiguration(ctx),
 			"aws_ecr_repository":                                           tableAwsEcrRepository(ctx),
 			"aws_ecrpublic_repository":                                     tableAwsEcrpublicRepository(ctx),
+			"aws_ecs_capacity_provider":                                    tableAwsEcsCapacityProvider(ctx),
 			"aws_ecs_cluster_metric_cpu_utilization_daily":                 tableAwsEcsClusterMetricCpuUtilizationDaily(ctx),
 			"aws_ecs_cluster_metric_cpu_utilization_hourly":                tableAwsEcsClusterMetricCpuUtilizationHourly(ctx),
 			"aws_ecs_cluster_metric_cpu_utilization":                       tableAwsEcsClusterMetricCpuUtilization(ctx),
 			"aws_ecs_cluster":                                              tableAwsEcsCluster(ctx),
 			"aws_ecs_container_instance":                                   tableAwsEcsContainerInstance(ctx),
+			"aws_ecs_service_deployment":                                   tableAwsEcsServiceDeployment(ctx),
 			"aws_ecs_service":                                              tableAwsEcsService(ctx),
+			"aws_ecs_task_container":                                       tableAwsEcsTaskContainer(ctx),
 			"aws_ecs_task_definition":                                      tableAwsEcsTaskDefinition(ctx),
+			"aws_ecs_task_set":                                             tableAwsEcsTaskSet(ctx),
 			"aws_ecs_task":                                                 tableAwsEcsTask(ctx),
 			"aws_efs_access_point":                                         tableAwsEfsAccessPoint(ctx),
 			"aws_efs_file_system":                                          tableAwsElasticFileSystem(ctx),
 			"aws_efs_mount_target":                                         tableAwsEfsMountTarget(ctx),
 			"aws_eks_access_entry":                                         tableAwsEksAccessEntry(ctx),
 			"aws_eks_access_policy_association":                            tableAwsEksAccessPolicyAssociation(ctx),
+			"aws_eks_addon_compatibility":                                  tableAwsEksAddonCompatibility(ctx),
 			"aws_eks_addon_version":                                        tableAwsEksAddonVersion(ctx),
 			"aws_eks_addon":                                                tableAwsEksAddon(ctx),
+			"aws_eks_cluster_version":                                      tableAwsEksClusterVersion(ctx),
 			"aws_eks_cluster":                                              tableAwsEksCluster(ctx),
 			"aws_eks_fargate_profile":                                      tableAwsEksFargateProfile(ctx),
 			"aws_eks_identity_provider_config":                             tableAwsEksIdentityProviderConfig(ctx),
+			"aws_eks_insight":                                              tableAwsEksInsight(ctx),
 			"aws_eks_node_group":                                           tableAwsEksNodeGroup(ctx),
 			"aws_eks_pod_identity_association":                             tableAwsEksPodIdentityAssociation(ctx),
 			"aws_elastic_beanstalk_application_version":                    tableAwsElasticBeanstalkApplicationVersion(ctx),
@@ -479,7 +528,13 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"aws_emr_instance":                                             tableAwsEmrInstance(ctx),
 			"aws_emr_security_configuration":                               tableAwsEmrSecurityConfiguration(ctx),
 			"aws_emr_studio":                                               tableAwsEmrStudio(ctx),
+			"aws_eventbridge_api_destination":                              tableAwsEventBridgeApiDestination(ctx),
+			"aws_eventbridge_archive":                                      tableAwsEventBridgeArchive(ctx),
 			"aws_eventbridge_bus":                                          tableAwsEventBridgeBus(ctx),
+			"aws_eventbridge_connection":                                   tableAwsEventBridgeConnection(ctx),
+			"aws_eventbridge_endpoint":                                     tableAwsEventBridgeEndpoint(ctx),
+			"aws_eventbridge_replay":                                       tableAwsEventBridgeReplay(ctx),
+			"aws_eventbridge_rule_target":                                  tableAwsEventBridgeRuleTarget(ctx),
 			"aws_eventbridge_rule":                                         tableAwsEventBridgeRule(ctx),
 			"aws_fms_app_list":                                             tableAwsFMSAppList(ctx),
 			"aws_fms_policy":                                               tableAwsFMSPolicy(ctx),
@@ -498,10 +553,13 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"aws_glue_job":                                                 tableAwsGlueJob(ctx),
 			"aws_glue_ml_transform":                                        tableAwsGlueMLTransform(ctx),
 			"aws_glue_security_configuration":                              tableAwsGlueSecurityConfiguration(ctx),
+			"aws_guardduty_coverage":                                       tableAwsGuardDutyCoverage(ctx),
 			"aws_guardduty_detector":                                       tableAwsGuardDutyDetector(ctx),
 			"aws_guardduty_filter":                                         tableAwsGuardDutyFilter(ctx),
 			"aws_guardduty_finding":                                        tableAwsGuardDutyFinding(ctx),
 			"aws_guardduty_ipset":                                          tableAwsGuardDutyIPSet(ctx),
+			"aws_guardduty_malware_protection_plan":                        tableAwsGuardDutyMalwareProtectionPlan(ctx),
+			"aws_guardduty_malware_scan":                                   tableAwsGuardDutyMalwareScan(ctx),
 			"aws_guardduty_member":                                         tableAwsGuardDutyMember(ctx),
 			"aws_guardduty_publishing_destination":                         tableAwsGuardDutyPublishingDestination(ctx),
 			"aws_guardduty_threat_intel_set":                               tableAwsGuardDutyThreatIntelSet(ctx),
@@ -528,6 +586,15 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"aws_identitystore_group_membership":                           tableAwsIdentityStoreGroupMembership(ctx),
 			"aws_identitystore_group":                                      tableAwsIdentityStoreGroup(ctx),
 			"aws_identitystore_user":                                       tableAwsIdentityStoreUser(ctx),
+			"aws_imagebuilder_component":                                   tableAwsImageBuilderComponent(ctx),
+			"aws_imagebuilder_container_recipe":                            tableAwsImageBuilderContainerRecipe(ctx),
+			"aws_imagebuilder_distribution_configuration":                  tableAwsImageBuilderDistributionConfiguration(ctx),
+			"aws_imagebuilder_image_pipeline":                              tableAwsImageBuilderImagePipeline(ctx),
+			"aws_imagebuilder_image_recipe":                                tableAwsImageBuilderImageRecipe(ctx),
+			"aws_imagebuilder_image_scan_finding":                          tableAwsImageBuilderImageScanFinding(ctx),
+			"aws_imagebuilder_image":                                       tableAwsImageBuilderImage(ctx),
+			"aws_imagebuilder_infrastructure_configuration":                tableAwsImageBuilderInfrastructureConfiguration(ctx),
+			"aws_inspector2_sbom_package":                                  tableAwsInspector2SbomPackage(ctx),
 			"aws_inspector_assessment_run":                                 tableAwsInspectorAssessmentRun(ctx),
 			"aws_inspector_assessment_target":                              tableAwsInspectorAssessmentTarget(ctx),
 			"aws_inspector_assessment_template":                            tableAwsInspectorAssessmentTemplate(ctx),
@@ -550,19 +617,24 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"aws_kinesis_video_stream":                                     tableAwsKinesisVideoStream(ctx),
 			"aws_kinesisanalyticsv2_application":                           tableAwsKinesisAnalyticsV2Application(ctx),
 			"aws_kms_alias":                                                tableAwsKmsAlias(ctx),
+			"aws_kms_key_grant":                                            tableAwsKmsKeyGrant(ctx),
 			"aws_kms_key_rotation":                                         tableAwsKmsKeyRotation(ctx),
 			"aws_kms_key":                                                  tableAwsKmsKey(ctx),
 			"aws_lakeformation_permission":                                 tableAwsLakeformationPermission(ctx),
 			"aws_lakeformation_resource":                                   tableAwsLakeformationResource(ctx),
 			"aws_lakeformation_tag":                                        tableAwsLakeformationTag(ctx),
 			"aws_lambda_alias":                                             tableAwsLambdaAlias(ctx),
+			"aws_lambda_code_signing_config":                               tableAwsLambdaCodeSigningConfig(ctx),
 			"aws_lambda_event_source_mapping":                              tableAwsLambdaEventSourceMapping(ctx),
+			"aws_lambda_function_event_invoke_config":                      tableAwsLambdaFunctionEventInvokeConfig(ctx),
 			"aws_lambda_function_metric_duration_daily":                    tableAwsLambdaFunctionMetricDurationDaily(ctx),
 			"aws_lambda_function_metric_errors_daily":                      tableAwsLambdaFunctionMetricErrorsDaily(ctx),
 			"aws_lambda_function_metric_invocations_daily":                 tableAwsLambdaFunctionMetricInvocationsDaily(ctx),
 			"aws_lambda_function":                                          tableAwsLambdaFunction(ctx),
 			"aws_lambda_layer_version":                                     tableAwsLambdaLayerVersion(ctx),
 			"aws_lambda_layer":                                             tableAwsLambdaLayer(ctx),
+			"aws_lambda_provisioned_concurrency_config":                    tableAwsLambdaProvisionedConcurrencyConfig(ctx),
+			"aws_lambda_runtime":                                           tableAwsLambdaRuntime(ctx),
 			"aws_lambda_version":                                           tableAwsLambdaVersion(ctx),
 			"aws_lightsail_bucket":                                         tableAwsLightsailBucket(ctx),
 			"aws_lightsail_instance":                                       tableAwsLightsailInstance(ctx),
@@ -581,6 +653,10 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"aws_networkfirewall_firewall_policy":                          tableAwsNetworkFirewallPolicy(ctx),
 			"aws_networkfirewall_firewall":                                 tableAwsNetworkFirewallFirewall(ctx),
 			"aws_networkfirewall_rule_group":                               tableAwsNetworkFirewallRuleGroup(ctx),
+			"aws_networkmanager_core_network":                              tableAwsNetworkManagerCoreNetwork(ctx),
+			"aws_networkmanager_global_network":                            tableAwsNetworkManagerGlobalNetwork(ctx),
+			"aws_networkmanager_link":                                      tableAwsNetworkManagerLink(ctx),
+			"aws_networkmanager_site":                                      tableAwsNetworkManagerSite(ctx),
 			"aws_oam_link":                                                 tableAwsOAMLink(ctx),
 			"aws_oam_sink":                                                 tableAwsOAMSink(ctx),
 			"aws_opensearch_domain":                                        tableAwsOpenSearchDomain(ctx),
@@ -650,9 +726,18 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"aws_rolesanywhere_trust_anchor":                               tableAwsRolesAnywhereTrustAnchor(ctx),
 			"aws_route53_domain":                                           tableAwsRoute53Domain(ctx),
 			"aws_route53_health_check":                                     tableAwsRoute53HealthCheck(ctx),
+			"aws_route53_profile_association":                              tableAwsRoute53ProfileAssociation(ctx),
+			"aws_route53_profile_resource_association":                     tableAwsRoute53ProfileResourceAssociation(ctx),
+			"aws_route53_profile":                                          tableAwsRoute53Profile(ctx),
 			"aws_route53_query_log":                                        tableAwsRoute53QueryLog(ctx),
 			"aws_route53_record":                                           tableAwsRoute53Record(ctx),
 			"aws_route53_resolver_endpoint":                                tableAwsRoute53ResolverEndpoint(ctx),
+			"aws_route53_resolver_firewall_config":                         tableAwsRoute53ResolverFirewallConfig(ctx),
+			"aws_route53_resolver_firewall_domain_list":                    tableAwsRoute53ResolverFirewallDomainList(ctx),
+			"aws_route53_resolver_firewall_domain":                         tableAwsRoute53ResolverFirewallDomain(ctx),
+			"aws_route53_resolver_firewall_rule_group_association":         tableAwsRoute53ResolverFirewallRuleGroupAssociation(ctx),
+			"aws_route53_resolver_firewall_rule_group":                     tableAwsRoute53ResolverFirewallRuleGroup(ctx),
+			"aws_route53_resolver_firewall_rule":                           tableAwsRoute53ResolverFirewallRule(ctx),
 			"aws_route53_resolver_query_log_config":                        tableAwsRoute53ResolverQueryLogConfig(ctx),
 			"aws_route53_resolver_rule":                                    tableAwsRoute53ResolverRule(ctx),
 			"aws_route53_traffic_policy_instance":                          tableAwsRoute53TrafficPolicyInstance(ctx),
@@ -679,8 +764,15 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"aws_sagemaker_training_job":                                   tableAwsSageMakerTrainingJob(ctx),
 			"aws_savingsplans_savings_plan":                                tableAwsSavingsPlan(ctx),
 			"aws_scheduler_schedule":                                       tableAwsSchedulerSchedule(ctx),
+			"aws_schemas_discoverer":                                       tableAwsSchemasDiscoverer(ctx),
+			"aws_schemas_registry":                                         tableAwsSchemasRegistry(ctx),
+			"aws_schemas_schema":                                           tableAwsSchemasSchema(ctx),
+			"aws_secretsmanager_secret_version":                            tableAwsSecretsManagerSecretVersion(ctx),
 			"aws_secretsmanager_secret":                                    tableAwsSecretsManagerSecret(ctx),
 			"aws_securityhub_action_target":                                tableAwsSecurityHubActionTarget(ctx),
+			"aws_securityhub_automation_rule":                              tableAwsSecurityHubAutomationRule(ctx),
+			"aws_securityhub_configuration_policy_association":             tableAwsSecurityHubConfigurationPolicyAssociation(ctx),
+			"aws_securityhub_configuration_policy":                         tableAwsSecurityHubConfigurationPolicy(ctx),
 			"aws_securityhub_enabled_product_subscription":                 tableAwsSecurityhubEnabledProductSubscription(ctx),
 			"aws_securityhub_finding_aggregator":                           tableAwsSecurityHubFindingAggregator(ctx),
 			"aws_securityhub_finding":                                      tableAwsSecurityHubFinding(ctx),
@@ -688,6 +780,7 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"aws_securityhub_insight":                                      tableAwsSecurityHubInsight(ctx),
 			"aws_securityhub_member":                                       tableAwsSecurityHubMember(ctx),
 			"aws_securityhub_product":                                      tableAwsSecurityhubProduct(ctx),
+			"aws_securityhub_security_control":                             tableAwsSecurityHubSecurityControl(ctx),
 			"aws_securityhub_standards_control":                            tableAwsSecurityHubStandardsControl(ctx),
 			"aws_securityhub_standards_subscription":                       tableAwsSecurityHubStandardsSubscription(ctx),
 			"aws_securitylake_data_lake":                                   tableAwsSecurityLakeDataLake(ctx),
@@ -725,6 +818,10 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"aws_sns_topic":                                                tableAwsSnsTopic(ctx),
 			"aws_sqs_queue":                                                tableAwsSqsQueue(ctx),
 			"aws_ssm_association":                                          tableAwsSSMAssociation(ctx),
+			"aws_ssm_automation_execution":                                 tableAwsSSMAutomationExecution(ctx),
+			"aws_ssm_change_request":                                       tableAwsSSMChangeRequest(ctx),
+			"aws_ssm_command_invocation":                                   tableAwsSSMCommandInvocation(ctx),
+			"aws_ssm_command":                                              tableAwsSSMCommand(ctx),
 			"aws_ssm_document_permission":                                  tableAwsSSMDocumentPermission(ctx),
 			"aws_ssm_document":                                             tableAwsSSMDocument(ctx),
 			"aws_ssm_inventory_entry":                                      tableAwsSSMInventoryEntry(ctx),
@@ -733,9 +830,12 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"aws_ssm_managed_instance_compliance":                          tableAwsSSMManagedInstanceCompliance(ctx),
 			"aws_ssm_managed_instance_patch_state":                         tableAwsSSMManagedInstancePatchState(ctx),
 			"aws_ssm_managed_instance":                                     tableAwsSSMManagedInstance(ctx),
+			"aws_ssm_ops_item":                                             tableAwsSSMOpsItem(ctx),
+			"aws_ssm_ops_metadata":                                         tableAwsSSMOpsMetadata(ctx),
 			"aws_ssm_parameter":                                            tableAwsSSMParameter(ctx),
 			"aws_ssm_patch_baseline":                                       tableAwsSSMPatchBaseline(ctx),
 			"aws_ssm_service_setting":                                      tableAwsSSMServiceSetting(ctx),
+			"aws_ssm_session":                                              tableAwsSSMSession(ctx),
 			"aws_ssmincidents_response_plan":                               tableAwsSSMIncidentsResponseaPlan(ctx),
 			"aws_ssoadmin_account_assignment":                              tableAwsSsoAdminAccountAssignment(ctx),
 			"aws_ssoadmin_customer_policy_attachment":                      tableAwsSsoAdminCustomerPolicyAttachment(ctx),
@@ -752,7 +852,14 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"aws_transfer_user":                                            tableAwsTransferUser(ctx),
 			"aws_trusted_advisor_check_result":                             tableAwsTrustedAdvisorCheckResult(ctx),
 			"aws_trusted_advisor_check_summary":                            tableAwsTrustedAdvisorCheckSummary(ctx),
+			"aws_verifiedpermissions_authorization_check":                  tableAwsVerifiedPermissionsAuthorizationCheck(ctx),
+			"aws_verifiedpermissions_identity_source":                      tableAwsVerifiedPermissionsIdentitySource(ctx),
+			"aws_verifiedpermissions_policy_store":                         tableAwsVerifiedPermissionsPolicyStore(ctx),
+			"aws_verifiedpermissions_policy_template":                      tableAwsVerifiedPermissionsPolicyTemplate(ctx),
+			"aws_verifiedpermissions_policy":                               tableAwsVerifiedPermissionsPolicy(ctx),
+			"aws_verifiedpermissions_schema":                               tableAwsVerifiedPermissionsSchema(ctx),
 			"aws_vpc_block_public_access_options":                          tableAwsVpcBlockPublicAccessOptions(ctx),
+			"aws_vpc_cidr_overlap":                                         tableAwsVpcCidrOverlap(ctx),
 			"aws_vpc_customer_gateway":                                     tableAwsVpcCustomerGateway(ctx),
 			"aws_vpc_dhcp_options":                                         tableAwsVpcDhcpOptions(ctx),
 			"aws_vpc_egress_only_internet_gateway":                         tableAwsVpcEgressOnlyIGW(ctx),
@@ -772,6 +879,7 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"aws_vpc_security_group_rule":                                  tableAwsVpcSecurityGroupRule(ctx),
 			"aws_vpc_security_group":                                       tableAwsVpcSecurityGroup(ctx),
 			"aws_vpc_security_group_vpc_association":                       tableAwsVpcSecurityGroupVpcAssociation(ctx),
+			"aws_vpc_subnet_capacity":                                      tableAwsVpcSubnetCapacity(ctx),
 			"aws_vpc_subnet":                                               tableAwsVpcSubnet(ctx),
 			"aws_vpc_verified_access_endpoint":                             tableAwsVpcVerifiedAccessEndpoint(ctx),
 			"aws_vpc_verified_access_group":                                tableAwsVpcVerifiedAccessGroup(ctx),
@@ -779,6 +887,14 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"aws_vpc_verified_access_trust_provider":                       tableAwsVpcVerifiedAccessTrustProvider(ctx),
 			"aws_vpc_vpn_connection":                                       tableAwsVpcVpnConnection(ctx),
 			"aws_vpc_vpn_gateway":                                          tableAwsVpcVpnGateway(ctx),
+			"aws_vpclattice_listener":                                      tableAwsVpcLatticeListener(ctx),
+			"aws_vpclattice_rule":                                          tableAwsVpcLatticeRule(ctx),
+			"aws_vpclattice_service_network_service_association":           tableAwsVpcLatticeServiceNetworkServiceAssociation(ctx),
+			"aws_vpclattice_service_network_vpc_association":               tableAwsVpcLatticeServiceNetworkVpcAssociation(ctx),
+			"aws_vpclattice_service_network":                               tableAwsVpcLatticeServiceNetwork(ctx),
+			"aws_vpclattice_service":                                       tableAwsVpcLatticeService(ctx),
+			"aws_vpclattice_target_group":                                  tableAwsVpcLatticeTargetGroup(ctx),
+			"aws_vpclattice_target":                                        tableAwsVpcLatticeTarget(ctx),
 			"aws_vpc":                                                      tableAwsVpc(ctx),
 			"aws_waf_rate_based_rule":                                      tableAwsWafRateBasedRule(ctx),
 			"aws_waf_rule_group":                                           tableAwsWafRuleGroup(ctx),
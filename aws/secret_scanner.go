@@ -0,0 +1,247 @@
+package aws
+
+import (
+	"math"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// SecretFinding is a likely plaintext secret found in a configuration value
+type SecretFinding struct {
+	RuleId        string
+	Description   string
+	FieldPath     string
+	RedactedMatch string
+	Entropy       float64
+}
+
+type secretScannerRule struct {
+	id          string
+	description string
+	pattern     *regexp.Regexp
+	// group is the capture group holding the secret, 0 for the whole match
+	group int
+}
+
+// secretScannerRules are the provider specific patterns, checked before the
+// generic entropy based detection
+var secretScannerRules = []secretScannerRule{
+	{
+		id:          "aws_access_key_id",
+		description: "AWS access key ID",
+		pattern:     regexp.MustCompile(`\b((?:AKIA|ASIA|ABIA|ACCA)[0-9A-Z]{16})\b`),
+		group:       1,
+	},
+	{
+		id:          "aws_secret_access_key",
+		description: "AWS secret access key",
+		pattern:     regexp.MustCompile(`(?i)aws_?secret_?(?:access_?)?key["'\s]*[:=]\s*["']?([A-Za-z0-9/+]{40})(?:[^A-Za-z0-9/+]|$)`),
+		group:       1,
+	},
+	{
+		id:          "github_token",
+		description: "GitHub token",
+		pattern:     regexp.MustCompile(`\b((?:ghp|gho|ghu|ghs|ghr)_[A-Za-z0-9]{36}|github_pat_[A-Za-z0-9_]{82})\b`),
+		group:       1,
+	},
+	{
+		id:          "private_key",
+		description: "Private key",
+		pattern:     regexp.MustCompile(`-----BEGIN (?:[A-Z0-9]+ )*PRIVATE KEY(?: BLOCK)?-----`),
+	},
+	{
+		id:          "jdbc_password",
+		description: "JDBC connection string password",
+		pattern:     regexp.MustCompile(`(?i)jdbc:[a-z0-9:]+[^\s"']*?[;?&]password=([^;&\s"']+)`),
+		group:       1,
+	},
+	{
+		id:          "jdbc_password",
+		description: "JDBC connection string password",
+		pattern:     regexp.MustCompile(`(?i)jdbc:[a-z0-9:]+//[^:/@\s"']+:([^@/\s"']+)@`),
+		group:       1,
+	},
+}
+
+var (
+	// secretScannerKeyNamePattern matches names of keys that usually hold a secret
+	secretScannerKeyNamePattern = regexp.MustCompile(`(?i)(passw(or)?d|pwd|secret|token|api_?key|access_?key|private_?key|credential)`)
+
+	// secretScannerAwsSecretKeyPattern matches a value shaped like an AWS secret access key
+	secretScannerAwsSecretKeyPattern = regexp.MustCompile(`^[A-Za-z0-9/+]{40}$`)
+
+	// secretScannerTokenPattern matches long base64 or hex like tokens in free text
+	secretScannerTokenPattern = regexp.MustCompile(`[A-Za-z0-9+/_\-]{32,}={0,2}`)
+
+	// secretScannerAssignmentPattern matches a secret-like key assigned a value in free text
+	secretScannerAssignmentPattern = regexp.MustCompile(`(?i)\b([\w.-]*(?:passw(?:or)?d|pwd|secret|token|api_?key)[\w.-]*)["']?\s*[:=]\s*["']?([^\s"';&]+)`)
+
+	// secretScannerReferencePattern matches values that point at a secret instead
+	// of holding it, and URLs or paths without credentials
+	secretScannerReferencePattern = regexp.MustCompile(`^(arn:|\{\{resolve:|\$\{|ssm:|secretsmanager:|/|[a-zA-Z][a-zA-Z0-9+.\-]*://[^@\s]*$)`)
+)
+
+const (
+	// Minimum entropy of the value of a secret-like key
+	secretScannerKeyedEntropy = 3.0
+	// Minimum entropy of a long token found in free text
+	secretScannerTokenEntropy = 4.5
+	// Minimum length of a value to be considered by the entropy checks
+	secretScannerMinLength = 8
+)
+
+// scanForSecrets returns the likely secrets in a configuration value. The name
+// is the key the value is stored under, if any, e.g. an environment variable
+// name, and is used to detect generic secrets such as DB_PASSWORD=...
+func scanForSecrets(fieldPath string, name string, value string) []SecretFinding {
+	var findings []SecretFinding
+	matched := map[string]bool{}
+
+	for _, rule := range secretScannerRules {
+		for _, m := range rule.pattern.FindAllStringSubmatch(value, -1) {
+			secret := m[rule.group]
+			if matched[secret] {
+				continue
+			}
+			matched[secret] = true
+			findings = append(findings, SecretFinding{
+				RuleId:        rule.id,
+				Description:   rule.description,
+				FieldPath:     fieldPath,
+				RedactedMatch: redactSecret(secret),
+			})
+		}
+	}
+
+	trimmed := strings.TrimSpace(value)
+	if name != "" && len(findings) == 0 && secretScannerKeyNamePattern.MatchString(name) {
+		// An AWS secret access key stored without its key ID
+		if strings.Contains(strings.ToLower(name), "aws") && secretScannerAwsSecretKeyPattern.MatchString(trimmed) {
+			return append(findings, SecretFinding{
+				RuleId:        "aws_secret_access_key",
+				Description:   "AWS secret access key",
+				FieldPath:     fieldPath,
+				RedactedMatch: redactSecret(trimmed),
+			})
+		}
+		if finding := genericSecretFinding(fieldPath, trimmed); finding != nil {
+			return append(findings, *finding)
+		}
+	}
+
+	// Free text, e.g. user data scripts, may assign secrets inline
+	if name == "" {
+		for _, m := range secretScannerAssignmentPattern.FindAllStringSubmatch(value, -1) {
+			if matched[m[2]] || secretScannerOverlaps(m[2], matched) {
+				continue
+			}
+			if finding := genericSecretFinding(fieldPath, m[2]); finding != nil {
+				matched[m[2]] = true
+				findings = append(findings, *finding)
+			}
+		}
+	}
+
+	for _, token := range secretScannerTokenPattern.FindAllString(value, -1) {
+		if matched[token] || secretScannerOverlaps(token, matched) || !secretScannerHasLetterAndDigit(token) {
+			continue
+		}
+		if entropy := shannonEntropy(token); entropy >= secretScannerTokenEntropy {
+			matched[token] = true
+			findings = append(findings, SecretFinding{
+				RuleId:        "high_entropy_string",
+				Description:   "High entropy string",
+				FieldPath:     fieldPath,
+				RedactedMatch: redactSecret(token),
+				Entropy:       entropy,
+			})
+		}
+	}
+
+	return findings
+}
+
+// scanMapForSecrets scans every value of a map, using the keys as names and
+// appending them to the field path, e.g. environment_variables.DB_PASSWORD
+func scanMapForSecrets(fieldPath string, values map[string]string) []SecretFinding {
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var findings []SecretFinding
+	for _, k := range keys {
+		findings = append(findings, scanForSecrets(fieldPath+"."+k, k, values[k])...)
+	}
+	return findings
+}
+
+// genericSecretFinding returns a finding if the value of a secret-like key
+// looks random enough to be a real secret rather than a placeholder
+func genericSecretFinding(fieldPath string, value string) *SecretFinding {
+	if len(value) < secretScannerMinLength || strings.ContainsAny(value, " \t\n") || secretScannerReferencePattern.MatchString(value) {
+		return nil
+	}
+	entropy := shannonEntropy(value)
+	if entropy < secretScannerKeyedEntropy {
+		return nil
+	}
+	return &SecretFinding{
+		RuleId:        "generic_secret",
+		Description:   "High entropy value of a secret-like key",
+		FieldPath:     fieldPath,
+		RedactedMatch: redactSecret(value),
+		Entropy:       entropy,
+	}
+}
+
+// secretScannerHasLetterAndDigit filters out long words and paths, which
+// rarely contain digits, from the free text token check
+func secretScannerHasLetterAndDigit(token string) bool {
+	return strings.ContainsAny(token, "0123456789") && strings.ContainsAny(token, "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ")
+}
+
+// secretScannerOverlaps reports whether a token contains, or is part of, a
+// secret that was already reported by a provider specific rule
+func secretScannerOverlaps(token string, matched map[string]bool) bool {
+	for secret := range matched {
+		if strings.Contains(token, secret) || strings.Contains(secret, token) {
+			return true
+		}
+	}
+	return false
+}
+
+// shannonEntropy returns the Shannon entropy of a string in bits per character
+func shannonEntropy(s string) float64 {
+	if s == "" {
+		return 0
+	}
+	counts := map[rune]int{}
+	total := 0
+	for _, r := range s {
+		counts[r]++
+		total++
+	}
+
+	var entropy float64
+	for _, c := range counts {
+		p := float64(c) / float64(total)
+		entropy -= p * math.Log2(p)
+	}
+	return entropy
+}
+
+// redactSecret keeps a short prefix of a secret to recognise its kind, e.g.
+// AKIA****, and masks the rest. Short values are fully masked.
+func redactSecret(secret string) string {
+	if len(secret) <= 8 {
+		return "****"
+	}
+	if len(secret) <= 16 {
+		return secret[:2] + "****"
+	}
+	return secret[:4] + "****"
+}
@@ -59,6 +59,8 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/databasemigrationservice"
 	"github.com/aws/aws-sdk-go-v2/service/datasync"
 	"github.com/aws/aws-sdk-go-v2/service/dax"
+	"github.com/aws/aws-sdk-go-v2/service/detective"
+	"github.com/aws/aws-sdk-go-v2/service/directconnect"
 	"github.com/aws/aws-sdk-go-v2/service/directoryservice"
 	"github.com/aws/aws-sdk-go-v2/service/dlm"
 	"github.com/aws/aws-sdk-go-v2/service/docdb"
@@ -87,6 +89,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/health"
 	"github.com/aws/aws-sdk-go-v2/service/iam"
 	"github.com/aws/aws-sdk-go-v2/service/identitystore"
+	"github.com/aws/aws-sdk-go-v2/service/imagebuilder"
 	"github.com/aws/aws-sdk-go-v2/service/inspector"
 	"github.com/aws/aws-sdk-go-v2/service/inspector2"
 	"github.com/aws/aws-sdk-go-v2/service/iot"
@@ -107,6 +110,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/mq"
 	"github.com/aws/aws-sdk-go-v2/service/neptune"
 	"github.com/aws/aws-sdk-go-v2/service/networkfirewall"
+	"github.com/aws/aws-sdk-go-v2/service/networkmanager"
 	"github.com/aws/aws-sdk-go-v2/service/oam"
 	"github.com/aws/aws-sdk-go-v2/service/opensearch"
 	"github.com/aws/aws-sdk-go-v2/service/organizations"
@@ -123,6 +127,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/rolesanywhere"
 	"github.com/aws/aws-sdk-go-v2/service/route53"
 	"github.com/aws/aws-sdk-go-v2/service/route53domains"
+	"github.com/aws/aws-sdk-go-v2/service/route53profiles"
 	"github.com/aws/aws-sdk-go-v2/service/route53resolver"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/s3control"
@@ -130,6 +135,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/sagemaker"
 	"github.com/aws/aws-sdk-go-v2/service/savingsplans"
 	"github.com/aws/aws-sdk-go-v2/service/scheduler"
+	"github.com/aws/aws-sdk-go-v2/service/schemas"
 	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
 	"github.com/aws/aws-sdk-go-v2/service/securityhub"
 	"github.com/aws/aws-sdk-go-v2/service/securitylake"
@@ -152,6 +158,8 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/synthetics"
 	"github.com/aws/aws-sdk-go-v2/service/timestreamwrite"
 	"github.com/aws/aws-sdk-go-v2/service/transfer"
+	"github.com/aws/aws-sdk-go-v2/service/verifiedpermissions"
+	"github.com/aws/aws-sdk-go-v2/service/vpclattice"
 	"github.com/aws/aws-sdk-go-v2/service/waf"
 	"github.com/aws/aws-sdk-go-v2/service/wafregional"
 	"github.com/aws/aws-sdk-go-v2/service/wafv2"
@@ -184,6 +192,17 @@ func AccessAnalyzerClient(ctx context.Context, d *plugin.QueryData) (*accessanal
 	return accessanalyzer.NewFromConfig(*cfg), nil
 }
 
+// AccessAnalyzerClientDefaultRegion returns an Access Analyzer client bound to
+// the default region. It is used by the policy checks, which return the same
+// results regardless of the region the request is made in.
+func AccessAnalyzerClientDefaultRegion(ctx context.Context, d *plugin.QueryData) (*accessanalyzer.Client, error) {
+	cfg, err := getClientForDefaultRegion(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+	return accessanalyzer.NewFromConfig(*cfg), nil
+}
+
 // AccountClient is used to query general information about an AWS account.
 func AccountClient(ctx context.Context, d *plugin.QueryData) (*account.Client, error) {
 	// Use the client region - service is global but available in all regions.
@@ -610,6 +629,39 @@ func DAXClient(ctx context.Context, d *plugin.QueryData) (*dax.Client, error) {
 	return dax.NewFromConfig(*cfg), nil
 }
 
+func DetectiveClient(ctx context.Context, d *plugin.QueryData) (*detective.Client, error) {
+	cfg, err := getClientForQuerySupportedRegion(ctx, d, AWS_API_DETECTIVE_SERVICE_ID)
+	if err != nil {
+		return nil, err
+	}
+	if cfg == nil {
+		return nil, nil
+	}
+	return detective.NewFromConfig(*cfg), nil
+}
+
+func DirectConnectClient(ctx context.Context, d *plugin.QueryData) (*directconnect.Client, error) {
+	cfg, err := getClientForQuerySupportedRegion(ctx, d, AWS_DIRECTCONNECT_SERVICE_ID)
+	if err != nil {
+		return nil, err
+	}
+	if cfg == nil {
+		return nil, nil
+	}
+	return directconnect.NewFromConfig(*cfg), nil
+}
+
+// DirectConnectGlobalClient is used for Direct Connect gateways, which are
+// global resources. Every region returns the same gateways, so we always use
+// the default region.
+func DirectConnectGlobalClient(ctx context.Context, d *plugin.QueryData) (*directconnect.Client, error) {
+	cfg, err := getClientForDefaultRegion(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+	return directconnect.NewFromConfig(*cfg), nil
+}
+
 func DirectoryServiceClient(ctx context.Context, d *plugin.QueryData) (*directoryservice.Client, error) {
 	cfg, err := getClientForQuerySupportedRegion(ctx, d, AWS_DS_SERVICE_ID)
 	if err != nil {
@@ -935,6 +987,14 @@ func IdentityStoreClient(ctx context.Context, d *plugin.QueryData) (*identitysto
 	return identitystore.NewFromConfig(*cfg), nil
 }
 
+func ImageBuilderClient(ctx context.Context, d *plugin.QueryData) (*imagebuilder.Client, error) {
+	cfg, err := getClientForQueryRegion(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+	return imagebuilder.NewFromConfig(*cfg), nil
+}
+
 func InspectorClient(ctx context.Context, d *plugin.QueryData) (*inspector.Client, error) {
 	cfg, err := getClientForQuerySupportedRegion(ctx, d, AWS_INSPECTOR_SERVICE_ID)
 	if err != nil {
@@ -1146,6 +1206,19 @@ func NetworkFirewallClient(ctx context.Context, d *plugin.QueryData) (*networkfi
 	return networkfirewall.NewFromConfig(*cfg), nil
 }
 
+func NetworkManagerClient(ctx context.Context, d *plugin.QueryData) (*networkmanager.Client, error) {
+	// Network Manager is a global service whose home region is us-west-2. All
+	// global networks, core networks, sites and links are managed through that
+	// region, regardless of where the attached resources are, so we hard code
+	// it here in the same way as Global Accelerator.
+	// https://docs.aws.amazon.com/network-manager/latest/tgwnm/what-are-global-networks.html
+	cfg, err := getClient(ctx, d, "us-west-2")
+	if err != nil {
+		return nil, err
+	}
+	return networkmanager.NewFromConfig(*cfg), nil
+}
+
 func OAMClient(ctx context.Context, d *plugin.QueryData) (*oam.Client, error) {
 	cfg, err := getClientForQuerySupportedRegion(ctx, d, AWS_OAM_SERVICE_ID)
 	if err != nil {
@@ -1428,6 +1501,17 @@ func Route53DomainsClient(ctx context.Context, d *plugin.QueryData) (*route53dom
 	return route53domains.NewFromConfig(*cfg), nil
 }
 
+func Route53ProfilesClient(ctx context.Context, d *plugin.QueryData) (*route53profiles.Client, error) {
+	cfg, err := getClientForQuerySupportedRegion(ctx, d, AWS_ROUTE53PROFILES_SERVICE_ID)
+	if err != nil {
+		return nil, err
+	}
+	if cfg == nil {
+		return nil, nil
+	}
+	return route53profiles.NewFromConfig(*cfg), nil
+}
+
 func Route53ResolverClient(ctx context.Context, d *plugin.QueryData) (*route53resolver.Client, error) {
 	cfg, err := getClientForQuerySupportedRegion(ctx, d, AWS_ROUTE53RESOLVER_SERVICE_ID)
 	if err != nil {
@@ -1531,6 +1615,17 @@ func SchedulerClient(ctx context.Context, d *plugin.QueryData) (*scheduler.Clien
 	return scheduler.NewFromConfig(*cfg), nil
 }
 
+func SchemasClient(ctx context.Context, d *plugin.QueryData) (*schemas.Client, error) {
+	cfg, err := getClientForQuerySupportedRegion(ctx, d, AWS_SCHEMAS_SERVICE_ID)
+	if err != nil {
+		return nil, err
+	}
+	if cfg == nil {
+		return nil, nil
+	}
+	return schemas.NewFromConfig(*cfg), nil
+}
+
 func SecretsManagerClient(ctx context.Context, d *plugin.QueryData) (*secretsmanager.Client, error) {
 	cfg, err := getClientForQueryRegion(ctx, d)
 	if err != nil {
@@ -1777,6 +1872,28 @@ func TimestreamwriteClient(ctx context.Context, d *plugin.QueryData) (*timestrea
 	return timestreamwrite.NewFromConfig(*cfg), nil
 }
 
+func VerifiedPermissionsClient(ctx context.Context, d *plugin.QueryData) (*verifiedpermissions.Client, error) {
+	cfg, err := getClientForQuerySupportedRegion(ctx, d, AWS_VERIFIEDPERMISSIONS_SERVICE_ID)
+	if err != nil {
+		return nil, err
+	}
+	if cfg == nil {
+		return nil, nil
+	}
+	return verifiedpermissions.NewFromConfig(*cfg), nil
+}
+
+func VPCLatticeClient(ctx context.Context, d *plugin.QueryData) (*vpclattice.Client, error) {
+	cfg, err := getClientForQuerySupportedRegion(ctx, d, AWS_VPC_LATTICE_SERVICE_ID)
+	if err != nil {
+		return nil, err
+	}
+	if cfg == nil {
+		return nil, nil
+	}
+	return vpclattice.NewFromConfig(*cfg), nil
+}
+
 func WAFClient(ctx context.Context, d *plugin.QueryData) (*waf.Client, error) {
 	// WAF Classic a global service with a single DNS endpoint
 	// (waf.amazonaws.com).
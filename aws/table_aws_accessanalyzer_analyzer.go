@@ -84,6 +84,12 @@ func tableAwsAccessAnalyzer(_ context.Context) *plugin.Table {
 				Description: "The statusReason provides more details about the current status of the analyzer.",
 				Type:        proto.ColumnType_STRING,
 			},
+			{
+				Name:        "unused_access_age",
+				Description: "The number of days after which an unused access analyzer reports a role, user credential or permission as unused. Only set for unused access analyzers.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Configuration").Transform(accessAnalyzerUnusedAccessAge),
+			},
 
 			// Steampipe standard columns
 			{
@@ -233,3 +239,12 @@ func listAccessAnalyzerFindings(ctx context.Context, d *plugin.QueryData, h *plu
 
 	return findings, nil
 }
+
+//// TRANSFORM FUNCTIONS
+
+func accessAnalyzerUnusedAccessAge(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	if configuration, ok := d.Value.(*types.AnalyzerConfigurationMemberUnusedAccess); ok {
+		return configuration.Value.UnusedAccessAge, nil
+	}
+	return nil, nil
+}
@@ -0,0 +1,123 @@
+package aws
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer"
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+func tableAwsAccessAnalyzerCheckAccessNotGranted(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_accessanalyzer_check_access_not_granted",
+		Description: "AWS Access Analyzer Check Access Not Granted",
+		List: &plugin.ListConfig{
+			Hydrate: listAccessAnalyzerCheckAccessNotGranted,
+			Tags:    map[string]string{"service": "access-analyzer", "action": "CheckAccessNotGranted"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "policy_document", Require: plugin.Required},
+				{Name: "actions", Require: plugin.Required},
+				{Name: "policy_type", Require: plugin.Optional},
+			},
+		},
+		Columns: []*plugin.Column{
+			// "Key" Columns
+			{
+				Name:        "policy_document",
+				Description: "The JSON policy document to check.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("policy_document"),
+			},
+			{
+				Name:        "actions",
+				Description: "The actions that the policy must not grant, e.g. [\"s3:PutObject\", \"iam:PassRole\"].",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromQual("actions"),
+			},
+			{
+				Name:        "policy_type",
+				Description: "The type of the policy. Can be IDENTITY_POLICY or RESOURCE_POLICY. Defaults to IDENTITY_POLICY.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// Result columns
+			{
+				Name:        "result",
+				Description: "The result of the check. PASS if the policy doesn't grant any of the actions, FAIL otherwise.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "message",
+				Description: "The message that explains the result of the check.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "reasons",
+				Description: "The statements in the policy that grant the actions, with a description of why.",
+				Type:        proto.ColumnType_JSON,
+			},
+		},
+	}
+}
+
+type awsAccessAnalyzerCheckAccessNotGrantedResult struct {
+	PolicyType types.AccessCheckPolicyType
+	Result     types.CheckAccessNotGrantedResult
+	Message    *string
+	Reasons    []types.ReasonSummary
+}
+
+func listAccessAnalyzerCheckAccessNotGranted(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	policyDocument := d.EqualsQualString("policy_document")
+	actionsQual := d.EqualsQuals["actions"].GetJsonbValue()
+	if policyDocument == "" || actionsQual == "" {
+		return nil, nil
+	}
+
+	var actions []string
+	if err := json.Unmarshal([]byte(actionsQual), &actions); err != nil {
+		return nil, errors.New("unable to parse the 'actions' query parameter the value must be in the format '[\"s3:PutObject\", \"iam:PassRole\"]'")
+	}
+
+	policyType := types.AccessCheckPolicyTypeIdentityPolicy
+	if d.EqualsQualString("policy_type") != "" {
+		policyType = types.AccessCheckPolicyType(d.EqualsQualString("policy_type"))
+	}
+
+	// Create Session
+	svc, err := AccessAnalyzerClientDefaultRegion(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_accessanalyzer_check_access_not_granted.listAccessAnalyzerCheckAccessNotGranted", "client_error", err)
+		return nil, err
+	}
+
+	params := &accessanalyzer.CheckAccessNotGrantedInput{
+		PolicyDocument: aws.String(policyDocument),
+		PolicyType:     policyType,
+		Access: []types.Access{
+			{Actions: actions},
+		},
+	}
+
+	op, err := svc.CheckAccessNotGranted(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_accessanalyzer_check_access_not_granted.listAccessAnalyzerCheckAccessNotGranted", "api_error", err)
+		return nil, err
+	}
+
+	d.StreamListItem(ctx, awsAccessAnalyzerCheckAccessNotGrantedResult{
+		PolicyType: policyType,
+		Result:     op.Result,
+		Message:    op.Message,
+		Reasons:    op.Reasons,
+	})
+
+	return nil, nil
+}
@@ -0,0 +1,114 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer"
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+func tableAwsAccessAnalyzerCheckNoNewAccess(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_accessanalyzer_check_no_new_access",
+		Description: "AWS Access Analyzer Check No New Access",
+		List: &plugin.ListConfig{
+			Hydrate: listAccessAnalyzerCheckNoNewAccess,
+			Tags:    map[string]string{"service": "access-analyzer", "action": "CheckNoNewAccess"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "new_policy_document", Require: plugin.Required},
+				{Name: "existing_policy_document", Require: plugin.Required},
+				{Name: "policy_type", Require: plugin.Optional},
+			},
+		},
+		Columns: []*plugin.Column{
+			// "Key" Columns
+			{
+				Name:        "new_policy_document",
+				Description: "The JSON policy document to check for new access.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("new_policy_document"),
+			},
+			{
+				Name:        "existing_policy_document",
+				Description: "The JSON policy document that the new policy is compared to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("existing_policy_document"),
+			},
+			{
+				Name:        "policy_type",
+				Description: "The type of the policies. Can be IDENTITY_POLICY or RESOURCE_POLICY. Defaults to IDENTITY_POLICY.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// Result columns
+			{
+				Name:        "result",
+				Description: "The result of the check. PASS if the new policy doesn't allow new access, FAIL otherwise.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "message",
+				Description: "The message that explains the result of the check.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "reasons",
+				Description: "The statements in the new policy that allow new access, with a description of why.",
+				Type:        proto.ColumnType_JSON,
+			},
+		},
+	}
+}
+
+type awsAccessAnalyzerCheckNoNewAccessResult struct {
+	PolicyType types.AccessCheckPolicyType
+	Result     types.CheckNoNewAccessResult
+	Message    *string
+	Reasons    []types.ReasonSummary
+}
+
+func listAccessAnalyzerCheckNoNewAccess(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	newPolicyDocument := d.EqualsQualString("new_policy_document")
+	existingPolicyDocument := d.EqualsQualString("existing_policy_document")
+	if newPolicyDocument == "" || existingPolicyDocument == "" {
+		return nil, nil
+	}
+
+	policyType := types.AccessCheckPolicyTypeIdentityPolicy
+	if d.EqualsQualString("policy_type") != "" {
+		policyType = types.AccessCheckPolicyType(d.EqualsQualString("policy_type"))
+	}
+
+	// Create Session
+	svc, err := AccessAnalyzerClientDefaultRegion(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_accessanalyzer_check_no_new_access.listAccessAnalyzerCheckNoNewAccess", "client_error", err)
+		return nil, err
+	}
+
+	params := &accessanalyzer.CheckNoNewAccessInput{
+		NewPolicyDocument:      aws.String(newPolicyDocument),
+		ExistingPolicyDocument: aws.String(existingPolicyDocument),
+		PolicyType:             policyType,
+	}
+
+	op, err := svc.CheckNoNewAccess(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_accessanalyzer_check_no_new_access.listAccessAnalyzerCheckNoNewAccess", "api_error", err)
+		return nil, err
+	}
+
+	d.StreamListItem(ctx, awsAccessAnalyzerCheckNoNewAccessResult{
+		PolicyType: policyType,
+		Result:     op.Result,
+		Message:    op.Message,
+		Reasons:    op.Reasons,
+	})
+
+	return nil, nil
+}
@@ -0,0 +1,191 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer"
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+func tableAwsAccessAnalyzerPolicyValidation(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_accessanalyzer_policy_validation",
+		Description: "AWS Access Analyzer Policy Validation",
+		List: &plugin.ListConfig{
+			Hydrate: listAccessAnalyzerPolicyValidationFindings,
+			Tags:    map[string]string{"service": "access-analyzer", "action": "ValidatePolicy"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "policy_document", Require: plugin.Required},
+				{Name: "policy_type", Require: plugin.Optional},
+				{Name: "validate_policy_resource_type", Require: plugin.Optional},
+				{Name: "locale", Require: plugin.Optional},
+			},
+		},
+		Columns: []*plugin.Column{
+			// "Key" Columns
+			{
+				Name:        "policy_document",
+				Description: "The JSON policy document to validate.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("policy_document"),
+			},
+			{
+				Name:        "policy_type",
+				Description: "The type of policy to validate. Can be one of IDENTITY_POLICY, RESOURCE_POLICY or SERVICE_CONTROL_POLICY. Defaults to IDENTITY_POLICY.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "validate_policy_resource_type",
+				Description: "The type of resource to attach to a resource policy, e.g. AWS::S3::Bucket. Runs additional checks for the service that the resource policy applies to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("validate_policy_resource_type"),
+			},
+			{
+				Name:        "locale",
+				Description: "The locale to use for the finding details. Defaults to EN.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// Result columns
+			{
+				Name:        "finding_type",
+				Description: "The type of the finding. Can be one of ERROR, SECURITY_WARNING, SUGGESTION or WARNING.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "issue_code",
+				Description: "The issue code of the finding, e.g. MISSING_VERSION.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "finding_details",
+				Description: "A localized message that explains the finding.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "learn_more_link",
+				Description: "A link to additional documentation about the finding.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "locations",
+				Description: "The locations in the policy document that are related to the finding, as a path such as Statement[0].Action[1] and the span of text in the document.",
+				Type:        proto.ColumnType_JSON,
+			},
+		},
+	}
+}
+
+type AccessAnalyzerPolicyValidationFinding struct {
+	types.ValidatePolicyFinding
+	PolicyType types.PolicyType
+	Locale     types.Locale
+	Locations  []AccessAnalyzerPolicyLocation
+}
+
+// AccessAnalyzerPolicyLocation is a location in a policy document, with the
+// path rendered as a string instead of a list of path elements
+type AccessAnalyzerPolicyLocation struct {
+	Path string
+	Span *types.Span
+}
+
+func listAccessAnalyzerPolicyValidationFindings(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	policyDocument := d.EqualsQualString("policy_document")
+	if policyDocument == "" {
+		return nil, nil
+	}
+
+	policyType := types.PolicyTypeIdentityPolicy
+	if d.EqualsQualString("policy_type") != "" {
+		policyType = types.PolicyType(d.EqualsQualString("policy_type"))
+	}
+
+	locale := types.LocaleEn
+	if d.EqualsQualString("locale") != "" {
+		locale = types.Locale(d.EqualsQualString("locale"))
+	}
+
+	// Create Session
+	svc, err := AccessAnalyzerClientDefaultRegion(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_accessanalyzer_policy_validation.listAccessAnalyzerPolicyValidationFindings", "client_error", err)
+		return nil, err
+	}
+
+	input := &accessanalyzer.ValidatePolicyInput{
+		PolicyDocument: aws.String(policyDocument),
+		PolicyType:     policyType,
+		Locale:         locale,
+	}
+	if d.EqualsQualString("validate_policy_resource_type") != "" {
+		input.ValidatePolicyResourceType = types.ValidatePolicyResourceType(d.EqualsQualString("validate_policy_resource_type"))
+	}
+
+	paginator := accessanalyzer.NewValidatePolicyPaginator(svc, input, func(o *accessanalyzer.ValidatePolicyPaginatorOptions) {
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_accessanalyzer_policy_validation.listAccessAnalyzerPolicyValidationFindings", "api_error", err)
+			return nil, err
+		}
+
+		for _, finding := range output.Findings {
+			row := AccessAnalyzerPolicyValidationFinding{
+				ValidatePolicyFinding: finding,
+				PolicyType:            policyType,
+				Locale:                locale,
+			}
+			for _, location := range finding.Locations {
+				row.Locations = append(row.Locations, AccessAnalyzerPolicyLocation{
+					Path: accessAnalyzerPolicyPath(location.Path),
+					Span: location.Span,
+				})
+			}
+			d.StreamListItem(ctx, row)
+
+			// Context may get canceled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// accessAnalyzerPolicyPath renders the path elements of a policy location the
+// way they would be written in JSON, e.g. Statement[0].Condition.StringEquals
+func accessAnalyzerPolicyPath(elements []types.PathElement) string {
+	var path strings.Builder
+	for _, element := range elements {
+		switch v := element.(type) {
+		case *types.PathElementMemberIndex:
+			fmt.Fprintf(&path, "[%d]", v.Value)
+		case *types.PathElementMemberKey:
+			if path.Len() > 0 {
+				path.WriteString(".")
+			}
+			path.WriteString(v.Value)
+		case *types.PathElementMemberValue:
+			fmt.Fprintf(&path, "[%q]", v.Value)
+		case *types.PathElementMemberSubstring:
+			start := aws.ToInt32(v.Value.Start)
+			fmt.Fprintf(&path, "[%d:%d]", start, start+aws.ToInt32(v.Value.Length))
+		}
+	}
+	return path.String()
+}
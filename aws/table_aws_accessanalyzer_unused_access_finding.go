@@ -0,0 +1,344 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer"
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer/types"
+	"github.com/aws/smithy-go"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+type AccessAnalyzerUnusedAccessFindingInfo struct {
+	types.FindingSummaryV2
+	AccessAnalyzerArn string
+}
+
+// AccessAnalyzerUnusedAccessDetails flattens the finding details of an unused
+// access finding. Which fields are set depends on the finding type.
+type AccessAnalyzerUnusedAccessDetails struct {
+	// The time the role, access key, password or permission was last used
+	LastAccessed *time.Time
+	// The access key of an UnusedIAMUserAccessKey finding
+	AccessKeyId *string
+	// The unused services and actions of an UnusedPermission finding
+	UnusedPermissions []types.UnusedPermissionDetails
+}
+
+//// TABLE DEFINITION
+
+func tableAwsAccessAnalyzerUnusedAccessFinding(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_accessanalyzer_unused_access_finding",
+		Description: "AWS Access Analyzer Unused Access Finding",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"id", "access_analyzer_arn"}),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException", "ValidationException"}),
+			},
+			Hydrate: getAccessAnalyzerUnusedAccessFinding,
+			Tags:    map[string]string{"service": "access-analyzer", "action": "GetFindingV2"},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listAccessAnalyzers,
+			Hydrate:       listAccessAnalyzerUnusedAccessFindings,
+			Tags:          map[string]string{"service": "access-analyzer", "action": "ListFindingsV2"},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException", "ValidationException"}),
+			},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "access_analyzer_arn", Require: plugin.Optional},
+				{Name: "finding_type", Require: plugin.Optional},
+				{Name: "resource", Require: plugin.Optional},
+				{Name: "resource_owner_account", Require: plugin.Optional},
+				{Name: "resource_type", Require: plugin.Optional},
+				{Name: "status", Require: plugin.Optional},
+			},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getAccessAnalyzerUnusedAccessFindingDetails,
+				Tags: map[string]string{"service": "access-analyzer", "action": "GetFindingV2"},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(AWS_ACCESS_ANALYZER_SERVICE_ID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "access_analyzer_arn",
+				Description: "The Amazon Resource Name (ARN) of the analyzer that generated the finding.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the finding.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "finding_type",
+				Description: "The type of the finding. Can be one of UnusedIAMRole, UnusedIAMUserAccessKey, UnusedIAMUserPassword or UnusedPermission.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "resource",
+				Description: "The IAM role or user that the finding is about.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "resource_type",
+				Description: "The type of the resource, e.g. AWS::IAM::Role or AWS::IAM::User.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "resource_owner_account",
+				Description: "The Amazon Web Services account ID that owns the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "The status of the finding. Can be one of ACTIVE, ARCHIVED or RESOLVED.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "error",
+				Description: "The error that resulted in an Error finding.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "analyzed_at",
+				Description: "The time at which the resource was analyzed.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "created_at",
+				Description: "The time at which the finding was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "updated_at",
+				Description: "The time at which the finding was most recently updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "last_accessed",
+				Description: "The time at which the unused role, access key or password was last used. Null if it has never been used, and for UnusedPermission findings.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Hydrate:     getAccessAnalyzerUnusedAccessFindingDetails,
+			},
+			{
+				Name:        "access_key_id",
+				Description: "The ID of the unused access key, for UnusedIAMUserAccessKey findings.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getAccessAnalyzerUnusedAccessFindingDetails,
+			},
+			{
+				Name:        "unused_permissions",
+				Description: "The unused services and actions, with the time they were last accessed, for UnusedPermission findings.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getAccessAnalyzerUnusedAccessFindingDetails,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Id"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAccessAnalyzerUnusedAccessFindings(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	analyzer := h.Item.(types.AnalyzerSummary)
+	arn := aws.ToString(analyzer.Arn)
+
+	// Only unused access analyzers have unused access findings
+	if analyzer.Type != types.TypeAccountUnusedAccess && analyzer.Type != types.TypeOrganizationUnusedAccess {
+		return nil, nil
+	}
+
+	// Minimize API call with given Access analyzer ARN
+	if d.EqualsQualString("access_analyzer_arn") != "" && d.EqualsQualString("access_analyzer_arn") != arn {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := AccessAnalyzerClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_accessanalyzer_unused_access_finding.listAccessAnalyzerUnusedAccessFindings", "client_error", err)
+		return nil, err
+	}
+
+	// The maximum number for MaxResults parameter is not defined by the API
+	// We have set the MaxResults to 1000 based on our test
+	maxItems := int32(1000)
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxItems {
+			if limit < 1 {
+				maxItems = int32(1)
+			} else {
+				maxItems = int32(limit)
+			}
+		}
+	}
+
+	input := &accessanalyzer.ListFindingsV2Input{
+		AnalyzerArn: aws.String(arn),
+		MaxResults:  aws.Int32(maxItems),
+		Filter:      map[string]types.Criterion{},
+	}
+
+	filterQuals := map[string]string{
+		"finding_type":           "findingType",
+		"resource":               "resource",
+		"resource_owner_account": "resourceOwnerAccount",
+		"resource_type":          "resourceType",
+		"status":                 "status",
+	}
+	for columnName, filterName := range filterQuals {
+		if d.EqualsQualString(columnName) != "" {
+			input.Filter[filterName] = types.Criterion{
+				Eq: []string{d.EqualsQualString(columnName)},
+			}
+		}
+	}
+
+	paginator := accessanalyzer.NewListFindingsV2Paginator(svc, input, func(o *accessanalyzer.ListFindingsV2PaginatorOptions) {
+		o.Limit = maxItems
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_accessanalyzer_unused_access_finding.listAccessAnalyzerUnusedAccessFindings", "api_error", err)
+			return nil, err
+		}
+
+		for _, finding := range output.Findings {
+			d.StreamListItem(ctx, AccessAnalyzerUnusedAccessFindingInfo{finding, arn})
+
+			// Context may get canceled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getAccessAnalyzerUnusedAccessFinding(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	id := d.EqualsQualString("id")
+	arn := d.EqualsQualString("access_analyzer_arn")
+
+	// check if id or arn is empty
+	if id == "" || arn == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := AccessAnalyzerClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_accessanalyzer_unused_access_finding.getAccessAnalyzerUnusedAccessFinding", "client_error", err)
+		return nil, err
+	}
+
+	params := &accessanalyzer.GetFindingV2Input{
+		AnalyzerArn: aws.String(arn),
+		Id:          aws.String(id),
+		MaxResults:  aws.Int32(1),
+	}
+
+	data, err := svc.GetFindingV2(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_accessanalyzer_unused_access_finding.getAccessAnalyzerUnusedAccessFinding", "api_error", err)
+		return nil, err
+	}
+
+	finding := types.FindingSummaryV2{
+		AnalyzedAt:           data.AnalyzedAt,
+		CreatedAt:            data.CreatedAt,
+		Error:                data.Error,
+		FindingType:          data.FindingType,
+		Id:                   data.Id,
+		Resource:             data.Resource,
+		ResourceOwnerAccount: data.ResourceOwnerAccount,
+		ResourceType:         data.ResourceType,
+		Status:               data.Status,
+		UpdatedAt:            data.UpdatedAt,
+	}
+
+	return AccessAnalyzerUnusedAccessFindingInfo{finding, arn}, nil
+}
+
+func getAccessAnalyzerUnusedAccessFindingDetails(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	finding := h.Item.(AccessAnalyzerUnusedAccessFindingInfo)
+
+	// Create Session
+	svc, err := AccessAnalyzerClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_accessanalyzer_unused_access_finding.getAccessAnalyzerUnusedAccessFindingDetails", "client_error", err)
+		return nil, err
+	}
+
+	params := &accessanalyzer.GetFindingV2Input{
+		AnalyzerArn: aws.String(finding.AccessAnalyzerArn),
+		Id:          finding.Id,
+	}
+
+	details := AccessAnalyzerUnusedAccessDetails{}
+
+	paginator := accessanalyzer.NewGetFindingV2Paginator(svc, params, func(o *accessanalyzer.GetFindingV2PaginatorOptions) {
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			var ae smithy.APIError
+			if errors.As(err, &ae) {
+				if ae.ErrorCode() == "ResourceNotFoundException" {
+					return nil, nil
+				}
+			}
+			plugin.Logger(ctx).Error("aws_accessanalyzer_unused_access_finding.getAccessAnalyzerUnusedAccessFindingDetails", "api_error", err)
+			return nil, err
+		}
+
+		for _, detail := range output.FindingDetails {
+			switch v := detail.(type) {
+			case *types.FindingDetailsMemberUnusedIamRoleDetails:
+				details.LastAccessed = v.Value.LastAccessed
+			case *types.FindingDetailsMemberUnusedIamUserAccessKeyDetails:
+				details.AccessKeyId = v.Value.AccessKeyId
+				details.LastAccessed = v.Value.LastAccessed
+			case *types.FindingDetailsMemberUnusedIamUserPasswordDetails:
+				details.LastAccessed = v.Value.LastAccessed
+			case *types.FindingDetailsMemberUnusedPermissionDetails:
+				details.UnusedPermissions = append(details.UnusedPermissions, v.Value)
+			}
+		}
+	}
+
+	return details, nil
+}
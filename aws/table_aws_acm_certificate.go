@@ -77,6 +77,20 @@ func tableAwsAcmCertificate(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Hydrate:     getAwsAcmCertificateProperties,
 			},
+			{
+				Name:        "certificate_decoded",
+				Description: "The decoded X.509 fields of the certificate, such as subject, subject alternative names, key usage, validity and serial number.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getAwsAcmCertificateProperties,
+				Transform:   transform.FromField("Certificate").Transform(decodeX509CertificatePem),
+			},
+			{
+				Name:        "certificate_chain_decoded",
+				Description: "The decoded X.509 fields of each certificate in the certificate chain.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getAwsAcmCertificateProperties,
+				Transform:   transform.FromField("CertificateChain").Transform(decodeX509CertificateChainPem),
+			},
 			{
 				Name:        "domain_name",
 				Description: "Fully qualified domain name (FQDN), such as www.example.com or example.com, for the certificate",
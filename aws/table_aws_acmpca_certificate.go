@@ -0,0 +1,469 @@
+package aws
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/acmpca"
+	"github.com/aws/aws-sdk-go-v2/service/acmpca/types"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3Types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/memoize"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+// AcmPcaCertificate is a certificate issued by a private CA, as listed in the
+// audit report of the CA
+type AcmPcaCertificate struct {
+	CertificateAuthorityArn *string
+	AuditReportId           *string
+	AwsAccountId            string     `json:"awsAccountId"`
+	CertificateArn          string     `json:"certificateArn"`
+	Serial                  string     `json:"serial"`
+	Subject                 string     `json:"subject"`
+	NotBefore               *time.Time `json:"-"`
+	NotAfter                *time.Time `json:"-"`
+	IssuedAt                *time.Time `json:"-"`
+	RevokedAt               *time.Time `json:"-"`
+	RevocationReason        string     `json:"revocationReason"`
+	TemplateArn             string     `json:"templateArn"`
+}
+
+//// TABLE DEFINITION
+
+func tableAwsAcmPcaCertificate(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_acmpca_certificate",
+		Description: "AWS ACM Private CA Certificate",
+		List: &plugin.ListConfig{
+			ParentHydrate: listAwsAcmPcaCertificateAuthorities,
+			Hydrate:       listAwsAcmPcaCertificates,
+			Tags:          map[string]string{"service": "acm-pca", "action": "CreateCertificateAuthorityAuditReport"},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException", "InvalidStateException"}),
+			},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "certificate_authority_arn", Require: plugin.Optional},
+			},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getAwsAcmPcaCertificate,
+				Tags: map[string]string{"service": "acm-pca", "action": "GetCertificate"},
+				IgnoreConfig: &plugin.IgnoreConfig{
+					ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException"}),
+				},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(AWS_ACM_PCA_SERVICE_ID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "certificate_arn",
+				Description: "The Amazon Resource Name (ARN) of the certificate.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "certificate_authority_arn",
+				Description: "The Amazon Resource Name (ARN) of the private CA that issued the certificate.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "serial",
+				Description: "The serial number of the certificate.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "subject",
+				Description: "The distinguished name of the certificate subject.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "not_before",
+				Description: "The time before which the certificate is not valid.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "not_after",
+				Description: "The time after which the certificate is not valid.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "issued_at",
+				Description: "The time at which the certificate was issued.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "revoked_at",
+				Description: "The time at which the certificate was revoked.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "revocation_reason",
+				Description: "The reason the certificate was revoked.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "template_arn",
+				Description: "The ARN of the template used to issue the certificate.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "resource_account_id",
+				Description: "The ID of the AWS account that requested the certificate.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AwsAccountId"),
+			},
+			{
+				Name:        "audit_report_id",
+				Description: "The ID of the audit report that lists the certificate.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "certificate",
+				Description: "The base64 PEM-encoded certificate.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getAwsAcmPcaCertificate,
+				Transform:   transform.FromField("Certificate"),
+			},
+			{
+				Name:        "certificate_chain",
+				Description: "The base64 PEM-encoded certificate chain that chains up to the root CA certificate.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getAwsAcmPcaCertificate,
+				Transform:   transform.FromField("CertificateChain"),
+			},
+			{
+				Name:        "certificate_decoded",
+				Description: "The decoded X.509 fields of the certificate, such as subject, subject alternative names, key usage, validity and serial number.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getAwsAcmPcaCertificate,
+				Transform:   transform.FromField("Certificate").Transform(decodeX509CertificatePem),
+			},
+			{
+				Name:        "certificate_chain_decoded",
+				Description: "The decoded X.509 fields of each certificate in the issuer chain.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getAwsAcmPcaCertificate,
+				Transform:   transform.FromField("CertificateChain").Transform(decodeX509CertificateChainPem),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Subject"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("CertificateArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAwsAcmPcaCertificates(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	ca := h.Item.(types.CertificateAuthority)
+
+	// Minimize the API call with the given CA ARN
+	if d.EqualsQualString("certificate_authority_arn") != "" && d.EqualsQualString("certificate_authority_arn") != aws.ToString(ca.Arn) {
+		return nil, nil
+	}
+
+	// Audit reports can only be created for CAs that have issued certificates
+	switch ca.Status {
+	case types.CertificateAuthorityStatusActive, types.CertificateAuthorityStatusDisabled, types.CertificateAuthorityStatusExpired:
+	default:
+		return nil, nil
+	}
+
+	report, err := getAwsAcmPcaAuditReport(ctx, d, h)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_acmpca_certificate.listAwsAcmPcaCertificates", "audit_report_error", err)
+		return nil, err
+	}
+
+	for _, certificate := range report.([]AcmPcaCertificate) {
+		d.StreamListItem(ctx, certificate)
+
+		// Context may get canceled due to manual cancellation or if the limit has been reached
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+// Private CA allows one audit report per CA every 30 minutes, so the parsed
+// report is cached for that long per connection and CA
+var getAwsAcmPcaAuditReportMemoized = plugin.HydrateFunc(getAwsAcmPcaAuditReportUncached).Memoize(memoize.WithCacheKeyFunction(getAwsAcmPcaAuditReportCacheKey), memoize.WithTtl(30*time.Minute))
+
+func getAwsAcmPcaAuditReport(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	return getAwsAcmPcaAuditReportMemoized(ctx, d, h)
+}
+
+func getAwsAcmPcaAuditReportCacheKey(_ context.Context, _ *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	ca := h.Item.(types.CertificateAuthority)
+	key := fmt.Sprintf("getAwsAcmPcaAuditReport-%s", aws.ToString(ca.Arn))
+	return key, nil
+}
+
+func getAwsAcmPcaAuditReportUncached(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	ca := h.Item.(types.CertificateAuthority)
+
+	awsSpcConfig := GetConfig(d.Connection)
+	if awsSpcConfig.AcmPcaAuditReportBucket == nil {
+		return nil, errors.New("acmpca_audit_report_bucket must be set in the connection config to query aws_acmpca_certificate")
+	}
+	bucket := aws.ToString(awsSpcConfig.AcmPcaAuditReportBucket)
+
+	// Create service
+	svc, err := ACMPCAClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_acmpca_certificate.getAwsAcmPcaAuditReportUncached", "connection_error", err)
+		return nil, err
+	}
+
+	bucketRegion, err := doGetBucketRegion(ctx, d, h, bucket)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_acmpca_certificate.getAwsAcmPcaAuditReportUncached", "get_bucket_region_error", err)
+		return nil, err
+	}
+	s3Svc, err := S3Client(ctx, d, bucketRegion)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_acmpca_certificate.getAwsAcmPcaAuditReportUncached", "client_error", err)
+		return nil, err
+	}
+
+	var reportId, key *string
+	created, createErr := svc.CreateCertificateAuthorityAuditReport(ctx, &acmpca.CreateCertificateAuthorityAuditReportInput{
+		CertificateAuthorityArn:   ca.Arn,
+		S3BucketName:              aws.String(bucket),
+		AuditReportResponseFormat: types.AuditReportResponseFormatJson,
+	})
+	if createErr != nil {
+		var ae smithy.APIError
+		if !errors.As(createErr, &ae) || !slices.Contains(acmPcaAuditReportRejectedErrors, ae.ErrorCode()) {
+			plugin.Logger(ctx).Error("aws_acmpca_certificate.getAwsAcmPcaAuditReportUncached", "api_error", createErr)
+			return nil, createErr
+		}
+
+		// The report cache is per process, so another process or connection
+		// may have created the report of the last 30 minutes. Read the latest
+		// report of the CA from the bucket instead.
+		reportId, key, err = getAcmPcaLatestAuditReportKey(ctx, d, s3Svc, bucket, aws.ToString(ca.Arn))
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_acmpca_certificate.getAwsAcmPcaAuditReportUncached", "list_objects_error", err)
+			return nil, err
+		}
+		if key == nil {
+			return nil, fmt.Errorf("cannot create an audit report of %s, Private CA allows one every 30 minutes, and no earlier report was found in bucket %s: %w", aws.ToString(ca.Arn), bucket, createErr)
+		}
+	} else {
+		reportId, key = created.AuditReportId, created.S3Key
+		if err := waitForAcmPcaAuditReport(ctx, svc, ca.Arn, reportId); err != nil {
+			plugin.Logger(ctx).Error("aws_acmpca_certificate.getAwsAcmPcaAuditReportUncached", "wait_error", err)
+			return nil, err
+		}
+	}
+
+	// Read the report from the bucket
+	object, err := s3Svc.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    key,
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_acmpca_certificate.getAwsAcmPcaAuditReportUncached", "get_object_error", err)
+		return nil, err
+	}
+	defer object.Body.Close()
+
+	content, err := io.ReadAll(object.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	certificates, err := parseAcmPcaAuditReport(content)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_acmpca_certificate.getAwsAcmPcaAuditReportUncached", "parse_error", err)
+		return nil, err
+	}
+	for i := range certificates {
+		certificates[i].CertificateAuthorityArn = ca.Arn
+		certificates[i].AuditReportId = reportId
+	}
+
+	return certificates, nil
+}
+
+func getAwsAcmPcaCertificate(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	certificate := h.Item.(AcmPcaCertificate)
+
+	// Create service
+	svc, err := ACMPCAClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_acmpca_certificate.getAwsAcmPcaCertificate", "connection_error", err)
+		return nil, err
+	}
+
+	params := &acmpca.GetCertificateInput{
+		CertificateAuthorityArn: certificate.CertificateAuthorityArn,
+		CertificateArn:          aws.String(certificate.CertificateArn),
+	}
+
+	op, err := svc.GetCertificate(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_acmpca_certificate.getAwsAcmPcaCertificate", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
+
+//// UTILITY FUNCTIONS
+
+// acmPcaAuditReportTimeout is how long to wait for an audit report to be
+// written to the bucket
+const acmPcaAuditReportTimeout = 5 * time.Minute
+
+// acmPcaAuditReportRejectedErrors are the errors returned when an audit report
+// of the CA was already created in the last 30 minutes
+var acmPcaAuditReportRejectedErrors = []string{"RequestInProgressException", "RequestFailedException", "LimitExceededException"}
+
+// waitForAcmPcaAuditReport waits for an audit report to be written to the
+// bucket, and returns an error if it failed or is not written in time.
+func waitForAcmPcaAuditReport(ctx context.Context, svc *acmpca.Client, caArn *string, reportId *string) error {
+	waitCtx, cancel := context.WithTimeout(ctx, acmPcaAuditReportTimeout)
+	defer cancel()
+
+	for {
+		report, err := svc.DescribeCertificateAuthorityAuditReport(ctx, &acmpca.DescribeCertificateAuthorityAuditReportInput{
+			CertificateAuthorityArn: caArn,
+			AuditReportId:           reportId,
+		})
+		if err != nil {
+			return err
+		}
+		switch report.AuditReportStatus {
+		case types.AuditReportStatusSuccess:
+			return nil
+		case types.AuditReportStatusCreating:
+		default:
+			return fmt.Errorf("audit report %s of %s failed", aws.ToString(reportId), aws.ToString(caArn))
+		}
+
+		select {
+		case <-waitCtx.Done():
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return fmt.Errorf("audit report %s of %s was not written within %s", aws.ToString(reportId), aws.ToString(caArn), acmPcaAuditReportTimeout)
+		case <-time.After(5 * time.Second):
+		}
+	}
+}
+
+// getAcmPcaLatestAuditReportKey returns the ID and object key of the most
+// recent JSON audit report of a CA in the bucket, or nil if there is none.
+// Private CA writes the reports to audit-report/<CA ID>/<report ID>.json
+func getAcmPcaLatestAuditReportKey(ctx context.Context, d *plugin.QueryData, svc *s3.Client, bucket string, caArn string) (*string, *string, error) {
+	caId := caArn[strings.LastIndex(caArn, "/")+1:]
+	paginator := s3.NewListObjectsV2Paginator(svc, &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+		Prefix: aws.String("audit-report/" + caId + "/"),
+	}, func(o *s3.ListObjectsV2PaginatorOptions) {
+		o.StopOnDuplicateToken = true
+	})
+
+	var latest *s3Types.Object
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+		for i, object := range output.Contents {
+			if !strings.HasSuffix(aws.ToString(object.Key), ".json") || object.LastModified == nil {
+				continue
+			}
+			if latest == nil || object.LastModified.After(*latest.LastModified) {
+				latest = &output.Contents[i]
+			}
+		}
+	}
+	if latest == nil {
+		return nil, nil, nil
+	}
+
+	reportId := strings.TrimSuffix(path.Base(aws.ToString(latest.Key)), ".json")
+	return aws.String(reportId), latest.Key, nil
+}
+
+// parseAcmPcaAuditReport parses a JSON audit report. Timestamps in the report
+// use a numeric zone without a colon, e.g. 2020-02-26T18:39:57+0000
+func parseAcmPcaAuditReport(content []byte) ([]AcmPcaCertificate, error) {
+	var entries []struct {
+		AcmPcaCertificate
+		NotBefore string `json:"notBefore"`
+		NotAfter  string `json:"notAfter"`
+		IssuedAt  string `json:"issuedAt"`
+		RevokedAt string `json:"revokedAt"`
+	}
+	if err := json.Unmarshal(content, &entries); err != nil {
+		return nil, err
+	}
+
+	certificates := make([]AcmPcaCertificate, 0, len(entries))
+	for _, entry := range entries {
+		certificate := entry.AcmPcaCertificate
+		for _, t := range []struct {
+			value  string
+			target **time.Time
+		}{
+			{entry.NotBefore, &certificate.NotBefore},
+			{entry.NotAfter, &certificate.NotAfter},
+			{entry.IssuedAt, &certificate.IssuedAt},
+			{entry.RevokedAt, &certificate.RevokedAt},
+		} {
+			if t.value == "" {
+				continue
+			}
+			parsed, err := parseAcmPcaAuditReportTime(t.value)
+			if err != nil {
+				return nil, err
+			}
+			*t.target = &parsed
+		}
+		certificates = append(certificates, certificate)
+	}
+
+	return certificates, nil
+}
+
+func parseAcmPcaAuditReportTime(value string) (time.Time, error) {
+	if t, err := time.Parse("2006-01-02T15:04:05-0700", value); err == nil {
+		return t, nil
+	}
+	return time.Parse(time.RFC3339, value)
+}
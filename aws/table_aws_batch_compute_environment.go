@@ -0,0 +1,215 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/batch"
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+func tableAwsBatchComputeEnvironment(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_batch_compute_environment",
+		Description: "AWS Batch Compute Environment",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("compute_environment_name"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ClientException"}),
+			},
+			Hydrate: getBatchComputeEnvironment,
+			Tags:    map[string]string{"service": "batch", "action": "DescribeComputeEnvironments"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listBatchComputeEnvironments,
+			Tags:    map[string]string{"service": "batch", "action": "DescribeComputeEnvironments"},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(AWS_BATCH_SERVICE_ID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "compute_environment_name",
+				Description: "The name of the compute environment",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the compute environment",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ComputeEnvironmentArn"),
+			},
+			{
+				Name:        "uuid",
+				Description: "The unique identifier of the compute environment",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type",
+				Description: "The type of the compute environment (MANAGED or UNMANAGED)",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "The state of the compute environment (ENABLED or DISABLED)",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "The current status of the compute environment (CREATING, UPDATING, DELETING, DELETED, VALID or INVALID)",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status_reason",
+				Description: "A short, human-readable string to provide additional details about the current status of the compute environment",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "container_orchestration_type",
+				Description: "The orchestration type of the compute environment (ECS or EKS)",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "service_role",
+				Description: "The service role that Batch uses to make calls to other AWS services on your behalf",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "ecs_cluster_arn",
+				Description: "The Amazon Resource Name (ARN) of the underlying Amazon ECS cluster that the compute environment uses",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "unmanaged_vcpus",
+				Description: "The maximum number of vCPUs expected to be used for an unmanaged compute environment",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("UnmanagedvCpus"),
+			},
+			{
+				Name:        "compute_resources_type",
+				Description: "The type of compute resources (EC2, SPOT, FARGATE or FARGATE_SPOT)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ComputeResources.Type"),
+			},
+			{
+				Name:        "compute_resources",
+				Description: "The compute resources defined for a managed compute environment, such as the instance types, subnets, security groups and vCPU limits",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "eks_configuration",
+				Description: "The configuration of the Amazon EKS cluster that supports the compute environment",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "update_policy",
+				Description: "The infrastructure update policy of the compute environment",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard columns for all tables
+			{
+				Name:        "tags",
+				Description: "The tags assigned to the compute environment",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ComputeEnvironmentName"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ComputeEnvironmentArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+func listBatchComputeEnvironments(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create service client
+	svc, err := BatchClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_batch_compute_environment.listBatchComputeEnvironments", "client_error", err)
+		return nil, err
+	}
+
+	// Unsupported region check
+	if svc == nil {
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			maxLimit = limit
+		}
+	}
+
+	input := &batch.DescribeComputeEnvironmentsInput{
+		MaxResults: aws.Int32(maxLimit),
+	}
+
+	paginator := batch.NewDescribeComputeEnvironmentsPaginator(svc, input)
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_batch_compute_environment.listBatchComputeEnvironments", "api_error", err)
+			return nil, err
+		}
+
+		for _, computeEnvironment := range output.ComputeEnvironments {
+			d.StreamListItem(ctx, computeEnvironment)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+func getBatchComputeEnvironment(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("compute_environment_name")
+	if name == "" {
+		return nil, nil
+	}
+
+	// Create service client
+	svc, err := BatchClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_batch_compute_environment.getBatchComputeEnvironment", "client_error", err)
+		return nil, err
+	}
+
+	// Unsupported region check
+	if svc == nil {
+		return nil, nil
+	}
+
+	input := &batch.DescribeComputeEnvironmentsInput{
+		ComputeEnvironments: []string{name},
+	}
+
+	output, err := svc.DescribeComputeEnvironments(ctx, input)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_batch_compute_environment.getBatchComputeEnvironment", "api_error", err)
+		return nil, err
+	}
+
+	if len(output.ComputeEnvironments) == 0 {
+		return nil, nil
+	}
+
+	return output.ComputeEnvironments[0], nil
+}
@@ -0,0 +1,366 @@
+package aws
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/batch"
+	"github.com/aws/aws-sdk-go-v2/service/batch/types"
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+func tableAwsBatchJob(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_batch_job",
+		Description: "AWS Batch Job",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("job_id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ClientException"}),
+			},
+			Hydrate: getBatchJob,
+			Tags:    map[string]string{"service": "batch", "action": "DescribeJobs"},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listBatchQueues,
+			Hydrate:       listBatchJobs,
+			Tags:          map[string]string{"service": "batch", "action": "ListJobs"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "job_queue", Require: plugin.Optional},
+				{Name: "status", Require: plugin.Optional},
+				{Name: "job_name", Require: plugin.Optional},
+				{Name: "job_definition", Require: plugin.Optional},
+				{Name: "created_at", Require: plugin.Optional, Operators: []string{">", ">=", "<", "<="}},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(AWS_BATCH_SERVICE_ID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "job_name",
+				Description: "The name of the job",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "job_id",
+				Description: "The job ID",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the job",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("JobArn"),
+			},
+			{
+				Name:        "job_queue",
+				Description: "The Amazon Resource Name (ARN) of the job queue that the job is associated with",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "job_definition",
+				Description: "The Amazon Resource Name (ARN) of the job definition that the job uses",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "The current status of the job (SUBMITTED, PENDING, RUNNABLE, STARTING, RUNNING, SUCCEEDED or FAILED)",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status_reason",
+				Description: "A short, human-readable string to provide more details about the current status of the job",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "created_at",
+				Description: "The time the job was created",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreatedAt").Transform(transform.UnixMsToTimestamp),
+			},
+			{
+				Name:        "started_at",
+				Description: "The time the job was started",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("StartedAt").Transform(transform.UnixMsToTimestamp),
+			},
+			{
+				Name:        "stopped_at",
+				Description: "The time the job stopped",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("StoppedAt").Transform(transform.UnixMsToTimestamp),
+			},
+			{
+				Name:        "is_cancelled",
+				Description: "Indicates whether the job is canceled",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "is_terminated",
+				Description: "Indicates whether the job is terminated",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "share_identifier",
+				Description: "The share identifier for the job, used by job queues with a fair share policy",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "scheduling_priority",
+				Description: "The scheduling priority of the job, used by job queues with a fair share policy",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "propagate_tags",
+				Description: "Specifies whether to propagate the tags from the job or job definition to the corresponding Amazon ECS task",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "platform_capabilities",
+				Description: "The platform capabilities required by the job definition (EC2 or FARGATE)",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "array_properties",
+				Description: "The array properties of the job, if it's an array job",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "attempts",
+				Description: "A list of job attempts that are associated with this job",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "container",
+				Description: "An object that represents the details for the container that's associated with the job",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "depends_on",
+				Description: "A list of job IDs that this job depends on",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "ecs_properties",
+				Description: "The properties of the Amazon ECS resources of the job",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "eks_attempts",
+				Description: "A list of job attempts that are associated with this job, for jobs running on Amazon EKS",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "eks_properties",
+				Description: "The properties of the job, for jobs running on Amazon EKS",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "node_details",
+				Description: "The details of the node of a multi-node parallel job",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "node_properties",
+				Description: "The node properties of a multi-node parallel job",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "parameters",
+				Description: "Additional parameters that are passed to the job, which replace parameter substitution placeholders or override the defaults of the job definition",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "retry_strategy",
+				Description: "The retry strategy to use for this job if an attempt fails",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "timeout",
+				Description: "The timeout configuration for the job",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard columns for all tables
+			{
+				Name:        "tags",
+				Description: "The tags assigned to the job",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("JobName"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("JobArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+// batchJobStatuses are the statuses ListJobs is called with, since without a
+// status or filter it only returns RUNNING jobs
+var batchJobStatuses = []types.JobStatus{
+	types.JobStatusSubmitted,
+	types.JobStatusPending,
+	types.JobStatusRunnable,
+	types.JobStatusStarting,
+	types.JobStatusRunning,
+	types.JobStatusSucceeded,
+	types.JobStatusFailed,
+}
+
+func listBatchJobs(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	queue := h.Item.(types.JobQueueDetail)
+
+	// Minimize the API call with the given job queue, which can be a name or an ARN
+	if jobQueue := d.EqualsQualString("job_queue"); jobQueue != "" && jobQueue != aws.ToString(queue.JobQueueName) && jobQueue != aws.ToString(queue.JobQueueArn) {
+		return nil, nil
+	}
+
+	// Create service client
+	svc, err := BatchClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_batch_job.listBatchJobs", "client_error", err)
+		return nil, err
+	}
+
+	// Unsupported region check
+	if svc == nil {
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	// ListJobs accepts a single filter, and returns jobs of every status when
+	// one is given
+	var filter *types.KeyValuesPair
+	if jobName := d.EqualsQualString("job_name"); jobName != "" {
+		filter = &types.KeyValuesPair{Name: aws.String("JOB_NAME"), Values: []string{jobName}}
+	} else if jobDefinition := d.EqualsQualString("job_definition"); jobDefinition != "" {
+		filter = &types.KeyValuesPair{Name: aws.String("JOB_DEFINITION"), Values: []string{jobDefinition}}
+	} else if d.Quals["created_at"] != nil {
+		for _, q := range d.Quals["created_at"].Quals {
+			timestamp := q.Value.GetTimestampValue().AsTime().UnixMilli()
+			switch q.Operator {
+			case ">=", ">":
+				filter = &types.KeyValuesPair{Name: aws.String("AFTER_CREATED_AT"), Values: []string{strconv.FormatInt(timestamp-1, 10)}}
+			case "<=", "<":
+				filter = &types.KeyValuesPair{Name: aws.String("BEFORE_CREATED_AT"), Values: []string{strconv.FormatInt(timestamp+1, 10)}}
+			}
+		}
+	}
+
+	statuses := batchJobStatuses
+	if filter != nil {
+		statuses = []types.JobStatus{""}
+	} else if status := d.EqualsQualString("status"); status != "" {
+		statuses = []types.JobStatus{types.JobStatus(status)}
+	}
+
+	for _, status := range statuses {
+		input := &batch.ListJobsInput{
+			JobQueue:   queue.JobQueueArn,
+			MaxResults: aws.Int32(maxLimit),
+		}
+		if filter != nil {
+			input.Filters = []types.KeyValuesPair{*filter}
+		} else {
+			input.JobStatus = status
+		}
+
+		paginator := batch.NewListJobsPaginator(svc, input, func(o *batch.ListJobsPaginatorOptions) {
+			o.Limit = maxLimit
+			o.StopOnDuplicateToken = true
+		})
+		for paginator.HasMorePages() {
+			// apply rate limiting
+			d.WaitForListRateLimit(ctx)
+
+			output, err := paginator.NextPage(ctx)
+			if err != nil {
+				plugin.Logger(ctx).Error("aws_batch_job.listBatchJobs", "api_error", err)
+				return nil, err
+			}
+			if len(output.JobSummaryList) == 0 {
+				continue
+			}
+
+			// Describe the jobs of each page in a single call, a page holds at most 100 jobs
+			jobIds := make([]string, 0, len(output.JobSummaryList))
+			for _, job := range output.JobSummaryList {
+				jobIds = append(jobIds, aws.ToString(job.JobId))
+			}
+
+			jobs, err := svc.DescribeJobs(ctx, &batch.DescribeJobsInput{Jobs: jobIds})
+			if err != nil {
+				plugin.Logger(ctx).Error("aws_batch_job.listBatchJobs", "api_error", err)
+				return nil, err
+			}
+
+			for _, job := range jobs.Jobs {
+				d.StreamListItem(ctx, job)
+
+				// Context can be cancelled due to manual cancellation or the limit has been hit
+				if d.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+func getBatchJob(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	jobId := d.EqualsQualString("job_id")
+	if jobId == "" {
+		return nil, nil
+	}
+
+	// Create service client
+	svc, err := BatchClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_batch_job.getBatchJob", "client_error", err)
+		return nil, err
+	}
+
+	// Unsupported region check
+	if svc == nil {
+		return nil, nil
+	}
+
+	input := &batch.DescribeJobsInput{
+		Jobs: []string{jobId},
+	}
+
+	output, err := svc.DescribeJobs(ctx, input)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_batch_job.getBatchJob", "api_error", err)
+		return nil, err
+	}
+
+	if len(output.Jobs) == 0 {
+		return nil, nil
+	}
+
+	return output.Jobs[0], nil
+}
@@ -0,0 +1,357 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/batch"
+	"github.com/aws/aws-sdk-go-v2/service/batch/types"
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+// BatchJobDefinitionSecret is a secret passed to a container of a job
+// definition, from Secrets Manager or Parameter Store
+type BatchJobDefinitionSecret struct {
+	Container string `json:"container,omitempty"`
+	Name      string `json:"name"`
+	ValueFrom string `json:"value_from"`
+}
+
+// batchJobDefinitionContainer is the part of a container definition that is
+// surfaced across the container, node, ECS and EKS properties of a job
+// definition
+type batchJobDefinitionContainer struct {
+	name       string
+	privileged bool
+	secrets    []types.Secret
+}
+
+func tableAwsBatchJobDefinition(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_batch_job_definition",
+		Description: "AWS Batch Job Definition",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("arn"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ClientException"}),
+			},
+			Hydrate: getBatchJobDefinition,
+			Tags:    map[string]string{"service": "batch", "action": "DescribeJobDefinitions"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listBatchJobDefinitions,
+			Tags:    map[string]string{"service": "batch", "action": "DescribeJobDefinitions"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "job_definition_name", Require: plugin.Optional},
+				{Name: "status", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(AWS_BATCH_SERVICE_ID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "job_definition_name",
+				Description: "The name of the job definition",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the job definition",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("JobDefinitionArn"),
+			},
+			{
+				Name:        "revision",
+				Description: "The revision of the job definition",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "type",
+				Description: "The type of job definition (container or multinode)",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "The status of the job definition (ACTIVE or INACTIVE)",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "container_orchestration_type",
+				Description: "The orchestration type of the compute environment (ECS or EKS)",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "image",
+				Description: "The image used to start the container of a single-node container job",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ContainerProperties.Image"),
+			},
+			{
+				Name:        "job_role_arn",
+				Description: "The ARN of the IAM role that the container of a single-node container job can assume",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ContainerProperties.JobRoleArn"),
+			},
+			{
+				Name:        "execution_role_arn",
+				Description: "The ARN of the execution role that Batch can assume for a single-node container job",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ContainerProperties.ExecutionRoleArn"),
+			},
+			{
+				Name:        "privileged",
+				Description: "True if any container of the job definition is given elevated permissions on the host container instance",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.From(batchJobDefinitionPrivileged),
+			},
+			{
+				Name:        "secrets",
+				Description: "The secrets passed to the containers of the job definition, with the container they are passed to for ECS and multi-node jobs",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.From(batchJobDefinitionSecrets),
+			},
+			{
+				Name:        "scheduling_priority",
+				Description: "The scheduling priority of the job definition, used by job queues with a fair share policy",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "propagate_tags",
+				Description: "Specifies whether to propagate the tags from the job or job definition to the corresponding Amazon ECS task",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "platform_capabilities",
+				Description: "The platform capabilities required by the job definition (EC2 or FARGATE)",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "container_properties",
+				Description: "The properties of a single-node container job running on Amazon ECS",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "ecs_properties",
+				Description: "The properties of the Amazon ECS resources of the job",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "eks_properties",
+				Description: "The properties of a job running on Amazon EKS",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "node_properties",
+				Description: "The properties of a multi-node parallel job",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "parameters",
+				Description: "Default parameters or parameter substitution placeholders that are set in the job definition",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "retry_strategy",
+				Description: "The retry strategy to use for failed jobs that are submitted with this job definition",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "timeout",
+				Description: "The timeout after which Batch terminates jobs submitted with this job definition",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard columns for all tables
+			{
+				Name:        "tags",
+				Description: "The tags assigned to the job definition",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("JobDefinitionName"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("JobDefinitionArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+func listBatchJobDefinitions(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create service client
+	svc, err := BatchClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_batch_job_definition.listBatchJobDefinitions", "client_error", err)
+		return nil, err
+	}
+
+	// Unsupported region check
+	if svc == nil {
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			maxLimit = limit
+		}
+	}
+
+	input := &batch.DescribeJobDefinitionsInput{
+		MaxResults: aws.Int32(maxLimit),
+	}
+	if name := d.EqualsQualString("job_definition_name"); name != "" {
+		input.JobDefinitionName = aws.String(name)
+	}
+	if status := d.EqualsQualString("status"); status != "" {
+		input.Status = aws.String(status)
+	}
+
+	paginator := batch.NewDescribeJobDefinitionsPaginator(svc, input)
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_batch_job_definition.listBatchJobDefinitions", "api_error", err)
+			return nil, err
+		}
+
+		for _, jobDefinition := range output.JobDefinitions {
+			d.StreamListItem(ctx, jobDefinition)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+func getBatchJobDefinition(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	arn := d.EqualsQualString("arn")
+	if arn == "" {
+		return nil, nil
+	}
+
+	// Create service client
+	svc, err := BatchClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_batch_job_definition.getBatchJobDefinition", "client_error", err)
+		return nil, err
+	}
+
+	// Unsupported region check
+	if svc == nil {
+		return nil, nil
+	}
+
+	input := &batch.DescribeJobDefinitionsInput{
+		JobDefinitions: []string{arn},
+	}
+
+	output, err := svc.DescribeJobDefinitions(ctx, input)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_batch_job_definition.getBatchJobDefinition", "api_error", err)
+		return nil, err
+	}
+
+	if len(output.JobDefinitions) == 0 {
+		return nil, nil
+	}
+
+	return output.JobDefinitions[0], nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func batchJobDefinitionPrivileged(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	jobDefinition := d.HydrateItem.(types.JobDefinition)
+	for _, container := range batchJobDefinitionContainers(jobDefinition) {
+		if container.privileged {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func batchJobDefinitionSecrets(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	jobDefinition := d.HydrateItem.(types.JobDefinition)
+	var secrets []BatchJobDefinitionSecret
+	for _, container := range batchJobDefinitionContainers(jobDefinition) {
+		for _, secret := range container.secrets {
+			secrets = append(secrets, BatchJobDefinitionSecret{
+				Container: container.name,
+				Name:      aws.ToString(secret.Name),
+				ValueFrom: aws.ToString(secret.ValueFrom),
+			})
+		}
+	}
+	return secrets, nil
+}
+
+// batchJobDefinitionContainers flattens the containers of a job definition,
+// whichever of the container, node, ECS or EKS properties defines them
+func batchJobDefinitionContainers(jobDefinition types.JobDefinition) []batchJobDefinitionContainer {
+	var containers []batchJobDefinitionContainer
+
+	addContainerProperties := func(name string, properties *types.ContainerProperties) {
+		if properties == nil {
+			return
+		}
+		containers = append(containers, batchJobDefinitionContainer{
+			name:       name,
+			privileged: aws.ToBool(properties.Privileged),
+			secrets:    properties.Secrets,
+		})
+	}
+	addEcsProperties := func(prefix string, properties *types.EcsProperties) {
+		if properties == nil {
+			return
+		}
+		for _, task := range properties.TaskProperties {
+			for _, container := range task.Containers {
+				containers = append(containers, batchJobDefinitionContainer{
+					name:       prefix + aws.ToString(container.Name),
+					privileged: aws.ToBool(container.Privileged),
+					secrets:    container.Secrets,
+				})
+			}
+		}
+	}
+
+	addContainerProperties("", jobDefinition.ContainerProperties)
+	addEcsProperties("", jobDefinition.EcsProperties)
+	if jobDefinition.NodeProperties != nil {
+		for _, node := range jobDefinition.NodeProperties.NodeRangeProperties {
+			prefix := "nodes:" + aws.ToString(node.TargetNodes)
+			addContainerProperties(prefix, node.Container)
+			addEcsProperties(prefix+"/", node.EcsProperties)
+		}
+	}
+	if jobDefinition.EksProperties != nil && jobDefinition.EksProperties.PodProperties != nil {
+		pod := jobDefinition.EksProperties.PodProperties
+		eksContainers := append([]types.EksContainer{}, pod.InitContainers...)
+		for _, container := range append(eksContainers, pod.Containers...) {
+			privileged := container.SecurityContext != nil && aws.ToBool(container.SecurityContext.Privileged)
+			containers = append(containers, batchJobDefinitionContainer{
+				name:       aws.ToString(container.Name),
+				privileged: privileged,
+			})
+		}
+	}
+
+	return containers
+}
@@ -0,0 +1,180 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/batch"
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+func tableAwsBatchSchedulingPolicy(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_batch_scheduling_policy",
+		Description: "AWS Batch Scheduling Policy",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("arn"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ClientException"}),
+			},
+			Hydrate: getBatchSchedulingPolicy,
+			Tags:    map[string]string{"service": "batch", "action": "DescribeSchedulingPolicies"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listBatchSchedulingPolicies,
+			Tags:    map[string]string{"service": "batch", "action": "ListSchedulingPolicies"},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(AWS_BATCH_SERVICE_ID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the scheduling policy",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the scheduling policy",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "share_decay_seconds",
+				Description: "The amount of time (in seconds) to use to calculate a fair share percentage for each fair share identifier in use",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("FairsharePolicy.ShareDecaySeconds"),
+			},
+			{
+				Name:        "compute_reservation",
+				Description: "A value used to reserve some of the available maximum vCPU for fair share identifiers that aren't already used",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("FairsharePolicy.ComputeReservation"),
+			},
+			{
+				Name:        "share_distribution",
+				Description: "The share identifiers of the fair share policy and their weight factors",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("FairsharePolicy.ShareDistribution"),
+			},
+
+			// Standard columns for all tables
+			{
+				Name:        "tags",
+				Description: "The tags assigned to the scheduling policy",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+func listBatchSchedulingPolicies(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create service client
+	svc, err := BatchClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_batch_scheduling_policy.listBatchSchedulingPolicies", "client_error", err)
+		return nil, err
+	}
+
+	// Unsupported region check
+	if svc == nil {
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			maxLimit = limit
+		}
+	}
+
+	input := &batch.ListSchedulingPoliciesInput{
+		MaxResults: aws.Int32(maxLimit),
+	}
+
+	paginator := batch.NewListSchedulingPoliciesPaginator(svc, input)
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_batch_scheduling_policy.listBatchSchedulingPolicies", "api_error", err)
+			return nil, err
+		}
+		if len(output.SchedulingPolicies) == 0 {
+			continue
+		}
+
+		// Describe the policies of each page in a single call, a page holds at most 100 policies
+		arns := make([]string, 0, len(output.SchedulingPolicies))
+		for _, policy := range output.SchedulingPolicies {
+			arns = append(arns, aws.ToString(policy.Arn))
+		}
+
+		policies, err := svc.DescribeSchedulingPolicies(ctx, &batch.DescribeSchedulingPoliciesInput{Arns: arns})
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_batch_scheduling_policy.listBatchSchedulingPolicies", "api_error", err)
+			return nil, err
+		}
+
+		for _, policy := range policies.SchedulingPolicies {
+			d.StreamListItem(ctx, policy)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+func getBatchSchedulingPolicy(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	arn := d.EqualsQualString("arn")
+	if arn == "" {
+		return nil, nil
+	}
+
+	// Create service client
+	svc, err := BatchClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_batch_scheduling_policy.getBatchSchedulingPolicy", "client_error", err)
+		return nil, err
+	}
+
+	// Unsupported region check
+	if svc == nil {
+		return nil, nil
+	}
+
+	input := &batch.DescribeSchedulingPoliciesInput{
+		Arns: []string{arn},
+	}
+
+	output, err := svc.DescribeSchedulingPolicies(ctx, input)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_batch_scheduling_policy.getBatchSchedulingPolicy", "api_error", err)
+		return nil, err
+	}
+
+	if len(output.SchedulingPolicies) == 0 {
+		return nil, nil
+	}
+
+	return output.SchedulingPolicies[0], nil
+}
@@ -0,0 +1,178 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider"
+	"github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsCognitoResourceServer(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_cognito_resource_server",
+		Description: "AWS Cognito Resource Server",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"user_pool_id", "identifier"}),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException"}),
+			},
+			Hydrate: getCognitoResourceServer,
+			Tags:    map[string]string{"service": "cognito-idp", "action": "DescribeResourceServer"},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listCognitoUserPools,
+			Hydrate:       listCognitoResourceServers,
+			Tags:          map[string]string{"service": "cognito-idp", "action": "ListResourceServers"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "user_pool_id", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(AWS_COGNITO_IDP_SERVICE_ID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "identifier",
+				Description: "A unique resource server identifier for the resource server, typically an absolute URI.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "name",
+				Description: "The name of the resource server.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "user_pool_id",
+				Description: "The ID of the user pool that hosts the resource server.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "scopes",
+				Description: "A list of scopes that are defined for the resource server.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listCognitoResourceServers(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	userPoolID := h.Item.(types.UserPoolDescriptionType).Id
+
+	// Minimize the API call with the given user_pool_id
+	if d.EqualsQualString("user_pool_id") != "" && d.EqualsQualString("user_pool_id") != *userPoolID {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := CognitoIdentityProviderClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cognito_resource_server.listCognitoResourceServers", "connection_error", err)
+		return nil, err
+	}
+
+	if svc == nil {
+		// Unsupported region check
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(50)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < int64(maxLimit) {
+			if *limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = int32(*limit)
+			}
+		}
+	}
+
+	input := &cognitoidentityprovider.ListResourceServersInput{
+		UserPoolId: userPoolID,
+		MaxResults: aws.Int32(maxLimit),
+	}
+
+	paginator := cognitoidentityprovider.NewListResourceServersPaginator(svc, input, func(o *cognitoidentityprovider.ListResourceServersPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_cognito_resource_server.listCognitoResourceServers", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range output.ResourceServers {
+			d.StreamListItem(ctx, item)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getCognitoResourceServer(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	userPoolID := d.EqualsQualString("user_pool_id")
+	identifier := d.EqualsQualString("identifier")
+
+	// Empty check for required parameters
+	if userPoolID == "" || identifier == "" {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := CognitoIdentityProviderClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cognito_resource_server.getCognitoResourceServer", "connection_error", err)
+		return nil, err
+	}
+
+	if svc == nil {
+		// Unsupported region check
+		return nil, nil
+	}
+
+	params := &cognitoidentityprovider.DescribeResourceServerInput{
+		UserPoolId: aws.String(userPoolID),
+		Identifier: aws.String(identifier),
+	}
+
+	data, err := svc.DescribeResourceServer(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cognito_resource_server.getCognitoResourceServer", "api_error", err)
+		return nil, err
+	}
+
+	if data.ResourceServer != nil {
+		return *data.ResourceServer, nil
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,123 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider"
+	"github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsCognitoRiskConfiguration(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_cognito_risk_configuration",
+		Description: "AWS Cognito Risk Configuration",
+		List: &plugin.ListConfig{
+			ParentHydrate: listCognitoUserPools,
+			Hydrate:       listCognitoRiskConfigurations,
+			Tags:          map[string]string{"service": "cognito-idp", "action": "DescribeRiskConfiguration"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "user_pool_id", Require: plugin.Optional},
+				{Name: "client_id", Require: plugin.Optional},
+			},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				// Risk configuration requires the advanced security features of the user pool
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException", "UserPoolAddOnNotEnabledException"}),
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(AWS_COGNITO_IDP_SERVICE_ID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "user_pool_id",
+				Description: "The ID of the user pool.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "client_id",
+				Description: "The app client ID. Empty for the risk configuration of the whole user pool.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "last_modified_date",
+				Description: "The date and time when the risk configuration was last modified.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "account_takeover_risk_configuration",
+				Description: "The account takeover risk configuration, including the actions taken for low, medium and high risk events.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "compromised_credentials_risk_configuration",
+				Description: "The compromised credentials risk configuration, including the event filter and the action taken.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "risk_exception_configuration",
+				Description: "The IP address ranges that are always blocked or always allowed, regardless of risk.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("UserPoolId"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listCognitoRiskConfigurations(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	userPoolID := h.Item.(types.UserPoolDescriptionType).Id
+
+	// Minimize the API call with the given user_pool_id
+	if d.EqualsQualString("user_pool_id") != "" && d.EqualsQualString("user_pool_id") != *userPoolID {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := CognitoIdentityProviderClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cognito_risk_configuration.listCognitoRiskConfigurations", "connection_error", err)
+		return nil, err
+	}
+
+	if svc == nil {
+		// Unsupported region check
+		return nil, nil
+	}
+
+	input := &cognitoidentityprovider.DescribeRiskConfigurationInput{
+		UserPoolId: userPoolID,
+	}
+
+	// Without a client_id the risk configuration of the user pool is returned
+	if d.EqualsQualString("client_id") != "" {
+		input.ClientId = aws.String(d.EqualsQualString("client_id"))
+	}
+
+	// apply rate limiting
+	d.WaitForListRateLimit(ctx)
+
+	output, err := svc.DescribeRiskConfiguration(ctx, input)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cognito_risk_configuration.listCognitoRiskConfigurations", "api_error", err)
+		return nil, err
+	}
+
+	if output.RiskConfiguration != nil {
+		d.StreamListItem(ctx, *output.RiskConfiguration)
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,352 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider"
+	"github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+type CognitoUserInfo struct {
+	types.UserType
+	UserPoolId *string
+}
+
+//// TABLE DEFINITION
+
+func tableAwsCognitoUser(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_cognito_user",
+		Description: "AWS Cognito User",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"user_pool_id", "username"}),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"UserNotFoundException", "ResourceNotFoundException"}),
+			},
+			Hydrate: getCognitoUser,
+			Tags:    map[string]string{"service": "cognito-idp", "action": "AdminGetUser"},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listCognitoUserPools,
+			Hydrate:       listCognitoUsers,
+			Tags:          map[string]string{"service": "cognito-idp", "action": "ListUsers"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "user_pool_id", Require: plugin.Optional},
+				{Name: "username", Require: plugin.Optional},
+				{Name: "sub", Require: plugin.Optional},
+				{Name: "email", Require: plugin.Optional},
+				{Name: "phone_number", Require: plugin.Optional},
+				{Name: "user_status", Require: plugin.Optional},
+				{Name: "enabled", Require: plugin.Optional, Operators: []string{"=", "<>"}},
+			},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getCognitoUserMfaSettings,
+				Tags: map[string]string{"service": "cognito-idp", "action": "AdminGetUser"},
+				IgnoreConfig: &plugin.IgnoreConfig{
+					ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"UserNotFoundException"}),
+				},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(AWS_COGNITO_IDP_SERVICE_ID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "username",
+				Description: "The user name of the user.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "user_pool_id",
+				Description: "The ID of the user pool that the user belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "sub",
+				Description: "The unique identifier (sub attribute) of the user.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(cognitoUserAttribute, "sub"),
+			},
+			{
+				Name:        "email",
+				Description: "The email address of the user.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(cognitoUserAttribute, "email"),
+			},
+			{
+				Name:        "email_verified",
+				Description: "Indicates whether the email address of the user has been verified.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromP(cognitoUserAttribute, "email_verified").Transform(transform.ToBool),
+			},
+			{
+				Name:        "phone_number",
+				Description: "The phone number of the user.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(cognitoUserAttribute, "phone_number"),
+			},
+			{
+				Name:        "user_status",
+				Description: "The user status. Can be one of UNCONFIRMED, CONFIRMED, EXTERNAL_PROVIDER, UNKNOWN, RESET_REQUIRED or FORCE_CHANGE_PASSWORD.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "enabled",
+				Description: "Specifies whether the user is enabled.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "user_create_date",
+				Description: "The creation date of the user.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "user_last_modified_date",
+				Description: "The date and time when the user was last modified.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "preferred_mfa_setting",
+				Description: "The MFA method that the user has chosen as their preferred method, e.g. SMS_MFA or SOFTWARE_TOKEN_MFA.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCognitoUserMfaSettings,
+			},
+			{
+				Name:        "user_mfa_setting_list",
+				Description: "The MFA options that are activated for the user.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCognitoUserMfaSettings,
+			},
+			{
+				Name:        "attributes",
+				Description: "The user attributes, as a map of attribute name to value.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Attributes").Transform(cognitoUserAttributesToMap),
+			},
+			{
+				Name:        "mfa_options",
+				Description: "The legacy SMS MFA options of the user.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("MFAOptions"),
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Username"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listCognitoUsers(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	userPoolID := h.Item.(types.UserPoolDescriptionType).Id
+
+	// Minimize the API call with the given user_pool_id
+	if d.EqualsQualString("user_pool_id") != "" && d.EqualsQualString("user_pool_id") != *userPoolID {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := CognitoIdentityProviderClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cognito_user.listCognitoUsers", "connection_error", err)
+		return nil, err
+	}
+
+	if svc == nil {
+		// Unsupported region check
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(60)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < int64(maxLimit) {
+			if *limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = int32(*limit)
+			}
+		}
+	}
+
+	input := &cognitoidentityprovider.ListUsersInput{
+		UserPoolId: userPoolID,
+		Limit:      aws.Int32(maxLimit),
+	}
+
+	if filter := buildCognitoUserFilter(d); filter != "" {
+		input.Filter = aws.String(filter)
+	}
+
+	paginator := cognitoidentityprovider.NewListUsersPaginator(svc, input, func(o *cognitoidentityprovider.ListUsersPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_cognito_user.listCognitoUsers", "api_error", err)
+			return nil, err
+		}
+
+		for _, user := range output.Users {
+			d.StreamListItem(ctx, CognitoUserInfo{user, userPoolID})
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getCognitoUser(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	userPoolID := d.EqualsQualString("user_pool_id")
+	username := d.EqualsQualString("username")
+
+	// Empty check for required parameters
+	if userPoolID == "" || username == "" {
+		return nil, nil
+	}
+
+	output, err := adminGetCognitoUser(ctx, d, userPoolID, username)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cognito_user.getCognitoUser", "api_error", err)
+		return nil, err
+	}
+	if output == nil {
+		return nil, nil
+	}
+
+	user := types.UserType{
+		Attributes:           output.UserAttributes,
+		Enabled:              output.Enabled,
+		MFAOptions:           output.MFAOptions,
+		UserCreateDate:       output.UserCreateDate,
+		UserLastModifiedDate: output.UserLastModifiedDate,
+		UserStatus:           output.UserStatus,
+		Username:             output.Username,
+	}
+
+	return CognitoUserInfo{user, aws.String(userPoolID)}, nil
+}
+
+func getCognitoUserMfaSettings(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	user := h.Item.(CognitoUserInfo)
+
+	output, err := adminGetCognitoUser(ctx, d, *user.UserPoolId, *user.Username)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cognito_user.getCognitoUserMfaSettings", "api_error", err)
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func adminGetCognitoUser(ctx context.Context, d *plugin.QueryData, userPoolID string, username string) (*cognitoidentityprovider.AdminGetUserOutput, error) {
+	// Create session
+	svc, err := CognitoIdentityProviderClient(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	if svc == nil {
+		// Unsupported region check
+		return nil, nil
+	}
+
+	params := &cognitoidentityprovider.AdminGetUserInput{
+		UserPoolId: aws.String(userPoolID),
+		Username:   aws.String(username),
+	}
+
+	return svc.AdminGetUser(ctx, params)
+}
+
+// buildCognitoUserFilter builds the ListUsers filter expression from the
+// query quals. The API only accepts a single attribute in the filter, so the
+// most selective qual is used and the remaining quals are applied by Steampipe.
+func buildCognitoUserFilter(d *plugin.QueryData) string {
+	filterQuals := []struct {
+		ColumnName    string
+		AttributeName string
+	}{
+		{"username", "username"},
+		{"sub", "sub"},
+		{"email", "email"},
+		{"phone_number", "phone_number"},
+		{"user_status", "cognito:user_status"},
+	}
+
+	for _, q := range filterQuals {
+		if value := d.EqualsQualString(q.ColumnName); value != "" {
+			return fmt.Sprintf("%s = \"%s\"", q.AttributeName, strings.ReplaceAll(value, "\"", "\\\""))
+		}
+	}
+
+	if d.Quals["enabled"] != nil {
+		for _, q := range d.Quals["enabled"].Quals {
+			enabled := q.Value.GetBoolValue()
+			if q.Operator == "<>" {
+				enabled = !enabled
+			}
+			if enabled {
+				return "status = \"Enabled\""
+			}
+			return "status = \"Disabled\""
+		}
+	}
+
+	return ""
+}
+
+//// TRANSFORM FUNCTIONS
+
+func cognitoUserAttributesToMap(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	attributes, ok := d.Value.([]types.AttributeType)
+	if !ok || len(attributes) == 0 {
+		return nil, nil
+	}
+
+	result := map[string]string{}
+	for _, attribute := range attributes {
+		result[aws.ToString(attribute.Name)] = aws.ToString(attribute.Value)
+	}
+
+	return result, nil
+}
+
+func cognitoUserAttribute(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	user := d.HydrateItem.(CognitoUserInfo)
+	name := d.Param.(string)
+
+	for _, attribute := range user.Attributes {
+		if aws.ToString(attribute.Name) == name {
+			return attribute.Value, nil
+		}
+	}
+
+	return nil, nil
+}
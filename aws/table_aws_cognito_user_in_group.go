@@ -0,0 +1,191 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider"
+	"github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+type CognitoUserInGroupInfo struct {
+	types.UserType
+	UserPoolId *string
+	GroupName  *string
+}
+
+//// TABLE DEFINITION
+
+func tableAwsCognitoUserInGroup(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_cognito_user_in_group",
+		Description: "AWS Cognito User In Group",
+		List: &plugin.ListConfig{
+			ParentHydrate: listCognitoUserPools,
+			Hydrate:       listCognitoUsersInGroups,
+			Tags:          map[string]string{"service": "cognito-idp", "action": "ListUsersInGroup"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "user_pool_id", Require: plugin.Optional},
+				{Name: "group_name", Require: plugin.Optional},
+			},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException"}),
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(AWS_COGNITO_IDP_SERVICE_ID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "group_name",
+				Description: "The name of the group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "username",
+				Description: "The user name of the group member.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "user_pool_id",
+				Description: "The ID of the user pool that the group belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "user_status",
+				Description: "The status of the user.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "enabled",
+				Description: "Specifies whether the user is enabled.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "user_create_date",
+				Description: "The creation date of the user.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "user_last_modified_date",
+				Description: "The date and time when the user was last modified.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "attributes",
+				Description: "The user attributes, as a map of attribute name to value.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Attributes").Transform(cognitoUserAttributesToMap),
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Username"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listCognitoUsersInGroups(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	userPoolID := h.Item.(types.UserPoolDescriptionType).Id
+
+	// Minimize the API call with the given user_pool_id
+	if d.EqualsQualString("user_pool_id") != "" && d.EqualsQualString("user_pool_id") != *userPoolID {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := CognitoIdentityProviderClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cognito_user_in_group.listCognitoUsersInGroups", "connection_error", err)
+		return nil, err
+	}
+
+	if svc == nil {
+		// Unsupported region check
+		return nil, nil
+	}
+
+	// List the groups of the user pool, unless a group name has been provided
+	var groupNames []string
+	if d.EqualsQualString("group_name") != "" {
+		groupNames = []string{d.EqualsQualString("group_name")}
+	} else {
+		paginator := cognitoidentityprovider.NewListGroupsPaginator(svc, &cognitoidentityprovider.ListGroupsInput{
+			UserPoolId: userPoolID,
+			Limit:      aws.Int32(60),
+		}, func(o *cognitoidentityprovider.ListGroupsPaginatorOptions) {
+			o.StopOnDuplicateToken = true
+		})
+
+		for paginator.HasMorePages() {
+			// apply rate limiting
+			d.WaitForListRateLimit(ctx)
+
+			output, err := paginator.NextPage(ctx)
+			if err != nil {
+				plugin.Logger(ctx).Error("aws_cognito_user_in_group.listCognitoUsersInGroups", "list_groups_error", err)
+				return nil, err
+			}
+
+			for _, group := range output.Groups {
+				groupNames = append(groupNames, *group.GroupName)
+			}
+		}
+	}
+
+	// Limiting the results
+	maxLimit := int32(60)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < int64(maxLimit) {
+			if *limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = int32(*limit)
+			}
+		}
+	}
+
+	for _, groupName := range groupNames {
+		input := &cognitoidentityprovider.ListUsersInGroupInput{
+			UserPoolId: userPoolID,
+			GroupName:  aws.String(groupName),
+			Limit:      aws.Int32(maxLimit),
+		}
+
+		paginator := cognitoidentityprovider.NewListUsersInGroupPaginator(svc, input, func(o *cognitoidentityprovider.ListUsersInGroupPaginatorOptions) {
+			o.Limit = maxLimit
+			o.StopOnDuplicateToken = true
+		})
+
+		for paginator.HasMorePages() {
+			// apply rate limiting
+			d.WaitForListRateLimit(ctx)
+
+			output, err := paginator.NextPage(ctx)
+			if err != nil {
+				plugin.Logger(ctx).Error("aws_cognito_user_in_group.listCognitoUsersInGroups", "api_error", err)
+				return nil, err
+			}
+
+			for _, user := range output.Users {
+				d.StreamListItem(ctx, CognitoUserInGroupInfo{user, userPoolID, aws.String(groupName)})
+
+				// Context may get cancelled due to manual cancellation or if the limit has been reached
+				if d.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
+			}
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,342 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider"
+	"github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+// CognitoUserPoolClientInfo holds the app client configuration with the
+// client secret removed, so that it can never be returned as a column value
+type CognitoUserPoolClientInfo struct {
+	types.UserPoolClientType
+	ClientSecretExists bool
+}
+
+//// TABLE DEFINITION
+
+func tableAwsCognitoUserPoolClient(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_cognito_user_pool_client",
+		Description: "AWS Cognito User Pool Client",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"user_pool_id", "client_id"}),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException"}),
+			},
+			Hydrate: getCognitoUserPoolClient,
+			Tags:    map[string]string{"service": "cognito-idp", "action": "DescribeUserPoolClient"},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listCognitoUserPools,
+			Hydrate:       listCognitoUserPoolClients,
+			Tags:          map[string]string{"service": "cognito-idp", "action": "ListUserPoolClients"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "user_pool_id", Require: plugin.Optional},
+			},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getCognitoUserPoolClient,
+				Tags: map[string]string{"service": "cognito-idp", "action": "DescribeUserPoolClient"},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(AWS_COGNITO_IDP_SERVICE_ID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "client_id",
+				Description: "The ID of the app client.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "client_name",
+				Description: "The name of the app client.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "user_pool_id",
+				Description: "The ID of the user pool that the app client belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "client_secret_exists",
+				Description: "Indicates whether the app client has a client secret. The secret itself is never returned.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getCognitoUserPoolClient,
+			},
+			{
+				Name:        "creation_date",
+				Description: "The date and time when the app client was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Hydrate:     getCognitoUserPoolClient,
+			},
+			{
+				Name:        "last_modified_date",
+				Description: "The date and time when the app client was last modified.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Hydrate:     getCognitoUserPoolClient,
+			},
+			{
+				Name:        "allowed_oauth_flows_user_pool_client",
+				Description: "Indicates whether the app client is allowed to use OAuth 2.0 features.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getCognitoUserPoolClient,
+				Transform:   transform.FromField("AllowedOAuthFlowsUserPoolClient"),
+			},
+			{
+				Name:        "allowed_oauth_flows",
+				Description: "The allowed OAuth flows, i.e. code, implicit and client_credentials.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCognitoUserPoolClient,
+				Transform:   transform.FromField("AllowedOAuthFlows"),
+			},
+			{
+				Name:        "allowed_oauth_scopes",
+				Description: "The OAuth scopes that the app client supports.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCognitoUserPoolClient,
+				Transform:   transform.FromField("AllowedOAuthScopes"),
+			},
+			{
+				Name:        "callback_urls",
+				Description: "A list of allowed redirect (callback) URLs for the identity providers.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCognitoUserPoolClient,
+				Transform:   transform.FromField("CallbackURLs"),
+			},
+			{
+				Name:        "logout_urls",
+				Description: "A list of allowed sign-out URLs for the identity providers.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCognitoUserPoolClient,
+				Transform:   transform.FromField("LogoutURLs"),
+			},
+			{
+				Name:        "default_redirect_uri",
+				Description: "The default redirect URI.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCognitoUserPoolClient,
+				Transform:   transform.FromField("DefaultRedirectURI"),
+			},
+			{
+				Name:        "explicit_auth_flows",
+				Description: "The authentication flows that the app client supports.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCognitoUserPoolClient,
+			},
+			{
+				Name:        "supported_identity_providers",
+				Description: "A list of identity providers that the app client can use.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCognitoUserPoolClient,
+			},
+			{
+				Name:        "prevent_user_existence_errors",
+				Description: "Errors and responses that Amazon Cognito APIs return during authentication, account confirmation, and password recovery when the user doesn't exist in the user pool. Can be ENABLED or LEGACY.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCognitoUserPoolClient,
+			},
+			{
+				Name:        "enable_token_revocation",
+				Description: "Indicates whether token revocation is activated for the app client.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getCognitoUserPoolClient,
+			},
+			{
+				Name:        "enable_propagate_additional_user_context_data",
+				Description: "Indicates whether the app client accepts an IP address in the user context data for advanced security risk analysis.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getCognitoUserPoolClient,
+			},
+			{
+				Name:        "access_token_validity",
+				Description: "The access token time limit.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getCognitoUserPoolClient,
+			},
+			{
+				Name:        "id_token_validity",
+				Description: "The ID token time limit.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getCognitoUserPoolClient,
+			},
+			{
+				Name:        "refresh_token_validity",
+				Description: "The refresh token time limit.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getCognitoUserPoolClient,
+			},
+			{
+				Name:        "auth_session_validity",
+				Description: "The duration, in minutes, of the session token for each authentication flow.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getCognitoUserPoolClient,
+			},
+			{
+				Name:        "token_validity_units",
+				Description: "The time units used for the access, ID and refresh token validity.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCognitoUserPoolClient,
+			},
+			{
+				Name:        "read_attributes",
+				Description: "The list of user attributes that the app client can read.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCognitoUserPoolClient,
+			},
+			{
+				Name:        "write_attributes",
+				Description: "The list of user attributes that the app client can write.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCognitoUserPoolClient,
+			},
+			{
+				Name:        "analytics_configuration",
+				Description: "The Amazon Pinpoint analytics configuration for the app client.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCognitoUserPoolClient,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ClientName"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listCognitoUserPoolClients(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	userPoolID := h.Item.(types.UserPoolDescriptionType).Id
+
+	// Minimize the API call with the given user_pool_id
+	if d.EqualsQualString("user_pool_id") != "" && d.EqualsQualString("user_pool_id") != *userPoolID {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := CognitoIdentityProviderClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cognito_user_pool_client.listCognitoUserPoolClients", "connection_error", err)
+		return nil, err
+	}
+
+	if svc == nil {
+		// Unsupported region check
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(60)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < int64(maxLimit) {
+			if *limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = int32(*limit)
+			}
+		}
+	}
+
+	input := &cognitoidentityprovider.ListUserPoolClientsInput{
+		UserPoolId: userPoolID,
+		MaxResults: aws.Int32(maxLimit),
+	}
+
+	paginator := cognitoidentityprovider.NewListUserPoolClientsPaginator(svc, input, func(o *cognitoidentityprovider.ListUserPoolClientsPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_cognito_user_pool_client.listCognitoUserPoolClients", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range output.UserPoolClients {
+			d.StreamListItem(ctx, item)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getCognitoUserPoolClient(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var userPoolID, clientID string
+	if h.Item != nil {
+		switch item := h.Item.(type) {
+		case types.UserPoolClientDescription:
+			userPoolID = aws.ToString(item.UserPoolId)
+			clientID = aws.ToString(item.ClientId)
+		case CognitoUserPoolClientInfo:
+			return item, nil
+		}
+	} else {
+		userPoolID = d.EqualsQualString("user_pool_id")
+		clientID = d.EqualsQualString("client_id")
+	}
+
+	// Empty check for required parameters
+	if userPoolID == "" || clientID == "" {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := CognitoIdentityProviderClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cognito_user_pool_client.getCognitoUserPoolClient", "connection_error", err)
+		return nil, err
+	}
+
+	if svc == nil {
+		// Unsupported region check
+		return nil, nil
+	}
+
+	params := &cognitoidentityprovider.DescribeUserPoolClientInput{
+		UserPoolId: aws.String(userPoolID),
+		ClientId:   aws.String(clientID),
+	}
+
+	data, err := svc.DescribeUserPoolClient(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cognito_user_pool_client.getCognitoUserPoolClient", "api_error", err)
+		return nil, err
+	}
+
+	if data.UserPoolClient == nil {
+		return nil, nil
+	}
+
+	client := *data.UserPoolClient
+	info := CognitoUserPoolClientInfo{
+		ClientSecretExists: aws.ToString(client.ClientSecret) != "",
+	}
+	client.ClientSecret = nil
+	info.UserPoolClientType = client
+
+	return info, nil
+}
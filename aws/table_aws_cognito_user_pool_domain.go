@@ -0,0 +1,215 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider"
+	"github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsCognitoUserPoolDomain(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_cognito_user_pool_domain",
+		Description: "AWS Cognito User Pool Domain",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("domain"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException"}),
+			},
+			Hydrate: getCognitoUserPoolDomain,
+			Tags:    map[string]string{"service": "cognito-idp", "action": "DescribeUserPoolDomain"},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listCognitoUserPools,
+			Hydrate:       listCognitoUserPoolDomains,
+			Tags:          map[string]string{"service": "cognito-idp", "action": "DescribeUserPoolDomain"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "user_pool_id", Require: plugin.Optional},
+			},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException"}),
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(AWS_COGNITO_IDP_SERVICE_ID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "domain",
+				Description: "The domain string. For custom domains, this is the fully-qualified domain name. For Amazon Cognito prefix domains, this is the prefix alone.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "user_pool_id",
+				Description: "The ID of the user pool that the domain is associated with.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "custom_domain",
+				Description: "True if the domain is a custom domain with its own certificate, false if it is an Amazon Cognito prefix domain.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.From(cognitoUserPoolDomainIsCustom),
+			},
+			{
+				Name:        "certificate_arn",
+				Description: "The Amazon Resource Name (ARN) of the ACM certificate used by a custom domain.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CustomDomainConfig.CertificateArn"),
+			},
+			{
+				Name:        "status",
+				Description: "The domain status. Can be one of CREATING, DELETING, UPDATING, ACTIVE or FAILED.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "aws_account_id",
+				Description: "The Amazon Web Services account ID for the user pool owner.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AWSAccountId"),
+			},
+			{
+				Name:        "cloudfront_distribution",
+				Description: "The Amazon CloudFront endpoint that the domain is associated with.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CloudFrontDistribution"),
+			},
+			{
+				Name:        "s3_bucket",
+				Description: "The Amazon S3 bucket where the static files for the domain are stored.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("S3Bucket"),
+			},
+			{
+				Name:        "version",
+				Description: "The app version.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Domain"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listCognitoUserPoolDomains(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	userPoolID := h.Item.(types.UserPoolDescriptionType).Id
+
+	// Minimize the API call with the given user_pool_id
+	if d.EqualsQualString("user_pool_id") != "" && d.EqualsQualString("user_pool_id") != *userPoolID {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := CognitoIdentityProviderClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cognito_user_pool_domain.listCognitoUserPoolDomains", "connection_error", err)
+		return nil, err
+	}
+
+	if svc == nil {
+		// Unsupported region check
+		return nil, nil
+	}
+
+	// Domains can't be listed, so read the prefix and custom domain of the user pool
+	pool, err := svc.DescribeUserPool(ctx, &cognitoidentityprovider.DescribeUserPoolInput{
+		UserPoolId: userPoolID,
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cognito_user_pool_domain.listCognitoUserPoolDomains", "describe_user_pool_error", err)
+		return nil, err
+	}
+
+	if pool.UserPool == nil {
+		return nil, nil
+	}
+
+	for _, domain := range []*string{pool.UserPool.Domain, pool.UserPool.CustomDomain} {
+		if aws.ToString(domain) == "" {
+			continue
+		}
+
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := svc.DescribeUserPoolDomain(ctx, &cognitoidentityprovider.DescribeUserPoolDomainInput{
+			Domain: domain,
+		})
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_cognito_user_pool_domain.listCognitoUserPoolDomains", "api_error", err)
+			return nil, err
+		}
+
+		if output.DomainDescription == nil || output.DomainDescription.Domain == nil {
+			continue
+		}
+
+		d.StreamListItem(ctx, *output.DomainDescription)
+
+		// Context may get cancelled due to manual cancellation or if the limit has been reached
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getCognitoUserPoolDomain(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	domain := d.EqualsQualString("domain")
+
+	// Empty check for required parameters
+	if domain == "" {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := CognitoIdentityProviderClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cognito_user_pool_domain.getCognitoUserPoolDomain", "connection_error", err)
+		return nil, err
+	}
+
+	if svc == nil {
+		// Unsupported region check
+		return nil, nil
+	}
+
+	params := &cognitoidentityprovider.DescribeUserPoolDomainInput{
+		Domain: aws.String(domain),
+	}
+
+	data, err := svc.DescribeUserPoolDomain(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cognito_user_pool_domain.getCognitoUserPoolDomain", "api_error", err)
+		return nil, err
+	}
+
+	// The API returns an empty description for a domain that doesn't exist
+	if data.DomainDescription == nil || data.DomainDescription.Domain == nil {
+		return nil, nil
+	}
+
+	return *data.DomainDescription, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func cognitoUserPoolDomainIsCustom(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	domain := d.HydrateItem.(types.DomainDescriptionType)
+	return domain.CustomDomainConfig != nil && aws.ToString(domain.CustomDomainConfig.CertificateArn) != "", nil
+}
@@ -0,0 +1,521 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/aws/aws-sdk-go-v2/service/codebuild"
+	codebuildTypes "github.com/aws/aws-sdk-go-v2/service/codebuild/types"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2Types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	ecsTypes "github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"github.com/aws/aws-sdk-go-v2/service/glue"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmTypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+// ConfigSecretFinding is a likely plaintext secret found in the configuration
+// of a resource, reported against the table that exposes the scanned column
+type ConfigSecretFinding struct {
+	SourceTable  string
+	ResourceArn  string
+	ResourceName string
+	SecretFinding
+}
+
+// configSecretSource scans the resources of a source table in the current
+// region, calling stream for every finding. stream returns false once no
+// more rows are needed.
+type configSecretSource func(ctx context.Context, d *plugin.QueryData, stream func(ConfigSecretFinding) bool) error
+
+// configSecretSources are the scanned tables, in the order they are scanned
+var configSecretSources = []struct {
+	table string
+	scan  configSecretSource
+}{
+	{"aws_lambda_function", scanLambdaFunctionSecrets},
+	{"aws_ecs_task_definition", scanEcsTaskDefinitionSecrets},
+	{"aws_ec2_instance", scanEc2InstanceSecrets},
+	{"aws_cloudformation_stack", scanCloudFormationStackSecrets},
+	{"aws_ssm_parameter", scanSsmParameterSecrets},
+	{"aws_codebuild_project", scanCodeBuildProjectSecrets},
+	{"aws_glue_job", scanGlueJobSecrets},
+}
+
+//// TABLE DEFINITION
+
+func tableAwsConfigSecretFinding(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_config_secret_finding",
+		Description: "AWS Config Secret Finding",
+		List: &plugin.ListConfig{
+			Hydrate: listConfigSecretFindings,
+			// The scan calls several services, so the tags identify the table
+			// rather than a single API action. Every call, including the
+			// per-resource ones, waits for the list rate limiter.
+			Tags: map[string]string{"service": "steampipe", "action": "ScanConfigSecrets"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "source_table", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(AWS_EC2_SERVICE_ID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "source_table",
+				Description: "The table exposing the configuration the secret was found in, e.g. aws_lambda_function.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "resource_arn",
+				Description: "The Amazon Resource Name (ARN) of the resource the secret was found in.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "resource_name",
+				Description: "The name or ID of the resource the secret was found in.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "field_path",
+				Description: "The path of the value the secret was found in, e.g. environment_variables.DB_PASSWORD.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "rule_id",
+				Description: "The ID of the rule that matched, e.g. aws_access_key_id, github_token, private_key, jdbc_password, generic_secret or high_entropy_string.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the rule that matched.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "redacted_match",
+				Description: "The matched secret, with all but a prefix of at most 4 characters masked.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "entropy",
+				Description: "The Shannon entropy of the match in bits per character, for entropy based rules.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("Entropy").Transform(transform.NullIfZeroValue),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ResourceName"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listConfigSecretFindings(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	sourceTable := d.EqualsQualString("source_table")
+
+	done := false
+	stream := func(finding ConfigSecretFinding) bool {
+		d.StreamListItem(ctx, finding)
+
+		// Context may get cancelled due to manual cancellation or if the limit has been reached
+		if d.RowsRemaining(ctx) == 0 {
+			done = true
+		}
+		return !done
+	}
+
+	for _, source := range configSecretSources {
+		if sourceTable != "" && sourceTable != source.table {
+			continue
+		}
+
+		err := source.scan(ctx, d, stream)
+		if err != nil {
+			// A missing permission for one source should not fail the whole scan
+			if shouldIgnoreErrors([]string{"AccessDenied*", "UnauthorizedOperation", "UnrecognizedClientException"})(ctx, d, nil, err) {
+				plugin.Logger(ctx).Warn("aws_config_secret_finding.listConfigSecretFindings", "source_table", source.table, "skipped", err)
+				continue
+			}
+			plugin.Logger(ctx).Error("aws_config_secret_finding.listConfigSecretFindings", "source_table", source.table, "api_error", err)
+			return nil, err
+		}
+		if done {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// streamConfigSecretFindings streams the findings of a resource, returning
+// false once no more rows are needed
+func streamConfigSecretFindings(stream func(ConfigSecretFinding) bool, sourceTable string, arn string, name string, findings []SecretFinding) bool {
+	for _, finding := range findings {
+		row := ConfigSecretFinding{
+			SourceTable:   sourceTable,
+			ResourceArn:   arn,
+			ResourceName:  name,
+			SecretFinding: finding,
+		}
+		if !stream(row) {
+			return false
+		}
+	}
+	return true
+}
+
+//// SOURCES
+
+func scanLambdaFunctionSecrets(ctx context.Context, d *plugin.QueryData, stream func(ConfigSecretFinding) bool) error {
+	svc, err := LambdaClient(ctx, d)
+	if err != nil {
+		return err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil
+	}
+
+	paginator := lambda.NewListFunctionsPaginator(svc, &lambda.ListFunctionsInput{MaxItems: aws.Int32(50)}, func(o *lambda.ListFunctionsPaginatorOptions) {
+		o.StopOnDuplicateToken = true
+	})
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return err
+		}
+		for _, function := range output.Functions {
+			if function.Environment == nil {
+				continue
+			}
+			findings := scanMapForSecrets("environment_variables", function.Environment.Variables)
+			if !streamConfigSecretFindings(stream, "aws_lambda_function", aws.ToString(function.FunctionArn), aws.ToString(function.FunctionName), findings) {
+				return nil
+			}
+		}
+	}
+
+	return nil
+}
+
+// scanEcsTaskDefinitionSecrets scans the latest active revision of every task
+// definition family
+func scanEcsTaskDefinitionSecrets(ctx context.Context, d *plugin.QueryData, stream func(ConfigSecretFinding) bool) error {
+	svc, err := ECSClient(ctx, d)
+	if err != nil {
+		return err
+	}
+
+	input := &ecs.ListTaskDefinitionFamiliesInput{
+		MaxResults: aws.Int32(100),
+		Status:     ecsTypes.TaskDefinitionFamilyStatusActive,
+	}
+	paginator := ecs.NewListTaskDefinitionFamiliesPaginator(svc, input, func(o *ecs.ListTaskDefinitionFamiliesPaginatorOptions) {
+		o.StopOnDuplicateToken = true
+	})
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return err
+		}
+		for _, family := range output.Families {
+			// apply rate limiting
+			d.WaitForListRateLimit(ctx)
+
+			taskDefinition, err := svc.DescribeTaskDefinition(ctx, &ecs.DescribeTaskDefinitionInput{
+				TaskDefinition: aws.String(family),
+			})
+			if err != nil {
+				return err
+			}
+			if taskDefinition.TaskDefinition == nil {
+				continue
+			}
+
+			var findings []SecretFinding
+			for _, container := range taskDefinition.TaskDefinition.ContainerDefinitions {
+				for _, env := range container.Environment {
+					fieldPath := fmt.Sprintf("container_definitions.%s.environment.%s", aws.ToString(container.Name), aws.ToString(env.Name))
+					findings = append(findings, scanForSecrets(fieldPath, aws.ToString(env.Name), aws.ToString(env.Value))...)
+				}
+			}
+			if !streamConfigSecretFindings(stream, "aws_ecs_task_definition", aws.ToString(taskDefinition.TaskDefinition.TaskDefinitionArn), family, findings) {
+				return nil
+			}
+		}
+	}
+
+	return nil
+}
+
+func scanEc2InstanceSecrets(ctx context.Context, d *plugin.QueryData, stream func(ConfigSecretFinding) bool) error {
+	svc, err := EC2Client(ctx, d)
+	if err != nil {
+		return err
+	}
+
+	c, err := getCommonColumns(ctx, d, nil)
+	if err != nil {
+		return err
+	}
+	commonColumnData := c.(*awsCommonColumnData)
+	region := d.EqualsQualString(matrixKeyRegion)
+
+	paginator := ec2.NewDescribeInstancesPaginator(svc, &ec2.DescribeInstancesInput{MaxResults: aws.Int32(1000)}, func(o *ec2.DescribeInstancesPaginatorOptions) {
+		o.StopOnDuplicateToken = true
+	})
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return err
+		}
+		for _, reservation := range output.Reservations {
+			for _, instance := range reservation.Instances {
+				if instance.State != nil && instance.State.Name == ec2Types.InstanceStateNameTerminated {
+					continue
+				}
+
+				// apply rate limiting
+				d.WaitForListRateLimit(ctx)
+
+				attribute, err := svc.DescribeInstanceAttribute(ctx, &ec2.DescribeInstanceAttributeInput{
+					InstanceId: instance.InstanceId,
+					Attribute:  ec2Types.InstanceAttributeNameUserData,
+				})
+				if err != nil {
+					return err
+				}
+				if attribute.UserData == nil || attribute.UserData.Value == nil {
+					continue
+				}
+
+				// Scan the user data the way the aws_ec2_instance table decodes it
+				userData, _ := base64DecodedData(ctx, &transform.TransformData{Value: attribute.UserData.Value})
+				value, ok := userData.(string)
+				if !ok {
+					continue
+				}
+
+				arn := "arn:" + commonColumnData.Partition + ":ec2:" + region + ":" + commonColumnData.AccountId + ":instance/" + aws.ToString(instance.InstanceId)
+				if !streamConfigSecretFindings(stream, "aws_ec2_instance", arn, aws.ToString(instance.InstanceId), scanForSecrets("user_data", "", value)) {
+					return nil
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func scanCloudFormationStackSecrets(ctx context.Context, d *plugin.QueryData, stream func(ConfigSecretFinding) bool) error {
+	svc, err := CloudFormationClient(ctx, d)
+	if err != nil {
+		return err
+	}
+
+	paginator := cloudformation.NewDescribeStacksPaginator(svc, &cloudformation.DescribeStacksInput{}, func(o *cloudformation.DescribeStacksPaginatorOptions) {
+		o.StopOnDuplicateToken = true
+	})
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return err
+		}
+		for _, stack := range output.Stacks {
+			// NoEcho parameters are returned masked and never match
+			parameters := map[string]string{}
+			for _, parameter := range stack.Parameters {
+				parameters[aws.ToString(parameter.ParameterKey)] = aws.ToString(parameter.ParameterValue)
+			}
+			findings := scanMapForSecrets("parameters", parameters)
+			if !streamConfigSecretFindings(stream, "aws_cloudformation_stack", aws.ToString(stack.StackId), aws.ToString(stack.StackName), findings) {
+				return nil
+			}
+		}
+	}
+
+	return nil
+}
+
+// scanSsmParameterSecrets scans String parameters, which should have been
+// created as SecureString if they hold a secret
+func scanSsmParameterSecrets(ctx context.Context, d *plugin.QueryData, stream func(ConfigSecretFinding) bool) error {
+	svc, err := SSMClient(ctx, d)
+	if err != nil {
+		return err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil
+	}
+
+	input := &ssm.DescribeParametersInput{
+		MaxResults: aws.Int32(50),
+		ParameterFilters: []ssmTypes.ParameterStringFilter{
+			{
+				Key:    aws.String("Type"),
+				Option: aws.String("Equals"),
+				Values: []string{string(ssmTypes.ParameterTypeString)},
+			},
+		},
+	}
+	paginator := ssm.NewDescribeParametersPaginator(svc, input, func(o *ssm.DescribeParametersPaginatorOptions) {
+		o.StopOnDuplicateToken = true
+	})
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return err
+		}
+
+		// GetParameters accepts at most 10 names
+		for start := 0; start < len(output.Parameters); start += 10 {
+			end := min(start+10, len(output.Parameters))
+			names := make([]string, 0, end-start)
+			for _, parameter := range output.Parameters[start:end] {
+				names = append(names, aws.ToString(parameter.Name))
+			}
+
+			// apply rate limiting
+			d.WaitForListRateLimit(ctx)
+
+			parameters, err := svc.GetParameters(ctx, &ssm.GetParametersInput{Names: names})
+			if err != nil {
+				return err
+			}
+			for _, parameter := range parameters.Parameters {
+				name := aws.ToString(parameter.Name)
+				if !streamConfigSecretFindings(stream, "aws_ssm_parameter", aws.ToString(parameter.ARN), name, scanForSecrets("value", name, aws.ToString(parameter.Value))) {
+					return nil
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// scanCodeBuildProjectSecrets scans the PLAINTEXT environment variables of
+// projects, the other types reference Parameter Store or Secrets Manager
+func scanCodeBuildProjectSecrets(ctx context.Context, d *plugin.QueryData, stream func(ConfigSecretFinding) bool) error {
+	svc, err := CodeBuildClient(ctx, d)
+	if err != nil {
+		return err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil
+	}
+
+	paginator := codebuild.NewListProjectsPaginator(svc, &codebuild.ListProjectsInput{}, func(o *codebuild.ListProjectsPaginatorOptions) {
+		o.StopOnDuplicateToken = true
+	})
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return err
+		}
+
+		// BatchGetProjects accepts at most 100 names
+		for start := 0; start < len(output.Projects); start += 100 {
+			end := min(start+100, len(output.Projects))
+
+			// apply rate limiting
+			d.WaitForListRateLimit(ctx)
+
+			projects, err := svc.BatchGetProjects(ctx, &codebuild.BatchGetProjectsInput{Names: output.Projects[start:end]})
+			if err != nil {
+				return err
+			}
+			for _, project := range projects.Projects {
+				if project.Environment == nil {
+					continue
+				}
+
+				var findings []SecretFinding
+				for _, env := range project.Environment.EnvironmentVariables {
+					if env.Type != "" && env.Type != codebuildTypes.EnvironmentVariableTypePlaintext {
+						continue
+					}
+					fieldPath := "environment.environment_variables." + aws.ToString(env.Name)
+					findings = append(findings, scanForSecrets(fieldPath, aws.ToString(env.Name), aws.ToString(env.Value))...)
+				}
+				if !streamConfigSecretFindings(stream, "aws_codebuild_project", aws.ToString(project.Arn), aws.ToString(project.Name), findings) {
+					return nil
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func scanGlueJobSecrets(ctx context.Context, d *plugin.QueryData, stream func(ConfigSecretFinding) bool) error {
+	svc, err := GlueClient(ctx, d)
+	if err != nil {
+		return err
+	}
+
+	c, err := getCommonColumns(ctx, d, nil)
+	if err != nil {
+		return err
+	}
+	commonColumnData := c.(*awsCommonColumnData)
+	region := d.EqualsQualString(matrixKeyRegion)
+
+	paginator := glue.NewGetJobsPaginator(svc, &glue.GetJobsInput{MaxResults: aws.Int32(1000)}, func(o *glue.GetJobsPaginatorOptions) {
+		o.StopOnDuplicateToken = true
+	})
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return err
+		}
+		for _, job := range output.Jobs {
+			findings := scanMapForSecrets("default_arguments", job.DefaultArguments)
+			findings = append(findings, scanMapForSecrets("non_overridable_arguments", job.NonOverridableArguments)...)
+
+			// arn:aws:glue:region:account-id:job/job-name
+			arn := "arn:" + commonColumnData.Partition + ":glue:" + region + ":" + commonColumnData.AccountId + ":job/" + aws.ToString(job.Name)
+			if !streamConfigSecretFindings(stream, "aws_glue_job", arn, aws.ToString(job.Name), findings) {
+				return nil
+			}
+		}
+	}
+
+	return nil
+}
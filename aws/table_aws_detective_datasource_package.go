@@ -0,0 +1,126 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/detective"
+	"github.com/aws/aws-sdk-go-v2/service/detective/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+type DetectiveDatasourcePackageInfo struct {
+	types.DatasourcePackageIngestDetail
+	DatasourcePackage string
+	GraphArn          *string
+}
+
+//// TABLE DEFINITION
+
+func tableAwsDetectiveDatasourcePackage(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_detective_datasource_package",
+		Description: "AWS Detective Datasource Package",
+		List: &plugin.ListConfig{
+			ParentHydrate: listDetectiveGraphs,
+			Hydrate:       listDetectiveDatasourcePackages,
+			Tags:          map[string]string{"service": "detective", "action": "ListDatasourcePackages"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "graph_arn", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(AWS_API_DETECTIVE_SERVICE_ID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "datasource_package",
+				Description: "The name of the data source package. Can be one of DETECTIVE_CORE, EKS_AUDIT or ASFF_SECURITYHUB_FINDING.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "graph_arn",
+				Description: "The ARN of the behavior graph.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "datasource_package_ingest_state",
+				Description: "The ingest state of the data source package for the behavior graph. Can be one of STARTED, STOPPED or DISABLED.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "last_ingest_state_change",
+				Description: "The date and time that each ingest state was last entered, e.g. when the data source package was started.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DatasourcePackage"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listDetectiveDatasourcePackages(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	graphArn := aws.ToString(h.Item.(types.Graph).Arn)
+
+	// Minimize the API call with the given graph_arn
+	if d.EqualsQualString("graph_arn") != "" && d.EqualsQualString("graph_arn") != graphArn {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := DetectiveClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_detective_datasource_package.listDetectiveDatasourcePackages", "client_error", err)
+		return nil, err
+	}
+
+	// Unsupported region check
+	if svc == nil {
+		return nil, nil
+	}
+
+	input := &detective.ListDatasourcePackagesInput{
+		GraphArn:   aws.String(graphArn),
+		MaxResults: aws.Int32(100),
+	}
+
+	paginator := detective.NewListDatasourcePackagesPaginator(svc, input, func(o *detective.ListDatasourcePackagesPaginatorOptions) {
+		o.Limit = 100
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_detective_datasource_package.listDetectiveDatasourcePackages", "api_error", err)
+			return nil, err
+		}
+
+		for name, detail := range output.DatasourcePackages {
+			d.StreamListItem(ctx, DetectiveDatasourcePackageInfo{
+				DatasourcePackageIngestDetail: detail,
+				DatasourcePackage:             name,
+				GraphArn:                      aws.String(graphArn),
+			})
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,208 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/detective"
+	"github.com/aws/aws-sdk-go-v2/service/detective/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsDetectiveGraph(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_detective_graph",
+		Description: "AWS Detective Graph",
+		List: &plugin.ListConfig{
+			Hydrate: listDetectiveGraphs,
+			Tags:    map[string]string{"service": "detective", "action": "ListGraphs"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "arn", Require: plugin.Optional},
+			},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getDetectiveGraphOrganizationConfiguration,
+				Tags: map[string]string{"service": "detective", "action": "DescribeOrganizationConfiguration"},
+				// Only the Detective administrator account of an organization can describe the organization configuration
+				IgnoreConfig: &plugin.IgnoreConfig{
+					ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"AccessDeniedException", "ValidationException"}),
+				},
+			},
+			{
+				Func: getDetectiveGraphTags,
+				Tags: map[string]string{"service": "detective", "action": "ListTagsForResource"},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(AWS_API_DETECTIVE_SERVICE_ID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "arn",
+				Description: "The ARN of the behavior graph.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "created_time",
+				Description: "The date and time that the behavior graph was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "auto_enable",
+				Description: "Indicates whether to automatically enable new organization accounts as member accounts in the organization behavior graph. Only available to the Detective administrator account of the organization.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getDetectiveGraphOrganizationConfiguration,
+				Transform:   transform.FromField("AutoEnable"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Arn"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getDetectiveGraphTags,
+				Transform:   transform.FromField("Tags"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listDetectiveGraphs(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := DetectiveClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_detective_graph.listDetectiveGraphs", "client_error", err)
+		return nil, err
+	}
+
+	// Unsupported region check
+	if svc == nil {
+		return nil, nil
+	}
+
+	maxItems := int32(200)
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxItems {
+			if limit < 1 {
+				maxItems = int32(1)
+			} else {
+				maxItems = int32(limit)
+			}
+		}
+	}
+
+	input := &detective.ListGraphsInput{
+		MaxResults: aws.Int32(maxItems),
+	}
+
+	paginator := detective.NewListGraphsPaginator(svc, input, func(o *detective.ListGraphsPaginatorOptions) {
+		o.Limit = maxItems
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_detective_graph.listDetectiveGraphs", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range output.GraphList {
+			// The API doesn't support filtering, a region has at most one behavior graph
+			if d.EqualsQualString("arn") != "" && d.EqualsQualString("arn") != aws.ToString(item.Arn) {
+				continue
+			}
+
+			d.StreamListItem(ctx, item)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getDetectiveGraphOrganizationConfiguration(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	graph := h.Item.(types.Graph)
+
+	// Create session
+	svc, err := DetectiveClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_detective_graph.getDetectiveGraphOrganizationConfiguration", "client_error", err)
+		return nil, err
+	}
+
+	// Unsupported region check
+	if svc == nil {
+		return nil, nil
+	}
+
+	params := &detective.DescribeOrganizationConfigurationInput{
+		GraphArn: graph.Arn,
+	}
+
+	op, err := svc.DescribeOrganizationConfiguration(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_detective_graph.getDetectiveGraphOrganizationConfiguration", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
+
+func getDetectiveGraphTags(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	graph := h.Item.(types.Graph)
+
+	// Create session
+	svc, err := DetectiveClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_detective_graph.getDetectiveGraphTags", "client_error", err)
+		return nil, err
+	}
+
+	// Unsupported region check
+	if svc == nil {
+		return nil, nil
+	}
+
+	params := &detective.ListTagsForResourceInput{
+		ResourceArn: graph.Arn,
+	}
+
+	op, err := svc.ListTagsForResource(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_detective_graph.getDetectiveGraphTags", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
@@ -0,0 +1,356 @@
+package aws
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/detective"
+	"github.com/aws/aws-sdk-go-v2/service/detective/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+type DetectiveInvestigationInfo struct {
+	types.InvestigationDetail
+	GraphArn *string
+}
+
+//// TABLE DEFINITION
+
+func tableAwsDetectiveInvestigation(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_detective_investigation",
+		Description: "AWS Detective Investigation",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"graph_arn", "investigation_id"}),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException", "ValidationException"}),
+			},
+			Hydrate: getDetectiveInvestigation,
+			Tags:    map[string]string{"service": "detective", "action": "GetInvestigation"},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listDetectiveGraphs,
+			Hydrate:       listDetectiveInvestigations,
+			Tags:          map[string]string{"service": "detective", "action": "ListInvestigations"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "graph_arn", Require: plugin.Optional},
+				{Name: "entity_arn", Require: plugin.Optional},
+				{Name: "severity", Require: plugin.Optional},
+				{Name: "state", Require: plugin.Optional},
+				{Name: "status", Require: plugin.Optional},
+				{Name: "created_time", Require: plugin.Optional, Operators: []string{">", ">=", "<", "<=", "="}},
+			},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getDetectiveInvestigation,
+				Tags: map[string]string{"service": "detective", "action": "GetInvestigation"},
+			},
+			{
+				Func: listDetectiveInvestigationIndicators,
+				Tags: map[string]string{"service": "detective", "action": "ListIndicators"},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(AWS_API_DETECTIVE_SERVICE_ID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "investigation_id",
+				Description: "The investigation ID of the investigation report.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "graph_arn",
+				Description: "The ARN of the behavior graph.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "entity_arn",
+				Description: "The unique Amazon Resource Name (ARN) of the IAM user or IAM role that is investigated.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "entity_type",
+				Description: "The type of entity that is investigated. Can be IAM_ROLE or IAM_USER.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "severity",
+				Description: "The severity of the investigation, based on the likelihood and impact of the indicators of compromise. Can be one of INFORMATIONAL, LOW, MEDIUM, HIGH or CRITICAL.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "The current state of the investigation. Can be ACTIVE or ARCHIVED. An archived investigation indicates you have completed reviewing the investigation.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "The completion status of the investigation. Can be one of RUNNING, FAILED or SUCCESSFUL.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "created_time",
+				Description: "The creation time of the investigation report.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "scope_start_time",
+				Description: "The start of the time period that the investigation covers.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Hydrate:     getDetectiveInvestigation,
+			},
+			{
+				Name:        "scope_end_time",
+				Description: "The end of the time period that the investigation covers.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Hydrate:     getDetectiveInvestigation,
+			},
+			{
+				Name:        "indicators",
+				Description: "The indicators of compromise found by the investigation, e.g. TTPs observed, impossible travel or related GuardDuty findings.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listDetectiveInvestigationIndicators,
+				Transform:   transform.FromValue(),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("InvestigationId"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listDetectiveInvestigations(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	graphArn := aws.ToString(h.Item.(types.Graph).Arn)
+
+	// Minimize the API call with the given graph_arn
+	if d.EqualsQualString("graph_arn") != "" && d.EqualsQualString("graph_arn") != graphArn {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := DetectiveClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_detective_investigation.listDetectiveInvestigations", "client_error", err)
+		return nil, err
+	}
+
+	// Unsupported region check
+	if svc == nil {
+		return nil, nil
+	}
+
+	maxItems := int32(100)
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxItems {
+			if limit < 1 {
+				maxItems = int32(1)
+			} else {
+				maxItems = int32(limit)
+			}
+		}
+	}
+
+	input := &detective.ListInvestigationsInput{
+		GraphArn:       aws.String(graphArn),
+		MaxResults:     aws.Int32(maxItems),
+		FilterCriteria: buildDetectiveInvestigationFilterCriteria(d.Quals),
+	}
+
+	// API doesn't support aws-sdk-go-v2 paginator as of date.
+	pagesLeft := true
+	for pagesLeft {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := svc.ListInvestigations(ctx, input)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_detective_investigation.listDetectiveInvestigations", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range output.InvestigationDetails {
+			d.StreamListItem(ctx, DetectiveInvestigationInfo{item, aws.String(graphArn)})
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if output.NextToken != nil {
+			input.NextToken = output.NextToken
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getDetectiveInvestigation(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var graphArn, investigationId string
+	if h.Item != nil {
+		switch item := h.Item.(type) {
+		case DetectiveInvestigationInfo:
+			graphArn, investigationId = aws.ToString(item.GraphArn), aws.ToString(item.InvestigationId)
+		case *detective.GetInvestigationOutput:
+			return item, nil
+		}
+	} else {
+		graphArn = d.EqualsQualString("graph_arn")
+		investigationId = d.EqualsQualString("investigation_id")
+	}
+
+	if graphArn == "" || investigationId == "" {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := DetectiveClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_detective_investigation.getDetectiveInvestigation", "client_error", err)
+		return nil, err
+	}
+
+	// Unsupported region check
+	if svc == nil {
+		return nil, nil
+	}
+
+	params := &detective.GetInvestigationInput{
+		GraphArn:        aws.String(graphArn),
+		InvestigationId: aws.String(investigationId),
+	}
+
+	op, err := svc.GetInvestigation(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_detective_investigation.getDetectiveInvestigation", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
+
+func listDetectiveInvestigationIndicators(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var graphArn, investigationId *string
+	switch item := h.Item.(type) {
+	case DetectiveInvestigationInfo:
+		graphArn, investigationId = item.GraphArn, item.InvestigationId
+	case *detective.GetInvestigationOutput:
+		graphArn, investigationId = item.GraphArn, item.InvestigationId
+	}
+
+	// Create session
+	svc, err := DetectiveClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_detective_investigation.listDetectiveInvestigationIndicators", "client_error", err)
+		return nil, err
+	}
+
+	// Unsupported region check
+	if svc == nil {
+		return nil, nil
+	}
+
+	input := &detective.ListIndicatorsInput{
+		GraphArn:        graphArn,
+		InvestigationId: investigationId,
+		MaxResults:      aws.Int32(100),
+	}
+
+	var indicators []types.Indicator
+
+	// API doesn't support aws-sdk-go-v2 paginator as of date.
+	pagesLeft := true
+	for pagesLeft {
+		output, err := svc.ListIndicators(ctx, input)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_detective_investigation.listDetectiveInvestigationIndicators", "api_error", err)
+			return nil, err
+		}
+
+		indicators = append(indicators, output.Indicators...)
+
+		if output.NextToken != nil {
+			input.NextToken = output.NextToken
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return indicators, nil
+}
+
+//// UTILITY FUNCTION
+
+// Build detective investigation filter param
+func buildDetectiveInvestigationFilterCriteria(quals plugin.KeyColumnQualMap) *types.FilterCriteria {
+	criteria := &types.FilterCriteria{}
+	isFiltered := false
+
+	stringFilters := map[string]**types.StringFilter{
+		"entity_arn": &criteria.EntityArn,
+		"severity":   &criteria.Severity,
+		"state":      &criteria.State,
+		"status":     &criteria.Status,
+	}
+	for columnName, filter := range stringFilters {
+		if quals[columnName] == nil {
+			continue
+		}
+		for _, q := range quals[columnName].Quals {
+			if q.Operator == "=" {
+				*filter = &types.StringFilter{Value: aws.String(q.Value.GetStringValue())}
+				isFiltered = true
+			}
+		}
+	}
+
+	// The API requires both ends of the created time range
+	if quals["created_time"] != nil {
+		var start, end *time.Time
+		for _, q := range quals["created_time"].Quals {
+			timestamp := q.Value.GetTimestampValue().AsTime()
+			switch q.Operator {
+			case "=":
+				start, end = &timestamp, &timestamp
+			case ">", ">=":
+				start = &timestamp
+			case "<", "<=":
+				end = &timestamp
+			}
+		}
+		if start == nil {
+			start = aws.Time(time.Unix(0, 0))
+		}
+		if end == nil {
+			end = aws.Time(time.Now())
+		}
+		criteria.CreatedTime = &types.DateFilter{
+			StartInclusive: start,
+			EndInclusive:   end,
+		}
+		isFiltered = true
+	}
+
+	if !isFiltered {
+		return nil
+	}
+	return criteria
+}
@@ -0,0 +1,260 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/detective"
+	"github.com/aws/aws-sdk-go-v2/service/detective/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsDetectiveMember(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_detective_member",
+		Description: "AWS Detective Member",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"member_account_id", "graph_arn"}),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException", "ValidationException"}),
+			},
+			Hydrate: getDetectiveMember,
+			Tags:    map[string]string{"service": "detective", "action": "GetMembers"},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listDetectiveGraphs,
+			Hydrate:       listDetectiveMembers,
+			Tags:          map[string]string{"service": "detective", "action": "ListMembers"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "graph_arn", Require: plugin.Optional},
+			},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getDetectiveMemberDatasources,
+				Tags: map[string]string{"service": "detective", "action": "BatchGetGraphMemberDatasources"},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(AWS_API_DETECTIVE_SERVICE_ID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "member_account_id",
+				Description: "The Amazon Web Services account identifier for the member account.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AccountId"),
+			},
+			{
+				Name:        "graph_arn",
+				Description: "The ARN of the behavior graph.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "administrator_id",
+				Description: "The Amazon Web Services account identifier of the administrator account for the behavior graph.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "email_address",
+				Description: "The Amazon Web Services account root user email address for the member account.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "The current membership status of the member account. Can be one of INVITED, VERIFICATION_IN_PROGRESS, VERIFICATION_FAILED, ENABLED or ACCEPTED_BUT_DISABLED.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "disabled_reason",
+				Description: "For member accounts with a status of ACCEPTED_BUT_DISABLED, the reason that the member account is not enabled. Can be VOLUME_TOO_HIGH or VOLUME_UNKNOWN.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "invitation_type",
+				Description: "The type of behavior graph membership. Can be INVITATION or ORGANIZATION.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "invited_time",
+				Description: "For invited accounts, the date and time that Detective sent the invitation to the account.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "updated_time",
+				Description: "The date and time that the member account was last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "datasource_package_ingest_states",
+				Description: "The state of each data source package for the member account, e.g. {\"DETECTIVE_CORE\": \"STARTED\"}.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "volume_usage_by_datasource_package",
+				Description: "The volume of data in bytes per day ingested for each data source package of the member account.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "datasource_package_ingest_history",
+				Description: "The history of each data source package of the member account, i.e. when the package was started or stopped.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getDetectiveMemberDatasources,
+				Transform:   transform.FromField("DatasourcePackageIngestHistory"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AccountId"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listDetectiveMembers(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	graphArn := aws.ToString(h.Item.(types.Graph).Arn)
+
+	// Minimize the API call with the given graph_arn
+	if d.EqualsQualString("graph_arn") != "" && d.EqualsQualString("graph_arn") != graphArn {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := DetectiveClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_detective_member.listDetectiveMembers", "client_error", err)
+		return nil, err
+	}
+
+	// Unsupported region check
+	if svc == nil {
+		return nil, nil
+	}
+
+	maxItems := int32(200)
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxItems {
+			if limit < 1 {
+				maxItems = int32(1)
+			} else {
+				maxItems = int32(limit)
+			}
+		}
+	}
+
+	input := &detective.ListMembersInput{
+		GraphArn:   aws.String(graphArn),
+		MaxResults: aws.Int32(maxItems),
+	}
+
+	paginator := detective.NewListMembersPaginator(svc, input, func(o *detective.ListMembersPaginatorOptions) {
+		o.Limit = maxItems
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_detective_member.listDetectiveMembers", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range output.MemberDetails {
+			d.StreamListItem(ctx, item)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getDetectiveMember(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	accountId := d.EqualsQualString("member_account_id")
+	graphArn := d.EqualsQualString("graph_arn")
+
+	if accountId == "" || graphArn == "" {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := DetectiveClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_detective_member.getDetectiveMember", "client_error", err)
+		return nil, err
+	}
+
+	// Unsupported region check
+	if svc == nil {
+		return nil, nil
+	}
+
+	params := &detective.GetMembersInput{
+		AccountIds: []string{accountId},
+		GraphArn:   aws.String(graphArn),
+	}
+
+	op, err := svc.GetMembers(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_detective_member.getDetectiveMember", "api_error", err)
+		return nil, err
+	}
+
+	if len(op.MemberDetails) > 0 {
+		return op.MemberDetails[0], nil
+	}
+
+	return nil, nil
+}
+
+func getDetectiveMemberDatasources(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	member := h.Item.(types.MemberDetail)
+
+	// Create session
+	svc, err := DetectiveClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_detective_member.getDetectiveMemberDatasources", "client_error", err)
+		return nil, err
+	}
+
+	// Unsupported region check
+	if svc == nil {
+		return nil, nil
+	}
+
+	params := &detective.BatchGetGraphMemberDatasourcesInput{
+		AccountIds: []string{aws.ToString(member.AccountId)},
+		GraphArn:   member.GraphArn,
+	}
+
+	op, err := svc.BatchGetGraphMemberDatasources(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_detective_member.getDetectiveMemberDatasources", "api_error", err)
+		return nil, err
+	}
+
+	if len(op.MemberDatasources) > 0 {
+		return op.MemberDatasources[0], nil
+	}
+
+	return nil, nil
+}
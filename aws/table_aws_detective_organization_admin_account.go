@@ -0,0 +1,117 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/detective"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsDetectiveOrganizationAdminAccount(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_detective_organization_admin_account",
+		Description: "AWS Detective Organization Admin Account",
+		List: &plugin.ListConfig{
+			Hydrate: listDetectiveOrganizationAdminAccounts,
+			Tags:    map[string]string{"service": "detective", "action": "ListOrganizationAdminAccounts"},
+			// Only the organization management account can list the Detective administrator accounts
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"AccessDeniedException", "ValidationException"}),
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(AWS_API_DETECTIVE_SERVICE_ID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "admin_account_id",
+				Description: "The Amazon Web Services account identifier of the Detective administrator account for the organization.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AccountId"),
+			},
+			{
+				Name:        "graph_arn",
+				Description: "The ARN of the organization behavior graph.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "delegation_time",
+				Description: "The date and time when the Detective administrator account was enabled.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AccountId"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listDetectiveOrganizationAdminAccounts(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := DetectiveClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_detective_organization_admin_account.listDetectiveOrganizationAdminAccounts", "client_error", err)
+		return nil, err
+	}
+
+	// Unsupported region check
+	if svc == nil {
+		return nil, nil
+	}
+
+	maxItems := int32(200)
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxItems {
+			if limit < 1 {
+				maxItems = int32(1)
+			} else {
+				maxItems = int32(limit)
+			}
+		}
+	}
+
+	input := &detective.ListOrganizationAdminAccountsInput{
+		MaxResults: aws.Int32(maxItems),
+	}
+
+	paginator := detective.NewListOrganizationAdminAccountsPaginator(svc, input, func(o *detective.ListOrganizationAdminAccountsPaginatorOptions) {
+		o.Limit = maxItems
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_detective_organization_admin_account.listDetectiveOrganizationAdminAccounts", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range output.Administrators {
+			d.StreamListItem(ctx, item)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
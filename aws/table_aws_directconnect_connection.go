@@ -0,0 +1,296 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/directconnect"
+	"github.com/aws/aws-sdk-go-v2/service/directconnect/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsDirectConnectConnection(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_directconnect_connection",
+		Description: "AWS Direct Connect Connection",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("connection_id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"DirectConnectClientException"}),
+			},
+			Hydrate: getDirectConnectConnection,
+			Tags:    map[string]string{"service": "directconnect", "action": "DescribeConnections"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listDirectConnectConnections,
+			Tags:    map[string]string{"service": "directconnect", "action": "DescribeConnections"},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(AWS_DIRECTCONNECT_SERVICE_ID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "connection_id",
+				Description: "The ID of the connection.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "connection_name",
+				Description: "The name of the connection.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the connection.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getDirectConnectConnectionArn,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "connection_state",
+				Description: "The state of the connection.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "bandwidth",
+				Description: "The bandwidth of the connection.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "location",
+				Description: "The location of the connection.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "owner_account",
+				Description: "The ID of the AWS account that owns the connection. For hosted connections this is the customer account, which can differ from the account the connection is queried from.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "lag_id",
+				Description: "The ID of the LAG that the connection is a member of.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "vlan",
+				Description: "The ID of the VLAN, for hosted connections.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "partner_name",
+				Description: "The name of the AWS Direct Connect service provider associated with the connection.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "provider_name",
+				Description: "The name of the service provider associated with the connection.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "aws_device_v2",
+				Description: "The Direct Connect endpoint that terminates the physical connection.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AwsDeviceV2"),
+			},
+			{
+				Name:        "aws_logical_device_id",
+				Description: "The Direct Connect endpoint that terminates the logical connection.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "has_logical_redundancy",
+				Description: "Indicates whether the connection supports a secondary BGP peer in the same address family (IPv4/IPv6).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "jumbo_frame_capable",
+				Description: "Indicates whether jumbo frames are supported.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "loa_issue_time",
+				Description: "The time of the most recent call to DescribeLoa for this connection.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "mac_sec_capable",
+				Description: "Indicates whether the connection supports MAC Security (MACsec).",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "encryption_mode",
+				Description: "The MAC Security (MACsec) connection encryption mode.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "port_encryption_status",
+				Description: "The MAC Security (MACsec) port link status of the connection.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "mac_sec_keys",
+				Description: "The MAC Security (MACsec) security keys associated with the connection.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags assigned to the connection.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ConnectionName"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags").Transform(directConnectTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getDirectConnectConnectionArn,
+				Transform:   transform.FromValue().Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listDirectConnectConnections(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+
+	// Create session
+	svc, err := DirectConnectClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_directconnect_connection.listDirectConnectConnections", "client_error", err)
+		return nil, err
+	}
+
+	// Unsupported region check
+	if svc == nil {
+		return nil, nil
+	}
+
+	input := &directconnect.DescribeConnectionsInput{}
+
+	// API doesn't support aws-sdk-go-v2 paginator as of date.
+	pagesLeft := true
+
+	for pagesLeft {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		result, err := svc.DescribeConnections(ctx, input)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_directconnect_connection.listDirectConnectConnections", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range result.Connections {
+			d.StreamListItem(ctx, item)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if result.NextToken != nil {
+			pagesLeft = true
+			input.NextToken = result.NextToken
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getDirectConnectConnection(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	connectionId := d.EqualsQualString("connection_id")
+	if connectionId == "" {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := DirectConnectClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_directconnect_connection.getDirectConnectConnection", "client_error", err)
+		return nil, err
+	}
+
+	// Unsupported region check
+	if svc == nil {
+		return nil, nil
+	}
+
+	params := &directconnect.DescribeConnectionsInput{
+		ConnectionId: aws.String(connectionId),
+	}
+
+	op, err := svc.DescribeConnections(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_directconnect_connection.getDirectConnectConnection", "api_error", err)
+		return nil, err
+	}
+
+	if len(op.Connections) > 0 {
+		return op.Connections[0], nil
+	}
+	return nil, nil
+}
+
+func getDirectConnectConnectionArn(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	connection := h.Item.(types.Connection)
+	return directConnectArn(ctx, d, h, aws.ToString(connection.Region), aws.ToString(connection.OwnerAccount), "dxcon/"+*connection.ConnectionId)
+}
+
+//// UTILITY FUNCTIONS
+
+// directConnectArn builds the ARN of a Direct Connect resource. The ARN uses
+// the account that owns the resource, which for hosted connections and
+// virtual interfaces is not the account they are queried from. Direct Connect
+// gateways are global, and have no region in their ARN.
+func directConnectArn(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData, region string, ownerAccount string, resource string) (string, error) {
+	commonData, err := getCommonColumns(ctx, d, h)
+	if err != nil {
+		plugin.Logger(ctx).Error("directConnectArn", "common_data_error", err)
+		return "", err
+	}
+	commonColumnData := commonData.(*awsCommonColumnData)
+
+	if ownerAccount == "" {
+		ownerAccount = commonColumnData.AccountId
+	}
+
+	return "arn:" + commonColumnData.Partition + ":directconnect:" + region + ":" + ownerAccount + ":" + resource, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func directConnectTurbotTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	tags, ok := d.Value.([]types.Tag)
+	if !ok || len(tags) == 0 {
+		return nil, nil
+	}
+
+	turbotTagsMap := map[string]string{}
+	for _, i := range tags {
+		turbotTagsMap[*i.Key] = aws.ToString(i.Value)
+	}
+
+	return turbotTagsMap, nil
+}
@@ -0,0 +1,39 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/directconnect/types"
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsDirectConnectConnectionMetricBpsIngress(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_directconnect_connection_metric_bps_ingress",
+		Description: "AWS Direct Connect Connection Cloudwatch Metrics - Bps Ingress",
+		List: &plugin.ListConfig{
+			ParentHydrate: listDirectConnectConnections,
+			Hydrate:       listDirectConnectConnectionMetricBpsIngress,
+			Tags:          map[string]string{"service": "cloudwatch", "action": "GetMetricStatistics"},
+		},
+		GetMatrixItemFunc: CloudWatchRegionsMatrix,
+		Columns: awsRegionalColumns(cwMetricColumns(
+			[]*plugin.Column{
+				{
+					Name:        "connection_id",
+					Description: "The ID of the connection.",
+					Type:        proto.ColumnType_STRING,
+					Transform:   transform.FromField("DimensionValue"),
+				},
+			})),
+	}
+}
+
+func listDirectConnectConnectionMetricBpsIngress(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	connection := h.Item.(types.Connection)
+	return listCWMetricStatistics(ctx, d, "5_MIN", "AWS/DX", "ConnectionBpsIngress", "ConnectionId", *connection.ConnectionId)
+}
@@ -0,0 +1,157 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/directconnect"
+	"github.com/aws/aws-sdk-go-v2/service/directconnect/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsDirectConnectGatewayAssociation(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_directconnect_gateway_association",
+		Description: "AWS Direct Connect Gateway Association",
+		List: &plugin.ListConfig{
+			ParentHydrate: listDirectConnectGateways,
+			Hydrate:       listDirectConnectGatewayAssociations,
+			Tags:          map[string]string{"service": "directconnect", "action": "DescribeDirectConnectGatewayAssociations"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "direct_connect_gateway_id", Require: plugin.Optional},
+				{Name: "associated_gateway_id", Require: plugin.Optional},
+			},
+		},
+		Columns: awsGlobalRegionColumns([]*plugin.Column{
+			{
+				Name:        "association_id",
+				Description: "The ID of the Direct Connect gateway association.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "direct_connect_gateway_id",
+				Description: "The ID of the Direct Connect gateway.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "direct_connect_gateway_owner_account",
+				Description: "The ID of the AWS account that owns the Direct Connect gateway.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "association_state",
+				Description: "The state of the association.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "associated_gateway_id",
+				Description: "The ID of the associated virtual private gateway or transit gateway.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AssociatedGateway.Id"),
+			},
+			{
+				Name:        "associated_gateway_type",
+				Description: "The type of the associated gateway.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AssociatedGateway.Type"),
+			},
+			{
+				Name:        "associated_gateway_owner_account",
+				Description: "The ID of the AWS account that owns the associated gateway.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AssociatedGateway.OwnerAccount"),
+			},
+			{
+				Name:        "associated_gateway_region",
+				Description: "The Region where the associated gateway is located.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AssociatedGateway.Region"),
+			},
+			{
+				Name:        "associated_core_network",
+				Description: "The Cloud WAN core network associated with the Direct Connect gateway.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "allowed_prefixes_to_direct_connect_gateway",
+				Description: "The Amazon VPC prefixes to advertise to the Direct Connect gateway.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "state_change_error",
+				Description: "The error message if the state of an object failed to advance.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AssociationId"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listDirectConnectGatewayAssociations(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	gateway := h.Item.(types.DirectConnectGateway)
+
+	// Minimize the API calls if the gateway ID is specified in the query
+	if d.EqualsQualString("direct_connect_gateway_id") != "" && d.EqualsQualString("direct_connect_gateway_id") != *gateway.DirectConnectGatewayId {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := DirectConnectGlobalClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_directconnect_gateway_association.listDirectConnectGatewayAssociations", "client_error", err)
+		return nil, err
+	}
+
+	input := &directconnect.DescribeDirectConnectGatewayAssociationsInput{
+		DirectConnectGatewayId: gateway.DirectConnectGatewayId,
+	}
+	if d.EqualsQualString("associated_gateway_id") != "" {
+		input.AssociatedGatewayId = aws.String(d.EqualsQualString("associated_gateway_id"))
+	}
+
+	// API doesn't support aws-sdk-go-v2 paginator as of date.
+	pagesLeft := true
+
+	for pagesLeft {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		result, err := svc.DescribeDirectConnectGatewayAssociations(ctx, input)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_directconnect_gateway_association.listDirectConnectGatewayAssociations", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range result.DirectConnectGatewayAssociations {
+			d.StreamListItem(ctx, item)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if result.NextToken != nil {
+			pagesLeft = true
+			input.NextToken = result.NextToken
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,97 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/directconnect"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsDirectConnectLocation(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_directconnect_location",
+		Description: "AWS Direct Connect Location",
+		List: &plugin.ListConfig{
+			Hydrate: listDirectConnectLocations,
+			Tags:    map[string]string{"service": "directconnect", "action": "DescribeLocations"},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(AWS_DIRECTCONNECT_SERVICE_ID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "location_code",
+				Description: "The code for the location.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "location_name",
+				Description: "The name of the location, including the name of the colocation partner and the physical site of the building.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "available_port_speeds",
+				Description: "The available port speeds for the location.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "available_mac_sec_port_speeds",
+				Description: "The available MAC Security (MACsec) port speeds for the location.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "available_providers",
+				Description: "The names of the service providers for the location.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LocationName"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listDirectConnectLocations(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+
+	// Create session
+	svc, err := DirectConnectClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_directconnect_location.listDirectConnectLocations", "client_error", err)
+		return nil, err
+	}
+
+	// Unsupported region check
+	if svc == nil {
+		return nil, nil
+	}
+
+	// apply rate limiting
+	d.WaitForListRateLimit(ctx)
+
+	result, err := svc.DescribeLocations(ctx, &directconnect.DescribeLocationsInput{})
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_directconnect_location.listDirectConnectLocations", "api_error", err)
+		return nil, err
+	}
+
+	for _, item := range result.Locations {
+		d.StreamListItem(ctx, item)
+
+		// Context may get cancelled due to manual cancellation or if the limit has been reached
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,308 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/directconnect"
+	"github.com/aws/aws-sdk-go-v2/service/directconnect/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsDirectConnectVirtualInterface(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_directconnect_virtual_interface",
+		Description: "AWS Direct Connect Virtual Interface",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("virtual_interface_id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"DirectConnectClientException"}),
+			},
+			Hydrate: getDirectConnectVirtualInterface,
+			Tags:    map[string]string{"service": "directconnect", "action": "DescribeVirtualInterfaces"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listDirectConnectVirtualInterfaces,
+			Tags:    map[string]string{"service": "directconnect", "action": "DescribeVirtualInterfaces"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "connection_id", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(AWS_DIRECTCONNECT_SERVICE_ID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "virtual_interface_id",
+				Description: "The ID of the virtual interface.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "virtual_interface_name",
+				Description: "The name of the virtual interface.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the virtual interface.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getDirectConnectVirtualInterfaceArn,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "virtual_interface_type",
+				Description: "The type of virtual interface. The possible values are private, public and transit.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "virtual_interface_state",
+				Description: "The state of the virtual interface.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "connection_id",
+				Description: "The ID of the connection or LAG that the virtual interface runs over.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "owner_account",
+				Description: "The ID of the AWS account that owns the virtual interface.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "virtual_gateway_id",
+				Description: "The ID of the virtual private gateway, for private virtual interfaces that are attached directly to a VPC.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "direct_connect_gateway_id",
+				Description: "The ID of the Direct Connect gateway, for private and transit virtual interfaces that are attached through a Direct Connect gateway.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "location",
+				Description: "The location of the connection.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "vlan",
+				Description: "The ID of the VLAN.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "asn",
+				Description: "The autonomous system number (ASN) for the Border Gateway Protocol (BGP) configuration of the customer side.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.From(directConnectVirtualInterfaceAsn),
+			},
+			{
+				Name:        "amazon_side_asn",
+				Description: "The autonomous system number (ASN) for the Amazon side of the connection.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "address_family",
+				Description: "The address family for the BGP peer.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "amazon_address",
+				Description: "The IP address assigned to the Amazon interface.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "customer_address",
+				Description: "The IP address assigned to the customer interface.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "mtu",
+				Description: "The maximum transmission unit (MTU), in bytes.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "jumbo_frame_capable",
+				Description: "Indicates whether jumbo frames are supported.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "site_link_enabled",
+				Description: "Indicates whether SiteLink is enabled.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "aws_device_v2",
+				Description: "The Direct Connect endpoint that terminates the physical connection.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AwsDeviceV2"),
+			},
+			{
+				Name:        "aws_logical_device_id",
+				Description: "The Direct Connect endpoint that terminates the logical connection.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "bgp_peers",
+				Description: "The BGP peers configured on the virtual interface. BGP authentication keys are not included.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("BgpPeers").Transform(directConnectBgpPeers),
+			},
+			{
+				Name:        "route_filter_prefixes",
+				Description: "The routes to be advertised to the AWS network in this Region, for public virtual interfaces.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags assigned to the virtual interface.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("VirtualInterfaceName"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags").Transform(directConnectTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getDirectConnectVirtualInterfaceArn,
+				Transform:   transform.FromValue().Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listDirectConnectVirtualInterfaces(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+
+	// Create session
+	svc, err := DirectConnectClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_directconnect_virtual_interface.listDirectConnectVirtualInterfaces", "client_error", err)
+		return nil, err
+	}
+
+	// Unsupported region check
+	if svc == nil {
+		return nil, nil
+	}
+
+	input := &directconnect.DescribeVirtualInterfacesInput{}
+	if d.EqualsQualString("connection_id") != "" {
+		input.ConnectionId = aws.String(d.EqualsQualString("connection_id"))
+	}
+
+	// API doesn't support aws-sdk-go-v2 paginator as of date.
+	pagesLeft := true
+
+	for pagesLeft {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		result, err := svc.DescribeVirtualInterfaces(ctx, input)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_directconnect_virtual_interface.listDirectConnectVirtualInterfaces", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range result.VirtualInterfaces {
+			d.StreamListItem(ctx, item)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if result.NextToken != nil {
+			pagesLeft = true
+			input.NextToken = result.NextToken
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getDirectConnectVirtualInterface(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	virtualInterfaceId := d.EqualsQualString("virtual_interface_id")
+	if virtualInterfaceId == "" {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := DirectConnectClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_directconnect_virtual_interface.getDirectConnectVirtualInterface", "client_error", err)
+		return nil, err
+	}
+
+	// Unsupported region check
+	if svc == nil {
+		return nil, nil
+	}
+
+	params := &directconnect.DescribeVirtualInterfacesInput{
+		VirtualInterfaceId: aws.String(virtualInterfaceId),
+	}
+
+	op, err := svc.DescribeVirtualInterfaces(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_directconnect_virtual_interface.getDirectConnectVirtualInterface", "api_error", err)
+		return nil, err
+	}
+
+	if len(op.VirtualInterfaces) > 0 {
+		return op.VirtualInterfaces[0], nil
+	}
+	return nil, nil
+}
+
+func getDirectConnectVirtualInterfaceArn(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	virtualInterface := h.Item.(types.VirtualInterface)
+	return directConnectArn(ctx, d, h, aws.ToString(virtualInterface.Region), aws.ToString(virtualInterface.OwnerAccount), "dxvif/"+*virtualInterface.VirtualInterfaceId)
+}
+
+//// TRANSFORM FUNCTIONS
+
+// The 32-bit Asn field is 0 for 4-byte ASNs, which are only returned in AsnLong
+func directConnectVirtualInterfaceAsn(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	virtualInterface := d.HydrateItem.(types.VirtualInterface)
+	if virtualInterface.AsnLong != nil {
+		return *virtualInterface.AsnLong, nil
+	}
+	return virtualInterface.Asn, nil
+}
+
+// Remove the BGP MD5 authentication keys, which are shared secrets
+func directConnectBgpPeers(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	peers, ok := d.Value.([]types.BGPPeer)
+	if !ok {
+		return nil, nil
+	}
+
+	result := make([]types.BGPPeer, len(peers))
+	for i, peer := range peers {
+		peer.AuthKey = nil
+		result[i] = peer
+	}
+	return result, nil
+}
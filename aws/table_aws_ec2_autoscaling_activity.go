@@ -0,0 +1,232 @@
+package aws
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+func tableAwsEc2AutoScalingActivity(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_ec2_autoscaling_activity",
+		Description: "AWS EC2 Autoscaling Activity",
+		List: &plugin.ListConfig{
+			Hydrate: listAwsEc2AutoScalingActivities,
+			Tags:    map[string]string{"service": "autoscaling", "action": "DescribeScalingActivities"},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ValidationError"}),
+			},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "autoscaling_group_name", Require: plugin.Optional},
+				{Name: "activity_id", Require: plugin.Optional},
+				{Name: "include_deleted_groups", Require: plugin.Optional, Operators: []string{"="}},
+				{Name: "start_time", Require: plugin.Optional, Operators: []string{">", ">=", "<", "<=", "="}},
+				{Name: "status_code", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(AWS_AUTOSCALING_SERVICE_ID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "activity_id",
+				Description: "The ID of the activity.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "autoscaling_group_name",
+				Description: "The name of the Auto Scaling group.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AutoScalingGroupName"),
+			},
+			{
+				Name:        "autoscaling_group_arn",
+				Description: "The Amazon Resource Name (ARN) of the Auto Scaling group.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AutoScalingGroupARN"),
+			},
+			{
+				Name:        "autoscaling_group_state",
+				Description: "The state of the Auto Scaling group, which is either InService or Deleted.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AutoScalingGroupState"),
+			},
+			{
+				Name:        "status_code",
+				Description: "The current status of the activity, e.g. Successful, Failed, Cancelled or InProgress.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status_message",
+				Description: "A friendly, more verbose description of the activity status.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "A friendly, more verbose description of the activity.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "cause",
+				Description: "The reason the activity began.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "start_time",
+				Description: "The start time of the activity.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "end_time",
+				Description: "The end time of the activity.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "progress",
+				Description: "A value between 0 and 100 that indicates the progress of the activity.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "include_deleted_groups",
+				Description: "Indicates whether the activities of deleted Auto Scaling groups are included. Defaults to false.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromQual("include_deleted_groups"),
+			},
+			{
+				Name:        "details",
+				Description: "The details about the activity, such as the subnet and Availability Zone of a launched instance.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.From(autoScalingActivityDetails),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ActivityId"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAwsEc2AutoScalingActivities(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := AutoScalingClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ec2_autoscaling_activity.listAwsEc2AutoScalingActivities", "connection_error", err)
+		return nil, err
+	}
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &autoscaling.DescribeScalingActivitiesInput{
+		MaxRecords: aws.Int32(maxLimit),
+	}
+	if name := d.EqualsQualString("autoscaling_group_name"); name != "" {
+		input.AutoScalingGroupName = aws.String(name)
+	}
+	if activityId := d.EqualsQualString("activity_id"); activityId != "" {
+		input.ActivityIds = []string{activityId}
+	}
+	if d.EqualsQuals["include_deleted_groups"] != nil {
+		input.IncludeDeletedGroups = aws.Bool(d.EqualsQuals["include_deleted_groups"].GetBoolValue())
+	}
+
+	// Use the tightest lower bound of the start time quals
+	var startedAfter *time.Time
+	if d.Quals["start_time"] != nil {
+		for _, q := range d.Quals["start_time"].Quals {
+			timestamp := q.Value.GetTimestampValue().AsTime()
+			switch q.Operator {
+			case ">=", ">", "=":
+				if startedAfter == nil || timestamp.After(*startedAfter) {
+					startedAfter = &timestamp
+				}
+			}
+		}
+	}
+
+	// The API has no status filter, so activities are filtered once listed
+	statusCode := d.EqualsQualString("status_code")
+
+	// The activities of a group are returned in progress first, then the
+	// others latest first, so paging can stop at the first finished activity
+	// older than the lower bound. Across groups there is no such order, and
+	// older activities are only filtered out.
+	stopEarly := input.AutoScalingGroupName != nil
+
+	paginator := autoscaling.NewDescribeScalingActivitiesPaginator(svc, input, func(o *autoscaling.DescribeScalingActivitiesPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	// List call
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_ec2_autoscaling_activity.listAwsEc2AutoScalingActivities", "api_error", err)
+			return nil, err
+		}
+
+		for _, activity := range output.Activities {
+			if startedAfter != nil && activity.StartTime != nil && activity.StartTime.Before(*startedAfter) {
+				if stopEarly && activity.EndTime != nil {
+					return nil, nil
+				}
+				continue
+			}
+			if statusCode != "" && string(activity.StatusCode) != statusCode {
+				continue
+			}
+
+			d.StreamListItem(ctx, activity)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+// autoScalingActivityDetails parses the details of an activity, which are a
+// JSON object encoded as a string
+func autoScalingActivityDetails(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	activity := d.HydrateItem.(types.Activity)
+	if activity.Details == nil {
+		return nil, nil
+	}
+	var details interface{}
+	err := json.Unmarshal([]byte(*activity.Details), &details)
+	if err != nil {
+		return nil, nil
+	}
+	return details, nil
+}
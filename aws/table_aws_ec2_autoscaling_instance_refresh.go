@@ -0,0 +1,171 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+func tableAwsEc2AutoScalingInstanceRefresh(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_ec2_autoscaling_instance_refresh",
+		Description: "AWS EC2 Autoscaling Instance Refresh",
+		List: &plugin.ListConfig{
+			ParentHydrate: listAwsEc2AutoScalingGroup,
+			Hydrate:       listAwsEc2AutoScalingInstanceRefreshes,
+			Tags:          map[string]string{"service": "autoscaling", "action": "DescribeInstanceRefreshes"},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ValidationError"}),
+			},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "autoscaling_group_name", Require: plugin.Optional},
+				{Name: "instance_refresh_id", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(AWS_AUTOSCALING_SERVICE_ID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "instance_refresh_id",
+				Description: "The instance refresh ID.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "autoscaling_group_name",
+				Description: "The name of the Auto Scaling group.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AutoScalingGroupName"),
+			},
+			{
+				Name:        "status",
+				Description: "The current status of the instance refresh, e.g. Pending, InProgress, Successful, Failed, Cancelled or RollbackSuccessful.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status_reason",
+				Description: "The explanation for the specific status assigned to this operation.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "start_time",
+				Description: "The date and time at which the instance refresh began.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "end_time",
+				Description: "The date and time at which the instance refresh ended.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "percentage_complete",
+				Description: "The percentage of the instance refresh that is complete.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "instances_to_update",
+				Description: "The number of instances remaining to update before the instance refresh is complete.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "preferences",
+				Description: "The preferences of the instance refresh, such as the minimum healthy percentage, the instance warmup and the checkpoints.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "desired_configuration",
+				Description: "The desired configuration of the Auto Scaling group, such as the launch template and the mixed instances policy.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "progress_details",
+				Description: "Additional progress details for the Auto Scaling group and its warm pool.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "rollback_details",
+				Description: "The rollback details of the instance refresh.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("InstanceRefreshId"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAwsEc2AutoScalingInstanceRefreshes(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	asg := h.Item.(types.AutoScalingGroup)
+
+	// Minimize the API call with the given Auto Scaling group name
+	if name := d.EqualsQualString("autoscaling_group_name"); name != "" && name != aws.ToString(asg.AutoScalingGroupName) {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := AutoScalingClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ec2_autoscaling_instance_refresh.listAwsEc2AutoScalingInstanceRefreshes", "connection_error", err)
+		return nil, err
+	}
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &autoscaling.DescribeInstanceRefreshesInput{
+		AutoScalingGroupName: asg.AutoScalingGroupName,
+		MaxRecords:           aws.Int32(maxLimit),
+	}
+	if id := d.EqualsQualString("instance_refresh_id"); id != "" {
+		input.InstanceRefreshIds = []string{id}
+	}
+
+	paginator := autoscaling.NewDescribeInstanceRefreshesPaginator(svc, input, func(o *autoscaling.DescribeInstanceRefreshesPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	// List call
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_ec2_autoscaling_instance_refresh.listAwsEc2AutoScalingInstanceRefreshes", "api_error", err)
+			return nil, err
+		}
+
+		for _, refresh := range output.InstanceRefreshes {
+			d.StreamListItem(ctx, refresh)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,169 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+func tableAwsEc2AutoScalingLifecycleHook(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_ec2_autoscaling_lifecycle_hook",
+		Description: "AWS EC2 Autoscaling Lifecycle Hook",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"autoscaling_group_name", "lifecycle_hook_name"}),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ValidationError"}),
+			},
+			Hydrate: getAwsEc2AutoScalingLifecycleHook,
+			Tags:    map[string]string{"service": "autoscaling", "action": "DescribeLifecycleHooks"},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listAwsEc2AutoScalingGroup,
+			Hydrate:       listAwsEc2AutoScalingLifecycleHooks,
+			Tags:          map[string]string{"service": "autoscaling", "action": "DescribeLifecycleHooks"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "autoscaling_group_name", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(AWS_AUTOSCALING_SERVICE_ID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "lifecycle_hook_name",
+				Description: "The name of the lifecycle hook.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "autoscaling_group_name",
+				Description: "The name of the Auto Scaling group for the lifecycle hook.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AutoScalingGroupName"),
+			},
+			{
+				Name:        "lifecycle_transition",
+				Description: "The lifecycle transition, either autoscaling:EC2_INSTANCE_LAUNCHING or autoscaling:EC2_INSTANCE_TERMINATING.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "default_result",
+				Description: "The action the Auto Scaling group takes when the lifecycle hook timeout elapses or if an unexpected failure occurs, either CONTINUE or ABANDON.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "heartbeat_timeout",
+				Description: "The maximum time, in seconds, that can elapse before the lifecycle hook times out.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "global_timeout",
+				Description: "The maximum time, in seconds, that an instance can remain in a wait state.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "notification_target_arn",
+				Description: "The ARN of the target that Amazon EC2 Auto Scaling sends notifications to when an instance is in a wait state for the lifecycle hook.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("NotificationTargetARN"),
+			},
+			{
+				Name:        "role_arn",
+				Description: "The ARN of the IAM role that allows the Auto Scaling group to publish to the notification target.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RoleARN"),
+			},
+			{
+				Name:        "notification_metadata",
+				Description: "Additional information that is included any time Amazon EC2 Auto Scaling sends a message to the notification target.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LifecycleHookName"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAwsEc2AutoScalingLifecycleHooks(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	asg := h.Item.(types.AutoScalingGroup)
+
+	// Minimize the API call with the given Auto Scaling group name
+	if name := d.EqualsQualString("autoscaling_group_name"); name != "" && name != aws.ToString(asg.AutoScalingGroupName) {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := AutoScalingClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ec2_autoscaling_lifecycle_hook.listAwsEc2AutoScalingLifecycleHooks", "connection_error", err)
+		return nil, err
+	}
+
+	// apply rate limiting
+	d.WaitForListRateLimit(ctx)
+
+	output, err := svc.DescribeLifecycleHooks(ctx, &autoscaling.DescribeLifecycleHooksInput{
+		AutoScalingGroupName: asg.AutoScalingGroupName,
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ec2_autoscaling_lifecycle_hook.listAwsEc2AutoScalingLifecycleHooks", "api_error", err)
+		return nil, err
+	}
+
+	for _, hook := range output.LifecycleHooks {
+		d.StreamListItem(ctx, hook)
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getAwsEc2AutoScalingLifecycleHook(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	groupName := d.EqualsQualString("autoscaling_group_name")
+	hookName := d.EqualsQualString("lifecycle_hook_name")
+
+	// Empty check
+	if groupName == "" || hookName == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := AutoScalingClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ec2_autoscaling_lifecycle_hook.getAwsEc2AutoScalingLifecycleHook", "connection_error", err)
+		return nil, err
+	}
+
+	output, err := svc.DescribeLifecycleHooks(ctx, &autoscaling.DescribeLifecycleHooksInput{
+		AutoScalingGroupName: aws.String(groupName),
+		LifecycleHookNames:   []string{hookName},
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ec2_autoscaling_lifecycle_hook.getAwsEc2AutoScalingLifecycleHook", "api_error", err)
+		return nil, err
+	}
+
+	if len(output.LifecycleHooks) > 0 {
+		return output.LifecycleHooks[0], nil
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,161 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+func tableAwsEc2AutoScalingScheduledAction(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_ec2_autoscaling_scheduled_action",
+		Description: "AWS EC2 Autoscaling Scheduled Action",
+		List: &plugin.ListConfig{
+			Hydrate: listAwsEc2AutoScalingScheduledActions,
+			Tags:    map[string]string{"service": "autoscaling", "action": "DescribeScheduledActions"},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ValidationError"}),
+			},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "autoscaling_group_name", Require: plugin.Optional},
+				{Name: "scheduled_action_name", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(AWS_AUTOSCALING_SERVICE_ID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "scheduled_action_name",
+				Description: "The name of the scheduled action.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the scheduled action.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ScheduledActionARN"),
+			},
+			{
+				Name:        "autoscaling_group_name",
+				Description: "The name of the Auto Scaling group.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AutoScalingGroupName"),
+			},
+			{
+				Name:        "recurrence",
+				Description: "The recurring schedule for the action, in Unix cron syntax format.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "time_zone",
+				Description: "The time zone for the cron expression.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "start_time",
+				Description: "The date and time in UTC for this action to start.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "end_time",
+				Description: "The date and time in UTC for the recurring schedule to end.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "desired_capacity",
+				Description: "The desired capacity is the initial capacity of the Auto Scaling group after the scheduled action runs.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "min_size",
+				Description: "The minimum size of the Auto Scaling group.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "max_size",
+				Description: "The maximum size of the Auto Scaling group.",
+				Type:        proto.ColumnType_INT,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ScheduledActionName"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ScheduledActionARN").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAwsEc2AutoScalingScheduledActions(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := AutoScalingClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ec2_autoscaling_scheduled_action.listAwsEc2AutoScalingScheduledActions", "connection_error", err)
+		return nil, err
+	}
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &autoscaling.DescribeScheduledActionsInput{
+		MaxRecords: aws.Int32(maxLimit),
+	}
+	if name := d.EqualsQualString("autoscaling_group_name"); name != "" {
+		input.AutoScalingGroupName = aws.String(name)
+	}
+	if name := d.EqualsQualString("scheduled_action_name"); name != "" {
+		input.ScheduledActionNames = []string{name}
+	}
+
+	paginator := autoscaling.NewDescribeScheduledActionsPaginator(svc, input, func(o *autoscaling.DescribeScheduledActionsPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	// List call
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_ec2_autoscaling_scheduled_action.listAwsEc2AutoScalingScheduledActions", "api_error", err)
+			return nil, err
+		}
+
+		for _, action := range output.ScheduledUpdateGroupActions {
+			d.StreamListItem(ctx, action)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,179 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+type WarmPoolInstanceInfo struct {
+	types.Instance
+	AutoScalingGroupName  *string
+	AutoScalingGroupARN   *string
+	WarmPoolConfiguration *types.WarmPoolConfiguration
+}
+
+func tableAwsEc2AutoScalingWarmPoolInstance(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_ec2_autoscaling_warm_pool_instance",
+		Description: "AWS EC2 Autoscaling Warm Pool Instance",
+		List: &plugin.ListConfig{
+			ParentHydrate: listAwsEc2AutoScalingGroup,
+			Hydrate:       listAwsEc2AutoScalingWarmPoolInstances,
+			Tags:          map[string]string{"service": "autoscaling", "action": "DescribeWarmPool"},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ValidationError"}),
+			},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "autoscaling_group_name", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(AWS_AUTOSCALING_SERVICE_ID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "instance_id",
+				Description: "The ID of the instance.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "autoscaling_group_name",
+				Description: "The name of the Auto Scaling group of the warm pool.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AutoScalingGroupName"),
+			},
+			{
+				Name:        "autoscaling_group_arn",
+				Description: "The Amazon Resource Name (ARN) of the Auto Scaling group of the warm pool.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AutoScalingGroupARN"),
+			},
+			{
+				Name:        "lifecycle_state",
+				Description: "A description of the current lifecycle state of the instance, e.g. Warmed:Stopped or Warmed:Pending.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "health_status",
+				Description: "The last reported health status of the instance, either Healthy or Unhealthy.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "availability_zone",
+				Description: "The Availability Zone in which the instance is running.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "instance_type",
+				Description: "The instance type of the EC2 instance.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "protected_from_scale_in",
+				Description: "Indicates whether the instance is protected from termination by Amazon EC2 Auto Scaling when scaling in.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "weighted_capacity",
+				Description: "The number of capacity units contributed by the instance based on its instance type.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "launch_configuration_name",
+				Description: "The launch configuration associated with the instance.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "launch_template",
+				Description: "The launch template for the instance.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "warm_pool_state",
+				Description: "The instance state to transition to after the lifecycle actions are complete, either Stopped, Running or Hibernated.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("WarmPoolConfiguration.PoolState"),
+			},
+			{
+				Name:        "warm_pool_status",
+				Description: "The status of the warm pool, which is set to PendingDelete while the warm pool is being deleted.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("WarmPoolConfiguration.Status"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("InstanceId"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAwsEc2AutoScalingWarmPoolInstances(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	asg := h.Item.(types.AutoScalingGroup)
+
+	// Minimize the API call with the given Auto Scaling group name
+	if name := d.EqualsQualString("autoscaling_group_name"); name != "" && name != aws.ToString(asg.AutoScalingGroupName) {
+		return nil, nil
+	}
+
+	// Only groups with a warm pool have warm pool instances
+	if asg.WarmPoolConfiguration == nil {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := AutoScalingClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ec2_autoscaling_warm_pool_instance.listAwsEc2AutoScalingWarmPoolInstances", "connection_error", err)
+		return nil, err
+	}
+
+	input := &autoscaling.DescribeWarmPoolInput{
+		AutoScalingGroupName: asg.AutoScalingGroupName,
+		MaxRecords:           aws.Int32(100),
+	}
+
+	paginator := autoscaling.NewDescribeWarmPoolPaginator(svc, input, func(o *autoscaling.DescribeWarmPoolPaginatorOptions) {
+		o.Limit = 100
+		o.StopOnDuplicateToken = true
+	})
+
+	// List call
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_ec2_autoscaling_warm_pool_instance.listAwsEc2AutoScalingWarmPoolInstances", "api_error", err)
+			return nil, err
+		}
+
+		for _, instance := range output.Instances {
+			d.StreamListItem(ctx, WarmPoolInstanceInfo{
+				Instance:              instance,
+				AutoScalingGroupName:  asg.AutoScalingGroupName,
+				AutoScalingGroupARN:   asg.AutoScalingGroupARN,
+				WarmPoolConfiguration: output.WarmPoolConfiguration,
+			})
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,214 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsEc2TransitGatewayConnectAttachment(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_ec2_transit_gateway_connect_attachment",
+		Description: "AWS EC2 Transit Gateway Connect Attachment",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("transit_gateway_attachment_id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"InvalidTransitGatewayAttachmentID.NotFound", "InvalidTransitGatewayAttachmentID.Malformed", "InvalidAction"}),
+			},
+			Hydrate: getEc2TransitGatewayConnectAttachment,
+			Tags:    map[string]string{"service": "ec2", "action": "DescribeTransitGatewayConnects"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listEc2TransitGatewayConnectAttachments,
+			Tags:    map[string]string{"service": "ec2", "action": "DescribeTransitGatewayConnects"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "state", Require: plugin.Optional},
+				{Name: "transit_gateway_id", Require: plugin.Optional},
+				{Name: "transport_transit_gateway_attachment_id", Require: plugin.Optional},
+			},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"InvalidAction"}),
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(AWS_EC2_SERVICE_ID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "transit_gateway_attachment_id",
+				Description: "The ID of the Connect attachment.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "transit_gateway_id",
+				Description: "The ID of the transit gateway.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "transport_transit_gateway_attachment_id",
+				Description: "The ID of the attachment from which the Connect attachment was created, for example a VPC or Direct Connect gateway attachment.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "The state of the attachment.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "creation_time",
+				Description: "The creation time of the attachment.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "protocol",
+				Description: "The tunnel protocol of the Connect attachment.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Options.Protocol"),
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags assigned to the Connect attachment.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags"),
+			},
+
+			// Standard columns for all tables
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags").Transform(ec2TurbotTags),
+			},
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(ec2TransitGatewayConnectAttachmentTitle),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getEc2TransitGatewayConnectAttachmentAkas,
+				Transform:   transform.FromValue(),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listEc2TransitGatewayConnectAttachments(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+
+	// Create Session
+	svc, err := EC2Client(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ec2_transit_gateway_connect_attachment.listEc2TransitGatewayConnectAttachments", "connection_error", err)
+		return nil, err
+	}
+
+	// Limiting the results
+	maxLimit := int32(1000)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 5 {
+				maxLimit = 5
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &ec2.DescribeTransitGatewayConnectsInput{
+		MaxResults: aws.Int32(maxLimit),
+	}
+
+	filterKeyMap := []VpcFilterKeyMap{
+		{ColumnName: "state", FilterName: "state", ColumnType: "string"},
+		{ColumnName: "transit_gateway_id", FilterName: "transit-gateway-id", ColumnType: "string"},
+		{ColumnName: "transport_transit_gateway_attachment_id", FilterName: "transport-transit-gateway-attachment-id", ColumnType: "string"},
+	}
+
+	filters := buildVpcResourcesFilterParameter(filterKeyMap, d.Quals)
+	if len(filters) > 0 {
+		input.Filters = filters
+	}
+
+	paginator := ec2.NewDescribeTransitGatewayConnectsPaginator(svc, input, func(o *ec2.DescribeTransitGatewayConnectsPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	// List call
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_ec2_transit_gateway_connect_attachment.listEc2TransitGatewayConnectAttachments", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range output.TransitGatewayConnects {
+			d.StreamListItem(ctx, item)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getEc2TransitGatewayConnectAttachment(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	attachmentId := d.EqualsQualString("transit_gateway_attachment_id")
+	if attachmentId == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := EC2Client(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ec2_transit_gateway_connect_attachment.getEc2TransitGatewayConnectAttachment", "connection_error", err)
+		return nil, err
+	}
+
+	params := &ec2.DescribeTransitGatewayConnectsInput{
+		TransitGatewayAttachmentIds: []string{attachmentId},
+	}
+
+	op, err := svc.DescribeTransitGatewayConnects(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ec2_transit_gateway_connect_attachment.getEc2TransitGatewayConnectAttachment", "api_error", err)
+		return nil, err
+	}
+
+	if len(op.TransitGatewayConnects) > 0 {
+		return op.TransitGatewayConnects[0], nil
+	}
+	return nil, nil
+}
+
+func getEc2TransitGatewayConnectAttachmentAkas(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	attachment := h.Item.(types.TransitGatewayConnect)
+	return ec2ResourceAkas(ctx, d, h, "transit-gateway-attachment/"+*attachment.TransitGatewayAttachmentId)
+}
+
+//// TRANSFORM FUNCTIONS
+
+func ec2TransitGatewayConnectAttachmentTitle(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	attachment := d.HydrateItem.(types.TransitGatewayConnect)
+	return ec2TagNameOrDefault(attachment.Tags, aws.ToString(attachment.TransitGatewayAttachmentId)), nil
+}
@@ -0,0 +1,231 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsEc2TransitGatewayConnectPeer(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_ec2_transit_gateway_connect_peer",
+		Description: "AWS EC2 Transit Gateway Connect Peer",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("transit_gateway_connect_peer_id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"InvalidTransitGatewayConnectPeerId.NotFound", "InvalidTransitGatewayConnectPeerId.Malformed", "InvalidAction"}),
+			},
+			Hydrate: getEc2TransitGatewayConnectPeer,
+			Tags:    map[string]string{"service": "ec2", "action": "DescribeTransitGatewayConnectPeers"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listEc2TransitGatewayConnectPeers,
+			Tags:    map[string]string{"service": "ec2", "action": "DescribeTransitGatewayConnectPeers"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "state", Require: plugin.Optional},
+				{Name: "transit_gateway_attachment_id", Require: plugin.Optional},
+			},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"InvalidAction"}),
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(AWS_EC2_SERVICE_ID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "transit_gateway_connect_peer_id",
+				Description: "The ID of the Connect peer.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "transit_gateway_attachment_id",
+				Description: "The ID of the Connect attachment.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "The state of the Connect peer.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "creation_time",
+				Description: "The creation time of the Connect peer.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "protocol",
+				Description: "The tunnel protocol.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ConnectPeerConfiguration.Protocol"),
+			},
+			{
+				Name:        "peer_address",
+				Description: "The Connect peer IP address on the appliance side of the tunnel.",
+				Type:        proto.ColumnType_IPADDR,
+				Transform:   transform.FromField("ConnectPeerConfiguration.PeerAddress"),
+			},
+			{
+				Name:        "transit_gateway_address",
+				Description: "The Connect peer IP address on the transit gateway side of the tunnel.",
+				Type:        proto.ColumnType_IPADDR,
+				Transform:   transform.FromField("ConnectPeerConfiguration.TransitGatewayAddress"),
+			},
+			{
+				Name:        "inside_cidr_blocks",
+				Description: "The range of interior BGP peer IP addresses.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ConnectPeerConfiguration.InsideCidrBlocks"),
+			},
+			{
+				Name:        "bgp_configurations",
+				Description: "The BGP configuration details, including the peer ASN and BGP status of each session.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ConnectPeerConfiguration.BgpConfigurations"),
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags assigned to the Connect peer.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags"),
+			},
+
+			// Standard columns for all tables
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags").Transform(ec2TurbotTags),
+			},
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(ec2TransitGatewayConnectPeerTitle),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getEc2TransitGatewayConnectPeerAkas,
+				Transform:   transform.FromValue(),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listEc2TransitGatewayConnectPeers(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+
+	// Create Session
+	svc, err := EC2Client(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ec2_transit_gateway_connect_peer.listEc2TransitGatewayConnectPeers", "connection_error", err)
+		return nil, err
+	}
+
+	// Limiting the results
+	maxLimit := int32(1000)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 5 {
+				maxLimit = 5
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &ec2.DescribeTransitGatewayConnectPeersInput{
+		MaxResults: aws.Int32(maxLimit),
+	}
+
+	filterKeyMap := []VpcFilterKeyMap{
+		{ColumnName: "state", FilterName: "state", ColumnType: "string"},
+		{ColumnName: "transit_gateway_attachment_id", FilterName: "transit-gateway-attachment-id", ColumnType: "string"},
+	}
+
+	filters := buildVpcResourcesFilterParameter(filterKeyMap, d.Quals)
+	if len(filters) > 0 {
+		input.Filters = filters
+	}
+
+	paginator := ec2.NewDescribeTransitGatewayConnectPeersPaginator(svc, input, func(o *ec2.DescribeTransitGatewayConnectPeersPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	// List call
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_ec2_transit_gateway_connect_peer.listEc2TransitGatewayConnectPeers", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range output.TransitGatewayConnectPeers {
+			d.StreamListItem(ctx, item)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getEc2TransitGatewayConnectPeer(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	peerId := d.EqualsQualString("transit_gateway_connect_peer_id")
+	if peerId == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := EC2Client(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ec2_transit_gateway_connect_peer.getEc2TransitGatewayConnectPeer", "connection_error", err)
+		return nil, err
+	}
+
+	params := &ec2.DescribeTransitGatewayConnectPeersInput{
+		TransitGatewayConnectPeerIds: []string{peerId},
+	}
+
+	op, err := svc.DescribeTransitGatewayConnectPeers(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ec2_transit_gateway_connect_peer.getEc2TransitGatewayConnectPeer", "api_error", err)
+		return nil, err
+	}
+
+	if len(op.TransitGatewayConnectPeers) > 0 {
+		return op.TransitGatewayConnectPeers[0], nil
+	}
+	return nil, nil
+}
+
+func getEc2TransitGatewayConnectPeerAkas(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	peer := h.Item.(types.TransitGatewayConnectPeer)
+	return ec2ResourceAkas(ctx, d, h, "transit-gateway-connect-peer/"+*peer.TransitGatewayConnectPeerId)
+}
+
+//// TRANSFORM FUNCTIONS
+
+func ec2TransitGatewayConnectPeerTitle(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	peer := d.HydrateItem.(types.TransitGatewayConnectPeer)
+	return ec2TagNameOrDefault(peer.Tags, aws.ToString(peer.TransitGatewayConnectPeerId)), nil
+}
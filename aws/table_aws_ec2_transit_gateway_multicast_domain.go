@@ -0,0 +1,269 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsEc2TransitGatewayMulticastDomain(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_ec2_transit_gateway_multicast_domain",
+		Description: "AWS EC2 Transit Gateway Multicast Domain",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("transit_gateway_multicast_domain_id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"InvalidTransitGatewayMulticastDomainId.NotFound", "InvalidTransitGatewayMulticastDomainId.Malformed", "InvalidAction"}),
+			},
+			Hydrate: getEc2TransitGatewayMulticastDomain,
+			Tags:    map[string]string{"service": "ec2", "action": "DescribeTransitGatewayMulticastDomains"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listEc2TransitGatewayMulticastDomains,
+			Tags:    map[string]string{"service": "ec2", "action": "DescribeTransitGatewayMulticastDomains"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "state", Require: plugin.Optional},
+				{Name: "transit_gateway_id", Require: plugin.Optional},
+			},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"InvalidAction"}),
+			},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getEc2TransitGatewayMulticastDomainAssociations,
+				Tags: map[string]string{"service": "ec2", "action": "GetTransitGatewayMulticastDomainAssociations"},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(AWS_EC2_SERVICE_ID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "transit_gateway_multicast_domain_id",
+				Description: "The ID of the transit gateway multicast domain.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "transit_gateway_multicast_domain_arn",
+				Description: "The Amazon Resource Name (ARN) of the transit gateway multicast domain.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "transit_gateway_id",
+				Description: "The ID of the transit gateway.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "The state of the transit gateway multicast domain.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "owner_id",
+				Description: "The ID of the AWS account that owns the transit gateway multicast domain.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "creation_time",
+				Description: "The time the transit gateway multicast domain was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "auto_accept_shared_associations",
+				Description: "Indicates whether to automatically accept cross-account subnet associations that are associated with the transit gateway multicast domain.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Options.AutoAcceptSharedAssociations"),
+			},
+			{
+				Name:        "igmpv2_support",
+				Description: "Indicates whether Internet Group Management Protocol (IGMP) version 2 is turned on for the transit gateway multicast domain.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Options.Igmpv2Support"),
+			},
+			{
+				Name:        "static_sources_support",
+				Description: "Indicates whether support for statically configuring transit gateway multicast group sources is turned on.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Options.StaticSourcesSupport"),
+			},
+			{
+				Name:        "associations",
+				Description: "The attachments and subnets associated with the transit gateway multicast domain.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getEc2TransitGatewayMulticastDomainAssociations,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags assigned to the transit gateway multicast domain.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags"),
+			},
+
+			// Standard columns for all tables
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags").Transform(ec2TurbotTags),
+			},
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(ec2TransitGatewayMulticastDomainTitle),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("TransitGatewayMulticastDomainArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listEc2TransitGatewayMulticastDomains(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+
+	// Create Session
+	svc, err := EC2Client(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ec2_transit_gateway_multicast_domain.listEc2TransitGatewayMulticastDomains", "connection_error", err)
+		return nil, err
+	}
+
+	// Limiting the results
+	maxLimit := int32(1000)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 5 {
+				maxLimit = 5
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &ec2.DescribeTransitGatewayMulticastDomainsInput{
+		MaxResults: aws.Int32(maxLimit),
+	}
+
+	filterKeyMap := []VpcFilterKeyMap{
+		{ColumnName: "state", FilterName: "state", ColumnType: "string"},
+		{ColumnName: "transit_gateway_id", FilterName: "transit-gateway-id", ColumnType: "string"},
+	}
+
+	filters := buildVpcResourcesFilterParameter(filterKeyMap, d.Quals)
+	if len(filters) > 0 {
+		input.Filters = filters
+	}
+
+	paginator := ec2.NewDescribeTransitGatewayMulticastDomainsPaginator(svc, input, func(o *ec2.DescribeTransitGatewayMulticastDomainsPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	// List call
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_ec2_transit_gateway_multicast_domain.listEc2TransitGatewayMulticastDomains", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range output.TransitGatewayMulticastDomains {
+			d.StreamListItem(ctx, item)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getEc2TransitGatewayMulticastDomain(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	domainId := d.EqualsQualString("transit_gateway_multicast_domain_id")
+	if domainId == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := EC2Client(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ec2_transit_gateway_multicast_domain.getEc2TransitGatewayMulticastDomain", "connection_error", err)
+		return nil, err
+	}
+
+	params := &ec2.DescribeTransitGatewayMulticastDomainsInput{
+		TransitGatewayMulticastDomainIds: []string{domainId},
+	}
+
+	op, err := svc.DescribeTransitGatewayMulticastDomains(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ec2_transit_gateway_multicast_domain.getEc2TransitGatewayMulticastDomain", "api_error", err)
+		return nil, err
+	}
+
+	if len(op.TransitGatewayMulticastDomains) > 0 {
+		return op.TransitGatewayMulticastDomains[0], nil
+	}
+	return nil, nil
+}
+
+func getEc2TransitGatewayMulticastDomainAssociations(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	domain := h.Item.(types.TransitGatewayMulticastDomain)
+
+	// Create Session
+	svc, err := EC2Client(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ec2_transit_gateway_multicast_domain.getEc2TransitGatewayMulticastDomainAssociations", "connection_error", err)
+		return nil, err
+	}
+
+	params := &ec2.GetTransitGatewayMulticastDomainAssociationsInput{
+		TransitGatewayMulticastDomainId: domain.TransitGatewayMulticastDomainId,
+	}
+
+	var associations []types.TransitGatewayMulticastDomainAssociation
+	paginator := ec2.NewGetTransitGatewayMulticastDomainAssociationsPaginator(svc, params, func(o *ec2.GetTransitGatewayMulticastDomainAssociationsPaginatorOptions) {
+		o.StopOnDuplicateToken = true
+	})
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_ec2_transit_gateway_multicast_domain.getEc2TransitGatewayMulticastDomainAssociations", "api_error", err)
+			return nil, err
+		}
+		associations = append(associations, output.MulticastDomainAssociations...)
+	}
+
+	return associations, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func ec2TransitGatewayMulticastDomainTitle(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	domain := d.HydrateItem.(types.TransitGatewayMulticastDomain)
+	return ec2TagNameOrDefault(domain.Tags, aws.ToString(domain.TransitGatewayMulticastDomainId)), nil
+}
@@ -0,0 +1,267 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsEc2TransitGatewayPeeringAttachment(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_ec2_transit_gateway_peering_attachment",
+		Description: "AWS EC2 Transit Gateway Peering Attachment",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("transit_gateway_attachment_id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"InvalidTransitGatewayAttachmentID.NotFound", "InvalidTransitGatewayAttachmentID.Malformed", "InvalidAction"}),
+			},
+			Hydrate: getEc2TransitGatewayPeeringAttachment,
+			Tags:    map[string]string{"service": "ec2", "action": "DescribeTransitGatewayPeeringAttachments"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listEc2TransitGatewayPeeringAttachments,
+			Tags:    map[string]string{"service": "ec2", "action": "DescribeTransitGatewayPeeringAttachments"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "state", Require: plugin.Optional},
+				{Name: "transit_gateway_id", Require: plugin.Optional},
+			},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"InvalidAction"}),
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(AWS_EC2_SERVICE_ID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "transit_gateway_attachment_id",
+				Description: "The ID of the transit gateway peering attachment.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "transit_gateway_id",
+				Description: "The ID of the requester transit gateway.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RequesterTgwInfo.TransitGatewayId"),
+			},
+			{
+				Name:        "state",
+				Description: "The state of the transit gateway peering attachment.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status_code",
+				Description: "The status code of the transit gateway peering attachment.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Status.Code"),
+			},
+			{
+				Name:        "status_message",
+				Description: "The status message of the transit gateway peering attachment, if applicable.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Status.Message"),
+			},
+			{
+				Name:        "creation_time",
+				Description: "The time the transit gateway peering attachment was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "requester_owner_id",
+				Description: "The ID of the AWS account that owns the requester transit gateway.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RequesterTgwInfo.OwnerId"),
+			},
+			{
+				Name:        "requester_region",
+				Description: "The region of the requester transit gateway.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RequesterTgwInfo.Region"),
+			},
+			{
+				Name:        "requester_core_network_id",
+				Description: "The ID of the core network where the requester transit gateway peer is located.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RequesterTgwInfo.CoreNetworkId"),
+			},
+			{
+				Name:        "accepter_transit_gateway_id",
+				Description: "The ID of the accepter transit gateway.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AccepterTgwInfo.TransitGatewayId"),
+			},
+			{
+				Name:        "accepter_owner_id",
+				Description: "The ID of the AWS account that owns the accepter transit gateway.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AccepterTgwInfo.OwnerId"),
+			},
+			{
+				Name:        "accepter_region",
+				Description: "The region of the accepter transit gateway.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AccepterTgwInfo.Region"),
+			},
+			{
+				Name:        "accepter_core_network_id",
+				Description: "The ID of the core network where the accepter transit gateway peer is located.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AccepterTgwInfo.CoreNetworkId"),
+			},
+			{
+				Name:        "accepter_transit_gateway_attachment_id",
+				Description: "The ID of the accepter transit gateway attachment.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "dynamic_routing",
+				Description: "Indicates whether dynamic routing is enabled or disabled for the transit gateway peering attachment.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Options.DynamicRouting"),
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags assigned to the transit gateway peering attachment.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags"),
+			},
+
+			// Standard columns for all tables
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags").Transform(ec2TurbotTags),
+			},
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(ec2TransitGatewayPeeringAttachmentTitle),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getEc2TransitGatewayPeeringAttachmentAkas,
+				Transform:   transform.FromValue(),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listEc2TransitGatewayPeeringAttachments(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+
+	// Create Session
+	svc, err := EC2Client(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ec2_transit_gateway_peering_attachment.listEc2TransitGatewayPeeringAttachments", "connection_error", err)
+		return nil, err
+	}
+
+	// Limiting the results
+	maxLimit := int32(1000)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 5 {
+				maxLimit = 5
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &ec2.DescribeTransitGatewayPeeringAttachmentsInput{
+		MaxResults: aws.Int32(maxLimit),
+	}
+
+	filterKeyMap := []VpcFilterKeyMap{
+		{ColumnName: "state", FilterName: "state", ColumnType: "string"},
+		{ColumnName: "transit_gateway_id", FilterName: "transit-gateway-id", ColumnType: "string"},
+	}
+
+	filters := buildVpcResourcesFilterParameter(filterKeyMap, d.Quals)
+	if len(filters) > 0 {
+		input.Filters = filters
+	}
+
+	paginator := ec2.NewDescribeTransitGatewayPeeringAttachmentsPaginator(svc, input, func(o *ec2.DescribeTransitGatewayPeeringAttachmentsPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	// List call
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_ec2_transit_gateway_peering_attachment.listEc2TransitGatewayPeeringAttachments", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range output.TransitGatewayPeeringAttachments {
+			d.StreamListItem(ctx, item)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getEc2TransitGatewayPeeringAttachment(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	attachmentId := d.EqualsQualString("transit_gateway_attachment_id")
+	if attachmentId == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := EC2Client(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ec2_transit_gateway_peering_attachment.getEc2TransitGatewayPeeringAttachment", "connection_error", err)
+		return nil, err
+	}
+
+	params := &ec2.DescribeTransitGatewayPeeringAttachmentsInput{
+		TransitGatewayAttachmentIds: []string{attachmentId},
+	}
+
+	op, err := svc.DescribeTransitGatewayPeeringAttachments(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ec2_transit_gateway_peering_attachment.getEc2TransitGatewayPeeringAttachment", "api_error", err)
+		return nil, err
+	}
+
+	if len(op.TransitGatewayPeeringAttachments) > 0 {
+		return op.TransitGatewayPeeringAttachments[0], nil
+	}
+	return nil, nil
+}
+
+func getEc2TransitGatewayPeeringAttachmentAkas(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	attachment := h.Item.(types.TransitGatewayPeeringAttachment)
+	return ec2ResourceAkas(ctx, d, h, "transit-gateway-attachment/"+*attachment.TransitGatewayAttachmentId)
+}
+
+//// TRANSFORM FUNCTIONS
+
+func ec2TransitGatewayPeeringAttachmentTitle(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	attachment := d.HydrateItem.(types.TransitGatewayPeeringAttachment)
+	return ec2TagNameOrDefault(attachment.Tags, aws.ToString(attachment.TransitGatewayAttachmentId)), nil
+}
@@ -0,0 +1,281 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsEc2TransitGatewayPolicyTable(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_ec2_transit_gateway_policy_table",
+		Description: "AWS EC2 Transit Gateway Policy Table",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("transit_gateway_policy_table_id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"InvalidTransitGatewayPolicyTableId.NotFound", "InvalidTransitGatewayPolicyTableId.Malformed", "InvalidAction"}),
+			},
+			Hydrate: getEc2TransitGatewayPolicyTable,
+			Tags:    map[string]string{"service": "ec2", "action": "DescribeTransitGatewayPolicyTables"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listEc2TransitGatewayPolicyTables,
+			Tags:    map[string]string{"service": "ec2", "action": "DescribeTransitGatewayPolicyTables"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "state", Require: plugin.Optional},
+				{Name: "transit_gateway_id", Require: plugin.Optional},
+			},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"InvalidAction"}),
+			},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getEc2TransitGatewayPolicyTableEntries,
+				Tags: map[string]string{"service": "ec2", "action": "GetTransitGatewayPolicyTableEntries"},
+			},
+			{
+				Func: getEc2TransitGatewayPolicyTableAssociations,
+				Tags: map[string]string{"service": "ec2", "action": "GetTransitGatewayPolicyTableAssociations"},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(AWS_EC2_SERVICE_ID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "transit_gateway_policy_table_id",
+				Description: "The ID of the transit gateway policy table.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "transit_gateway_id",
+				Description: "The ID of the transit gateway.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "The state of the transit gateway policy table.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "creation_time",
+				Description: "The timestamp when the transit gateway policy table was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "entries",
+				Description: "The policy rules of the transit gateway policy table, and the route tables they target.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getEc2TransitGatewayPolicyTableEntries,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "associations",
+				Description: "The attachments associated with the transit gateway policy table.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getEc2TransitGatewayPolicyTableAssociations,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags assigned to the transit gateway policy table.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags"),
+			},
+
+			// Standard columns for all tables
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags").Transform(ec2TurbotTags),
+			},
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(ec2TransitGatewayPolicyTableTitle),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getEc2TransitGatewayPolicyTableAkas,
+				Transform:   transform.FromValue(),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listEc2TransitGatewayPolicyTables(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+
+	// Create Session
+	svc, err := EC2Client(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ec2_transit_gateway_policy_table.listEc2TransitGatewayPolicyTables", "connection_error", err)
+		return nil, err
+	}
+
+	// Limiting the results
+	maxLimit := int32(1000)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 5 {
+				maxLimit = 5
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &ec2.DescribeTransitGatewayPolicyTablesInput{
+		MaxResults: aws.Int32(maxLimit),
+	}
+
+	filterKeyMap := []VpcFilterKeyMap{
+		{ColumnName: "state", FilterName: "state", ColumnType: "string"},
+		{ColumnName: "transit_gateway_id", FilterName: "transit-gateway-id", ColumnType: "string"},
+	}
+
+	filters := buildVpcResourcesFilterParameter(filterKeyMap, d.Quals)
+	if len(filters) > 0 {
+		input.Filters = filters
+	}
+
+	paginator := ec2.NewDescribeTransitGatewayPolicyTablesPaginator(svc, input, func(o *ec2.DescribeTransitGatewayPolicyTablesPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	// List call
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_ec2_transit_gateway_policy_table.listEc2TransitGatewayPolicyTables", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range output.TransitGatewayPolicyTables {
+			d.StreamListItem(ctx, item)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getEc2TransitGatewayPolicyTable(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	policyTableId := d.EqualsQualString("transit_gateway_policy_table_id")
+	if policyTableId == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := EC2Client(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ec2_transit_gateway_policy_table.getEc2TransitGatewayPolicyTable", "connection_error", err)
+		return nil, err
+	}
+
+	params := &ec2.DescribeTransitGatewayPolicyTablesInput{
+		TransitGatewayPolicyTableIds: []string{policyTableId},
+	}
+
+	op, err := svc.DescribeTransitGatewayPolicyTables(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ec2_transit_gateway_policy_table.getEc2TransitGatewayPolicyTable", "api_error", err)
+		return nil, err
+	}
+
+	if len(op.TransitGatewayPolicyTables) > 0 {
+		return op.TransitGatewayPolicyTables[0], nil
+	}
+	return nil, nil
+}
+
+func getEc2TransitGatewayPolicyTableEntries(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	policyTable := h.Item.(types.TransitGatewayPolicyTable)
+
+	// Create Session
+	svc, err := EC2Client(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ec2_transit_gateway_policy_table.getEc2TransitGatewayPolicyTableEntries", "connection_error", err)
+		return nil, err
+	}
+
+	params := &ec2.GetTransitGatewayPolicyTableEntriesInput{
+		TransitGatewayPolicyTableId: policyTable.TransitGatewayPolicyTableId,
+	}
+
+	op, err := svc.GetTransitGatewayPolicyTableEntries(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ec2_transit_gateway_policy_table.getEc2TransitGatewayPolicyTableEntries", "api_error", err)
+		return nil, err
+	}
+
+	return op.TransitGatewayPolicyTableEntries, nil
+}
+
+func getEc2TransitGatewayPolicyTableAssociations(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	policyTable := h.Item.(types.TransitGatewayPolicyTable)
+
+	// Create Session
+	svc, err := EC2Client(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ec2_transit_gateway_policy_table.getEc2TransitGatewayPolicyTableAssociations", "connection_error", err)
+		return nil, err
+	}
+
+	params := &ec2.GetTransitGatewayPolicyTableAssociationsInput{
+		TransitGatewayPolicyTableId: policyTable.TransitGatewayPolicyTableId,
+	}
+
+	var associations []types.TransitGatewayPolicyTableAssociation
+	paginator := ec2.NewGetTransitGatewayPolicyTableAssociationsPaginator(svc, params, func(o *ec2.GetTransitGatewayPolicyTableAssociationsPaginatorOptions) {
+		o.StopOnDuplicateToken = true
+	})
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_ec2_transit_gateway_policy_table.getEc2TransitGatewayPolicyTableAssociations", "api_error", err)
+			return nil, err
+		}
+		associations = append(associations, output.Associations...)
+	}
+
+	return associations, nil
+}
+
+func getEc2TransitGatewayPolicyTableAkas(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	policyTable := h.Item.(types.TransitGatewayPolicyTable)
+	return ec2ResourceAkas(ctx, d, h, "transit-gateway-policy-table/"+*policyTable.TransitGatewayPolicyTableId)
+}
+
+//// TRANSFORM FUNCTIONS
+
+func ec2TransitGatewayPolicyTableTitle(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	policyTable := d.HydrateItem.(types.TransitGatewayPolicyTable)
+	return ec2TagNameOrDefault(policyTable.Tags, aws.ToString(policyTable.TransitGatewayPolicyTableId)), nil
+}
@@ -0,0 +1,159 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsEc2TransitGatewayRouteTableAssociation(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_ec2_transit_gateway_route_table_association",
+		Description: "AWS EC2 Transit Gateway Route Table Association",
+		List: &plugin.ListConfig{
+			ParentHydrate: listEc2TransitGatewayRouteTable,
+			Hydrate:       listEc2TransitGatewayRouteTableAssociations,
+			Tags:          map[string]string{"service": "ec2", "action": "GetTransitGatewayRouteTableAssociations"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "transit_gateway_route_table_id", Require: plugin.Optional},
+				{Name: "resource_id", Require: plugin.Optional},
+				{Name: "resource_type", Require: plugin.Optional},
+				{Name: "transit_gateway_attachment_id", Require: plugin.Optional},
+			},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"InvalidAction", "InvalidRouteTableID.NotFound"}),
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(AWS_EC2_SERVICE_ID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "transit_gateway_route_table_id",
+				Description: "The ID of the transit gateway route table.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "transit_gateway_attachment_id",
+				Description: "The ID of the attachment.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Association.TransitGatewayAttachmentId"),
+			},
+			{
+				Name:        "resource_id",
+				Description: "The ID of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Association.ResourceId"),
+			},
+			{
+				Name:        "resource_type",
+				Description: "The type of resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Association.ResourceType"),
+			},
+			{
+				Name:        "state",
+				Description: "The state of the association.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Association.State"),
+			},
+
+			// Standard columns for all tables
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Association.TransitGatewayAttachmentId"),
+			},
+		}),
+	}
+}
+
+type TransitGatewayRouteTableAssociationDetails struct {
+	Association                types.TransitGatewayRouteTableAssociation
+	TransitGatewayRouteTableId string
+}
+
+//// LIST FUNCTION
+
+func listEc2TransitGatewayRouteTableAssociations(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	routeTableId := h.Item.(types.TransitGatewayRouteTable).TransitGatewayRouteTableId
+
+	// Skip route tables that do not match the qual
+	if d.EqualsQualString("transit_gateway_route_table_id") != "" && d.EqualsQualString("transit_gateway_route_table_id") != *routeTableId {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := EC2Client(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ec2_transit_gateway_route_table_association.listEc2TransitGatewayRouteTableAssociations", "connection_error", err)
+		return nil, err
+	}
+
+	// Limiting the results
+	maxLimit := int32(1000)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 5 {
+				maxLimit = 5
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &ec2.GetTransitGatewayRouteTableAssociationsInput{
+		MaxResults:                 aws.Int32(maxLimit),
+		TransitGatewayRouteTableId: routeTableId,
+	}
+
+	filterKeyMap := []VpcFilterKeyMap{
+		{ColumnName: "resource_id", FilterName: "resource-id", ColumnType: "string"},
+		{ColumnName: "resource_type", FilterName: "resource-type", ColumnType: "string"},
+		{ColumnName: "transit_gateway_attachment_id", FilterName: "transit-gateway-attachment-id", ColumnType: "string"},
+	}
+
+	filters := buildVpcResourcesFilterParameter(filterKeyMap, d.Quals)
+	if len(filters) > 0 {
+		input.Filters = filters
+	}
+
+	paginator := ec2.NewGetTransitGatewayRouteTableAssociationsPaginator(svc, input, func(o *ec2.GetTransitGatewayRouteTableAssociationsPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	// List call
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_ec2_transit_gateway_route_table_association.listEc2TransitGatewayRouteTableAssociations", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range output.Associations {
+			d.StreamListItem(ctx, &TransitGatewayRouteTableAssociationDetails{
+				Association:                item,
+				TransitGatewayRouteTableId: *routeTableId,
+			})
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,236 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsEcsCapacityProvider(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_ecs_capacity_provider",
+		Description: "AWS ECS Capacity Provider",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    getEcsCapacityProvider,
+			Tags:       map[string]string{"service": "ecs", "action": "DescribeCapacityProviders"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listEcsCapacityProviders,
+			Tags:    map[string]string{"service": "ecs", "action": "DescribeCapacityProviders"},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(AWS_ECS_SERVICE_ID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the capacity provider.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) that identifies the capacity provider.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CapacityProviderArn"),
+			},
+			{
+				Name:        "status",
+				Description: "The current status of the capacity provider, e.g. ACTIVE or INACTIVE.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type",
+				Description: "The type of capacity provider, e.g. EC2_AUTOSCALING, MANAGED_INSTANCES, FARGATE or FARGATE_SPOT.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "cluster",
+				Description: "The cluster that the capacity provider is associated with, for capacity providers that are scoped to a cluster.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "update_status",
+				Description: "The update status of the capacity provider, e.g. UPDATE_COMPLETE or UPDATE_FAILED.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "update_status_reason",
+				Description: "The update status reason. This provides further details about the update status for the capacity provider.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "auto_scaling_group_arn",
+				Description: "The Amazon Resource Name (ARN) of the Auto Scaling group of the capacity provider.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AutoScalingGroupProvider.AutoScalingGroupArn"),
+			},
+			{
+				Name:        "managed_termination_protection",
+				Description: "The managed termination protection setting of the Auto Scaling group capacity provider, either ENABLED or DISABLED.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AutoScalingGroupProvider.ManagedTerminationProtection"),
+			},
+			{
+				Name:        "managed_draining",
+				Description: "The managed draining setting of the Auto Scaling group capacity provider, either ENABLED or DISABLED.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AutoScalingGroupProvider.ManagedDraining"),
+			},
+			{
+				Name:        "managed_scaling",
+				Description: "The managed scaling settings of the Auto Scaling group capacity provider, such as the target capacity.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("AutoScalingGroupProvider.ManagedScaling"),
+			},
+			{
+				Name:        "auto_scaling_group_provider",
+				Description: "The Auto Scaling group settings for the capacity provider.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "managed_instances_provider",
+				Description: "The configuration of the Amazon ECS Managed Instances capacity provider.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "tags_src",
+				Description: "The metadata that you apply to the capacity provider.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags").Transform(ecsTagsToTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("CapacityProviderArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listEcsCapacityProviders(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := ECSClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ecs_capacity_provider.listEcsCapacityProviders", "connection_error", err)
+		return nil, err
+	}
+
+	// Limiting the results
+	maxLimit := int32(10)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &ecs.DescribeCapacityProvidersInput{
+		Include:    []types.CapacityProviderField{types.CapacityProviderFieldTags},
+		MaxResults: aws.Int32(maxLimit),
+	}
+
+	// DescribeCapacityProviders has no paginator
+	for {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := svc.DescribeCapacityProviders(ctx, input)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_ecs_capacity_provider.listEcsCapacityProviders", "api_error", err)
+			return nil, err
+		}
+
+		for _, capacityProvider := range output.CapacityProviders {
+			d.StreamListItem(ctx, capacityProvider)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+		input.NextToken = output.NextToken
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getEcsCapacityProvider(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+
+	// Empty check
+	if name == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := ECSClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ecs_capacity_provider.getEcsCapacityProvider", "connection_error", err)
+		return nil, err
+	}
+
+	output, err := svc.DescribeCapacityProviders(ctx, &ecs.DescribeCapacityProvidersInput{
+		CapacityProviders: []string{name},
+		Include:           []types.CapacityProviderField{types.CapacityProviderFieldTags},
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ecs_capacity_provider.getEcsCapacityProvider", "api_error", err)
+		return nil, err
+	}
+
+	// Unknown capacity providers are returned as failures rather than an error
+	if len(output.CapacityProviders) > 0 {
+		return output.CapacityProviders[0], nil
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func ecsTagsToTurbotTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	tags, ok := d.Value.([]types.Tag)
+	if !ok || len(tags) == 0 {
+		return nil, nil
+	}
+
+	turbotTagsMap := map[string]string{}
+	for _, i := range tags {
+		turbotTagsMap[*i.Key] = *i.Value
+	}
+	return turbotTagsMap, nil
+}
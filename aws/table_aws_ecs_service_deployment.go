@@ -0,0 +1,347 @@
+package aws
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsEcsServiceDeployment(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_ecs_service_deployment",
+		Description: "AWS ECS Service Deployment",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("service_deployment_arn"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"InvalidParameterException", "ServiceNotFoundException"}),
+			},
+			Hydrate: getEcsServiceDeployment,
+			Tags:    map[string]string{"service": "ecs", "action": "DescribeServiceDeployments"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate:       listEcsServiceDeployments,
+			Tags:          map[string]string{"service": "ecs", "action": "ListServiceDeployments"},
+			ParentHydrate: listEcsClusters,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ClusterNotFoundException", "ServiceNotFoundException", "InvalidParameterException"}),
+			},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "cluster_arn", Require: plugin.Optional},
+				{Name: "service_arn", Require: plugin.Optional},
+				{Name: "status", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(AWS_ECS_SERVICE_ID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "service_deployment_arn",
+				Description: "The ARN of the service deployment.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "service_arn",
+				Description: "The ARN of the service for this service deployment.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "cluster_arn",
+				Description: "The ARN of the cluster that hosts the service.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "The service deployment state, e.g. PENDING, IN_PROGRESS, SUCCESSFUL, STOPPED, ROLLBACK_IN_PROGRESS, ROLLBACK_SUCCESSFUL or ROLLBACK_FAILED.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status_reason",
+				Description: "Information about why the service deployment is in the current status.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "lifecycle_stage",
+				Description: "The current lifecycle stage of the deployment, e.g. SCALE_UP, PRODUCTION_TRAFFIC_SHIFT, BAKE_TIME or CLEAN_UP.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "created_at",
+				Description: "The time the service deployment was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "started_at",
+				Description: "The time the service deployment started.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "updated_at",
+				Description: "The time that the service deployment was last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "finished_at",
+				Description: "The time the service deployment finished.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "stopped_at",
+				Description: "The time the service deployment stopped.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "target_service_revision_arn",
+				Description: "The ARN of the service revision deployed as part of the service deployment.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("TargetServiceRevision.Arn"),
+			},
+			{
+				Name:        "rollback_reason",
+				Description: "The reason the deployment was rolled back, if it was rolled back.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Rollback.Reason"),
+			},
+			{
+				Name:        "rollback_started_at",
+				Description: "The time the rollback of the deployment started.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Rollback.StartedAt"),
+			},
+			{
+				Name:        "rollback_service_revision_arn",
+				Description: "The ARN of the service revision the deployment was rolled back to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Rollback.ServiceRevisionArn"),
+			},
+			{
+				Name:        "target_service_revision",
+				Description: "The service revision deployed as part of the service deployment, with its requested, running and pending task counts.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "source_service_revisions",
+				Description: "The currently deployed workload configurations.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "rollback",
+				Description: "The rollback options the service deployment uses when the deployment fails.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "deployment_circuit_breaker",
+				Description: "The circuit breaker configuration that determines a service deployment failed, with its failure count and threshold.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "alarms",
+				Description: "The CloudWatch alarms that determine when a service deployment fails.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "deployment_configuration",
+				Description: "Optional deployment parameters that control how many tasks run during the deployment and the ordering of stopping and starting tasks.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ServiceDeploymentArn").Transform(ecsArnToResourceId),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ServiceDeploymentArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listEcsServiceDeployments(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	cluster := h.Item.(types.Cluster)
+
+	// Minimize the API call with the given cluster ARN
+	if d.EqualsQualString("cluster_arn") != "" && d.EqualsQualString("cluster_arn") != aws.ToString(cluster.ClusterArn) {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := ECSClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ecs_service_deployment.listEcsServiceDeployments", "connection_error", err)
+		return nil, err
+	}
+
+	serviceArns, err := listEcsServiceArns(ctx, d, svc, cluster.ClusterArn)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ecs_service_deployment.listEcsServiceDeployments", "api_error", err)
+		return nil, err
+	}
+
+	// Limiting the results
+	maxLimit := int32(20)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	for _, serviceArn := range serviceArns {
+		input := &ecs.ListServiceDeploymentsInput{
+			Cluster:    cluster.ClusterArn,
+			Service:    aws.String(serviceArn),
+			MaxResults: aws.Int32(maxLimit),
+		}
+		if d.EqualsQualString("status") != "" {
+			input.Status = []types.ServiceDeploymentStatus{types.ServiceDeploymentStatus(d.EqualsQualString("status"))}
+		}
+
+		// ListServiceDeployments has no paginator
+		for {
+			// apply rate limiting
+			d.WaitForListRateLimit(ctx)
+
+			output, err := svc.ListServiceDeployments(ctx, input)
+			if err != nil {
+				plugin.Logger(ctx).Error("aws_ecs_service_deployment.listEcsServiceDeployments", "api_error", err)
+				return nil, err
+			}
+
+			// DescribeServiceDeployments describes up to 20 deployments, the maximum page size
+			var deploymentArns []string
+			for _, deployment := range output.ServiceDeployments {
+				deploymentArns = append(deploymentArns, aws.ToString(deployment.ServiceDeploymentArn))
+			}
+
+			if len(deploymentArns) > 0 {
+				result, err := svc.DescribeServiceDeployments(ctx, &ecs.DescribeServiceDeploymentsInput{
+					ServiceDeploymentArns: deploymentArns,
+				})
+				if err != nil {
+					plugin.Logger(ctx).Error("aws_ecs_service_deployment.listEcsServiceDeployments", "api_error", err)
+					return nil, err
+				}
+
+				for _, deployment := range result.ServiceDeployments {
+					d.StreamListItem(ctx, deployment)
+
+					// Context may get cancelled due to manual cancellation or if the limit has been reached
+					if d.RowsRemaining(ctx) == 0 {
+						return nil, nil
+					}
+				}
+			}
+
+			if output.NextToken == nil {
+				break
+			}
+			input.NextToken = output.NextToken
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getEcsServiceDeployment(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	arn := d.EqualsQualString("service_deployment_arn")
+
+	// Empty check
+	if arn == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := ECSClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ecs_service_deployment.getEcsServiceDeployment", "connection_error", err)
+		return nil, err
+	}
+
+	output, err := svc.DescribeServiceDeployments(ctx, &ecs.DescribeServiceDeploymentsInput{
+		ServiceDeploymentArns: []string{arn},
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ecs_service_deployment.getEcsServiceDeployment", "api_error", err)
+		return nil, err
+	}
+
+	// Unknown deployments are returned as failures rather than an error
+	if len(output.ServiceDeployments) > 0 {
+		return output.ServiceDeployments[0], nil
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+// ecsArnToResourceId returns the last part of an ECS ARN, e.g. the ID of a
+// service deployment or of a task set
+func ecsArnToResourceId(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	arn, ok := d.Value.(*string)
+	if !ok || aws.ToString(arn) == "" {
+		return nil, nil
+	}
+	parts := strings.Split(*arn, "/")
+	return parts[len(parts)-1], nil
+}
+
+//// UTILITY FUNCTIONS
+
+// listEcsServiceArns returns the ARNs of the services of a cluster, or the
+// service given in the service_arn qual
+func listEcsServiceArns(ctx context.Context, d *plugin.QueryData, svc *ecs.Client, clusterArn *string) ([]string, error) {
+	if serviceArn := d.EqualsQualString("service_arn"); serviceArn != "" {
+		// Service ARNs in the new format include the name of their cluster
+		parts := strings.Split(serviceArn, "/")
+		if len(parts) == 3 && !strings.HasSuffix(aws.ToString(clusterArn), "/"+parts[1]) {
+			return nil, nil
+		}
+		return []string{serviceArn}, nil
+	}
+
+	input := &ecs.ListServicesInput{
+		Cluster:    clusterArn,
+		MaxResults: aws.Int32(100),
+	}
+
+	paginator := ecs.NewListServicesPaginator(svc, input, func(o *ecs.ListServicesPaginatorOptions) {
+		o.Limit = 100
+		o.StopOnDuplicateToken = true
+	})
+
+	var serviceArns []string
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		serviceArns = append(serviceArns, output.ServiceArns...)
+	}
+
+	return serviceArns, nil
+}
@@ -0,0 +1,309 @@
+package aws
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+	"github.com/turbot/steampipe-plugin-sdk/v6/query_cache"
+)
+
+type EcsTaskContainerInfo struct {
+	types.Container
+	ClusterArn           *string
+	ContainerInstanceArn *string
+	TaskDefinitionArn    *string
+	AvailabilityZone     *string
+	Group                *string
+	LaunchType           types.LaunchType
+	TaskDesiredStatus    *string
+	TaskLastStatus       *string
+}
+
+//// TABLE DEFINITION
+
+func tableAwsEcsTaskContainer(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_ecs_task_container",
+		Description: "AWS ECS Task Container",
+		List: &plugin.ListConfig{
+			Hydrate:       listEcsTaskContainers,
+			Tags:          map[string]string{"service": "ecs", "action": "DescribeTasks"},
+			ParentHydrate: listEcsClusters,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ClusterNotFoundException", "ServiceNotFoundException", "InvalidParameterException"}),
+			},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "cluster_arn", Require: plugin.Optional},
+				{Name: "container_instance_arn", Require: plugin.Optional},
+				{Name: "task_desired_status", Require: plugin.Optional},
+				{Name: "launch_type", Require: plugin.Optional},
+				{Name: "service_name", Require: plugin.Optional, CacheMatch: query_cache.CacheMatchExact},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(AWS_ECS_SERVICE_ID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the container.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "container_arn",
+				Description: "The Amazon Resource Name (ARN) of the container.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "task_arn",
+				Description: "The Amazon Resource Name (ARN) of the task that the container is part of.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "cluster_arn",
+				Description: "The Amazon Resource Name (ARN) of the cluster that hosts the task.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "service_name",
+				Description: "The name of the service that started the task, if the task was started by a service.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Group").Transform(ecsTaskGroupToServiceName),
+			},
+			{
+				Name:        "task_definition_arn",
+				Description: "The Amazon Resource Name (ARN) of the task definition that creates the task.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "container_instance_arn",
+				Description: "The Amazon Resource Name (ARN) of the container instance that hosts the task.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "availability_zone",
+				Description: "The Availability Zone of the task.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "launch_type",
+				Description: "The infrastructure where the task is running.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "task_desired_status",
+				Description: "The desired status of the task.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "task_last_status",
+				Description: "The last known status of the task.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "image",
+				Description: "The image used for the container.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "image_digest",
+				Description: "The container image manifest digest that the container is running.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "runtime_id",
+				Description: "The ID of the Docker container.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "last_status",
+				Description: "The last known status of the container.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "health_status",
+				Description: "The health status of the container, either HEALTHY, UNHEALTHY or UNKNOWN.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "exit_code",
+				Description: "The exit code returned from the container.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "reason",
+				Description: "A short (1024 max characters) human-readable string to provide additional details about a running or stopped container.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "cpu",
+				Description: "The number of CPU units set for the container.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "memory",
+				Description: "The hard limit (in MiB) of memory set for the container.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "memory_reservation",
+				Description: "The soft limit (in MiB) of memory set for the container.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "gpu_ids",
+				Description: "The IDs of each GPU assigned to the container.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "managed_agents",
+				Description: "The details of any Amazon ECS managed agents associated with the container.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "network_bindings",
+				Description: "The network bindings associated with the container.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "network_interfaces",
+				Description: "The network interfaces associated with the container.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ContainerArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listEcsTaskContainers(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	clusterArn := h.Item.(types.Cluster).ClusterArn
+
+	// Minimize the API call with the given cluster ARN
+	if d.EqualsQualString("cluster_arn") != "" && d.EqualsQualString("cluster_arn") != aws.ToString(clusterArn) {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := ECSClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ecs_task_container.listEcsTaskContainers", "connection_error", err)
+		return nil, err
+	}
+
+	// Prepare input parameters
+	input := ecs.ListTasksInput{Cluster: clusterArn}
+
+	if d.EqualsQualString("service_name") != "" {
+		input.ServiceName = aws.String(d.EqualsQualString("service_name"))
+	}
+	if d.EqualsQualString("container_instance_arn") != "" {
+		input.ContainerInstance = aws.String(d.EqualsQualString("container_instance_arn"))
+	}
+	if d.EqualsQualString("task_desired_status") != "" {
+		input.DesiredStatus = types.DesiredStatus(d.EqualsQualString("task_desired_status"))
+	}
+	if d.EqualsQualString("launch_type") != "" {
+		input.LaunchType = types.LaunchType(d.EqualsQualString("launch_type"))
+	}
+
+	// Each task has at least one container, so the row limit also bounds the number of tasks
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+	input.MaxResults = aws.Int32(maxLimit)
+
+	paginator := ecs.NewListTasksPaginator(svc, &input, func(o *ecs.ListTasksPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			// Error could not be caught by ignore config, we need to handle it manually
+			if strings.Contains(err.Error(), "ServiceNotFoundException") {
+				return nil, nil
+			}
+			plugin.Logger(ctx).Error("aws_ecs_task_container.listEcsTaskContainers", "list_tasks_api_error", err)
+			return nil, err
+		}
+
+		if len(output.TaskArns) == 0 {
+			continue
+		}
+
+		result, err := svc.DescribeTasks(ctx, &ecs.DescribeTasksInput{
+			Cluster: clusterArn,
+			Tasks:   output.TaskArns,
+		})
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_ecs_task_container.listEcsTaskContainers", "describe_tasks_api_error", err)
+			return nil, err
+		}
+
+		for _, task := range result.Tasks {
+			for _, container := range task.Containers {
+				d.StreamListItem(ctx, EcsTaskContainerInfo{
+					Container:            container,
+					ClusterArn:           task.ClusterArn,
+					ContainerInstanceArn: task.ContainerInstanceArn,
+					TaskDefinitionArn:    task.TaskDefinitionArn,
+					AvailabilityZone:     task.AvailabilityZone,
+					Group:                task.Group,
+					LaunchType:           task.LaunchType,
+					TaskDesiredStatus:    task.DesiredStatus,
+					TaskLastStatus:       task.LastStatus,
+				})
+
+				// Context may get cancelled due to manual cancellation or if the limit has been reached
+				if d.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+// Tasks started by a service have a group of the form service:<service-name>
+func ecsTaskGroupToServiceName(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	group := aws.ToString(d.Value.(*string))
+	if !strings.HasPrefix(group, "service:") {
+		return nil, nil
+	}
+	return strings.TrimPrefix(group, "service:"), nil
+}
@@ -0,0 +1,257 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsEcsTaskSet(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_ecs_task_set",
+		Description: "AWS ECS Task Set",
+		List: &plugin.ListConfig{
+			Hydrate:       listEcsTaskSets,
+			Tags:          map[string]string{"service": "ecs", "action": "DescribeTaskSets"},
+			ParentHydrate: listEcsClusters,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ClusterNotFoundException", "ServiceNotFoundException", "InvalidParameterException"}),
+			},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "cluster_arn", Require: plugin.Optional},
+				{Name: "service_arn", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(AWS_ECS_SERVICE_ID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The ID of the task set.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the task set.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("TaskSetArn"),
+			},
+			{
+				Name:        "service_arn",
+				Description: "The Amazon Resource Name (ARN) of the service the task set exists in.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "cluster_arn",
+				Description: "The Amazon Resource Name (ARN) of the cluster that the service that hosts the task set exists in.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "The status of the task set, either PRIMARY, ACTIVE or DRAINING.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "stability_status",
+				Description: "The stability status of the task set, either STEADY_STATE or STABILIZING.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "stability_status_at",
+				Description: "The time the task set stability status was retrieved.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "task_definition",
+				Description: "The task definition that the task set is using.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "external_id",
+				Description: "The external ID associated with the task set, e.g. the CodeDeploy deployment ID or the Cloud Map service ARN.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "started_by",
+				Description: "The tag specified when a task set is started, e.g. CODE_DEPLOY for an CodeDeploy deployment.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "launch_type",
+				Description: "The launch type the tasks in the task set are using.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "platform_version",
+				Description: "The Fargate platform version where the tasks in the task set are running.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "platform_family",
+				Description: "The operating system that your tasks in the set are running on.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "computed_desired_count",
+				Description: "The computed desired count for the task set, calculated by multiplying the service's desired count by the task set's scale percentage.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "pending_count",
+				Description: "The number of tasks in the task set that are in the PENDING status during a deployment.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "running_count",
+				Description: "The number of tasks in the task set that are in the RUNNING status during a deployment.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "created_at",
+				Description: "The time when the task set was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "updated_at",
+				Description: "The time when the task set was last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "scale",
+				Description: "A floating-point percentage of your desired number of tasks to place and keep running in the task set.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "capacity_provider_strategy",
+				Description: "The capacity provider strategy that are associated with the task set.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "load_balancers",
+				Description: "Details on a load balancer that are used with a task set.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "network_configuration",
+				Description: "The network configuration for the task set.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "service_registries",
+				Description: "The details for the service discovery registries to assign to this task set.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "fargate_ephemeral_storage",
+				Description: "The Fargate ephemeral storage settings for the task set.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "tags_src",
+				Description: "The metadata that you apply to the task set.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Id"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags").Transform(ecsTagsToTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("TaskSetArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listEcsTaskSets(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	cluster := h.Item.(types.Cluster)
+
+	// Minimize the API call with the given cluster ARN
+	if d.EqualsQualString("cluster_arn") != "" && d.EqualsQualString("cluster_arn") != aws.ToString(cluster.ClusterArn) {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := ECSClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ecs_task_set.listEcsTaskSets", "connection_error", err)
+		return nil, err
+	}
+
+	serviceArns, err := listEcsServiceArns(ctx, d, svc, cluster.ClusterArn)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ecs_task_set.listEcsTaskSets", "api_error", err)
+		return nil, err
+	}
+
+	// DescribeServices API can describe up to 10 services in a single operation.
+	// Only the services using the EXTERNAL or CODE_DEPLOY deployment controller
+	// have task sets.
+	for i := 0; i < len(serviceArns); i += 10 {
+		end := i + 10
+		if end > len(serviceArns) {
+			end = len(serviceArns)
+		}
+
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		services, err := svc.DescribeServices(ctx, &ecs.DescribeServicesInput{
+			Cluster:  cluster.ClusterArn,
+			Services: serviceArns[i:end],
+		})
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_ecs_task_set.listEcsTaskSets", "api_error", err)
+			return nil, err
+		}
+
+		for _, service := range services.Services {
+			if len(service.TaskSets) == 0 {
+				continue
+			}
+
+			output, err := svc.DescribeTaskSets(ctx, &ecs.DescribeTaskSetsInput{
+				Cluster: cluster.ClusterArn,
+				Service: service.ServiceArn,
+				Include: []types.TaskSetField{types.TaskSetFieldTags},
+			})
+			if err != nil {
+				plugin.Logger(ctx).Error("aws_ecs_task_set.listEcsTaskSets", "api_error", err)
+				return nil, err
+			}
+
+			for _, taskSet := range output.TaskSets {
+				d.StreamListItem(ctx, taskSet)
+
+				// Context may get cancelled due to manual cancellation or if the limit has been reached
+				if d.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
+			}
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,229 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/aws/aws-sdk-go-v2/service/eks/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsEksAddonCompatibility(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_eks_addon_compatibility",
+		Description: "AWS EKS Addon Compatibility",
+		List: &plugin.ListConfig{
+			Hydrate: listEksAddonCompatibilities,
+			Tags:    map[string]string{"service": "eks", "action": "DescribeAddonVersions"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "addon_name", Require: plugin.Optional},
+				{Name: "cluster_version", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(AWS_EKS_SERVICE_ID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "addon_name",
+				Description: "The name of the add-on.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "addon_version",
+				Description: "The version of the add-on.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "cluster_version",
+				Description: "The Kubernetes version of the cluster that the add-on version is compatible with.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "default_version",
+				Description: "Indicates if the add-on version is the default version installed on clusters of this Kubernetes version.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "platform_versions",
+				Description: "The EKS platform versions of the cluster that the add-on version is compatible with.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "type",
+				Description: "The type of the add-on.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "owner",
+				Description: "The owner of the add-on.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "publisher",
+				Description: "The publisher of the add-on.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "architecture",
+				Description: "The architectures that the add-on version supports.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "requires_configuration",
+				Description: "Indicates whether the add-on version requires configuration.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "cluster_version_status",
+				Description: "The support status of the cluster version, either UNSUPPORTED, STANDARD_SUPPORT or EXTENDED_SUPPORT.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ClusterVersionInformation.VersionStatus"),
+			},
+			{
+				Name:        "end_of_standard_support_date",
+				Description: "The date when standard support ends for the cluster version.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("ClusterVersionInformation.EndOfStandardSupportDate"),
+			},
+			{
+				Name:        "end_of_extended_support_date",
+				Description: "The date when extended support ends for the cluster version.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("ClusterVersionInformation.EndOfExtendedSupportDate"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AddonVersion"),
+			},
+		}),
+	}
+}
+
+type addonCompatibility struct {
+	AddonName                 *string
+	AddonVersion              *string
+	ClusterVersion            *string
+	DefaultVersion            bool
+	PlatformVersions          []string
+	Type                      *string
+	Owner                     *string
+	Publisher                 *string
+	Architecture              []string
+	RequiresConfiguration     bool
+	ClusterVersionInformation *types.ClusterVersionInformation
+}
+
+//// LIST FUNCTION
+
+func listEksAddonCompatibilities(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create service
+	svc, err := EKSClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_eks_addon_compatibility.listEksAddonCompatibilities", "get_client_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region check
+		return nil, nil
+	}
+
+	clusterVersion := d.EqualsQualString("cluster_version")
+
+	// Get the lifecycle of the cluster versions to join them to the compatibilities
+	versionsInput := &eks.DescribeClusterVersionsInput{
+		IncludeAll: aws.Bool(true),
+		MaxResults: aws.Int32(100),
+	}
+	if clusterVersion != "" {
+		versionsInput.ClusterVersions = []string{clusterVersion}
+	}
+
+	clusterVersions := map[string]types.ClusterVersionInformation{}
+	versionsPaginator := eks.NewDescribeClusterVersionsPaginator(svc, versionsInput, func(o *eks.DescribeClusterVersionsPaginatorOptions) {
+		o.Limit = *versionsInput.MaxResults
+		o.StopOnDuplicateToken = true
+	})
+	for versionsPaginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := versionsPaginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_eks_addon_compatibility.listEksAddonCompatibilities", "describe_cluster_versions_api_error", err)
+			return nil, err
+		}
+		for _, version := range output.ClusterVersions {
+			clusterVersions[aws.ToString(version.ClusterVersion)] = version
+		}
+	}
+
+	input := &eks.DescribeAddonVersionsInput{
+		MaxResults: aws.Int32(100),
+	}
+	if d.EqualsQualString("addon_name") != "" {
+		input.AddonName = aws.String(d.EqualsQualString("addon_name"))
+	}
+	if clusterVersion != "" {
+		input.KubernetesVersion = aws.String(clusterVersion)
+	}
+
+	paginator := eks.NewDescribeAddonVersionsPaginator(svc, input, func(o *eks.DescribeAddonVersionsPaginatorOptions) {
+		o.Limit = *input.MaxResults
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_eks_addon_compatibility.listEksAddonCompatibilities", "api_error", err)
+			return nil, err
+		}
+
+		for _, addon := range output.Addons {
+			for _, version := range addon.AddonVersions {
+				for _, compatibility := range version.Compatibilities {
+					// The add-on versions returned for a Kubernetes version also list their other compatibilities
+					if clusterVersion != "" && aws.ToString(compatibility.ClusterVersion) != clusterVersion {
+						continue
+					}
+
+					item := addonCompatibility{
+						AddonName:             addon.AddonName,
+						AddonVersion:          version.AddonVersion,
+						ClusterVersion:        compatibility.ClusterVersion,
+						DefaultVersion:        compatibility.DefaultVersion,
+						PlatformVersions:      compatibility.PlatformVersions,
+						Type:                  addon.Type,
+						Owner:                 addon.Owner,
+						Publisher:             addon.Publisher,
+						Architecture:          version.Architecture,
+						RequiresConfiguration: version.RequiresConfiguration,
+					}
+					if info, ok := clusterVersions[aws.ToString(compatibility.ClusterVersion)]; ok {
+						item.ClusterVersionInformation = &info
+					}
+					d.StreamListItem(ctx, item)
+
+					// Context may get cancelled due to manual cancellation or if the limit has been reached
+					if d.RowsRemaining(ctx) == 0 {
+						return nil, nil
+					}
+				}
+			}
+		}
+	}
+
+	return nil, nil
+}
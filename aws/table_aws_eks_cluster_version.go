@@ -0,0 +1,167 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/aws/aws-sdk-go-v2/service/eks/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsEksClusterVersion(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_eks_cluster_version",
+		Description: "AWS EKS Cluster Version",
+		List: &plugin.ListConfig{
+			Hydrate: listEksClusterVersions,
+			Tags:    map[string]string{"service": "eks", "action": "DescribeClusterVersions"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "cluster_version", Require: plugin.Optional},
+				{Name: "cluster_type", Require: plugin.Optional},
+				{Name: "version_status", Require: plugin.Optional},
+				{Name: "default_version", Require: plugin.Optional, Operators: []string{"=", "<>"}},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(AWS_EKS_SERVICE_ID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "cluster_version",
+				Description: "The Kubernetes minor version of the cluster, e.g. 1.31.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "cluster_type",
+				Description: "The type of cluster this version is for, e.g. eks.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "version_status",
+				Description: "The support status of the version, either UNSUPPORTED, STANDARD_SUPPORT or EXTENDED_SUPPORT.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "default_version",
+				Description: "Indicates if this is the default version used when creating a cluster.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "kubernetes_patch_version",
+				Description: "The patch version of Kubernetes for this cluster version.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "default_platform_version",
+				Description: "The default EKS platform version for this cluster version.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "release_date",
+				Description: "The release date of this cluster version.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "end_of_standard_support_date",
+				Description: "The date when standard support ends for this version.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "end_of_extended_support_date",
+				Description: "The date when extended support ends for this version.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ClusterVersion"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listEksClusterVersions(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create service
+	svc, err := EKSClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_eks_cluster_version.listEksClusterVersions", "get_client_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region check
+		return nil, nil
+	}
+
+	// Versions that are no longer supported are only returned when all versions are requested
+	input := &eks.DescribeClusterVersionsInput{
+		IncludeAll: aws.Bool(true),
+		MaxResults: aws.Int32(100),
+	}
+
+	if d.EqualsQualString("cluster_version") != "" {
+		input.ClusterVersions = []string{d.EqualsQualString("cluster_version")}
+	}
+	if d.EqualsQualString("cluster_type") != "" {
+		input.ClusterType = aws.String(d.EqualsQualString("cluster_type"))
+	}
+	if d.EqualsQualString("version_status") != "" {
+		input.VersionStatus = types.VersionStatus(d.EqualsQualString("version_status"))
+	}
+
+	// Only the default version can be requested through the API
+	if d.Quals["default_version"] != nil {
+		for _, q := range d.Quals["default_version"].Quals {
+			value := q.Value.GetBoolValue()
+			if (q.Operator == "=" && value) || (q.Operator == "<>" && !value) {
+				input.DefaultOnly = aws.Bool(true)
+			}
+		}
+	}
+
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < *input.MaxResults {
+			if limit < 1 {
+				input.MaxResults = aws.Int32(1)
+			} else {
+				input.MaxResults = aws.Int32(limit)
+			}
+		}
+	}
+
+	paginator := eks.NewDescribeClusterVersionsPaginator(svc, input, func(o *eks.DescribeClusterVersionsPaginatorOptions) {
+		o.Limit = *input.MaxResults
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_eks_cluster_version.listEksClusterVersions", "api_error", err)
+			return nil, err
+		}
+
+		for _, version := range output.ClusterVersions {
+			d.StreamListItem(ctx, version)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,289 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/aws/aws-sdk-go-v2/service/eks/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsEksInsight(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_eks_insight",
+		Description: "AWS EKS Insight",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"cluster_name", "id"}),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException", "InvalidParameterException"}),
+			},
+			Hydrate: getEksInsight,
+			Tags:    map[string]string{"service": "eks", "action": "DescribeInsight"},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listEKSClusters,
+			Hydrate:       listEksInsights,
+			Tags:          map[string]string{"service": "eks", "action": "ListInsights"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "cluster_name", Require: plugin.Optional},
+				{Name: "category", Require: plugin.Optional},
+				{Name: "status", Require: plugin.Optional},
+				{Name: "kubernetes_version", Require: plugin.Optional},
+			},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getEksInsight,
+				Tags: map[string]string{"service": "eks", "action": "DescribeInsight"},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(AWS_EKS_SERVICE_ID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "cluster_name",
+				Description: "The name of the cluster the insight is for.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the insight.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "name",
+				Description: "The name of the insight.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "category",
+				Description: "The category of the insight, either UPGRADE_READINESS or MISCONFIGURATION.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "The status of the insight, either PASSING, WARNING, ERROR or UNKNOWN.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("InsightStatus.Status"),
+			},
+			{
+				Name:        "status_reason",
+				Description: "The explanation of the status of the insight.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("InsightStatus.Reason"),
+			},
+			{
+				Name:        "kubernetes_version",
+				Description: "The Kubernetes minor version the insight relates to, e.g. the version the cluster would be upgraded to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the insight which includes alert criteria, remediation recommendation, and additional resources.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "last_refresh_time",
+				Description: "The time Amazon EKS last successfully completed a refresh of this insight check on the cluster.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "last_transition_time",
+				Description: "The time the status of the insight last changed.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "recommendation",
+				Description: "A summary of how to remediate the finding of the insight if applicable.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getEksInsight,
+			},
+			{
+				Name:        "additional_info",
+				Description: "Links to sources that provide additional context on the insight.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getEksInsight,
+			},
+			{
+				Name:        "resources",
+				Description: "The details about each resource listed in the insight check result, with their Kubernetes resource URI, ARN and status.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getEksInsight,
+			},
+			{
+				Name:        "deprecation_details",
+				Description: "The deprecated Kubernetes APIs used by the cluster, with their replacement, the version that stops serving them and the clients still calling them.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getEksInsight,
+				Transform:   transform.FromField("CategorySpecificSummary.DeprecationDetails"),
+			},
+			{
+				Name:        "addon_compatibility_details",
+				Description: "The add-ons installed on the cluster, with the add-on versions that are compatible with the Kubernetes version of the insight.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getEksInsight,
+				Transform:   transform.FromField("CategorySpecificSummary.AddonCompatibilityDetails"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+		}),
+	}
+}
+
+// EksInsightInfo holds the cluster name along with the insight, since the
+// insight itself does not reference its cluster
+type EksInsightInfo struct {
+	ClusterName *string
+	types.Insight
+}
+
+//// LIST FUNCTION
+
+func listEksInsights(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	clusterName := h.Item.(types.Cluster).Name
+
+	// Minimize the API call with the given cluster name
+	if d.EqualsQualString("cluster_name") != "" && d.EqualsQualString("cluster_name") != aws.ToString(clusterName) {
+		return nil, nil
+	}
+
+	// Create service
+	svc, err := EKSClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_eks_insight.listEksInsights", "get_client_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region check
+		return nil, nil
+	}
+
+	input := &eks.ListInsightsInput{
+		ClusterName: clusterName,
+		MaxResults:  aws.Int32(100),
+	}
+
+	filter := &types.InsightsFilter{}
+	if d.EqualsQualString("category") != "" {
+		filter.Categories = []types.Category{types.Category(d.EqualsQualString("category"))}
+	}
+	if d.EqualsQualString("status") != "" {
+		filter.Statuses = []types.InsightStatusValue{types.InsightStatusValue(d.EqualsQualString("status"))}
+	}
+	if d.EqualsQualString("kubernetes_version") != "" {
+		filter.KubernetesVersions = []string{d.EqualsQualString("kubernetes_version")}
+	}
+	if len(filter.Categories) > 0 || len(filter.Statuses) > 0 || len(filter.KubernetesVersions) > 0 {
+		input.Filter = filter
+	}
+
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < *input.MaxResults {
+			if limit < 1 {
+				input.MaxResults = aws.Int32(1)
+			} else {
+				input.MaxResults = aws.Int32(limit)
+			}
+		}
+	}
+
+	paginator := eks.NewListInsightsPaginator(svc, input, func(o *eks.ListInsightsPaginatorOptions) {
+		o.Limit = *input.MaxResults
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_eks_insight.listEksInsights", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range output.Insights {
+			d.StreamListItem(ctx, EksInsightInfo{
+				ClusterName: clusterName,
+				Insight: types.Insight{
+					Category:           item.Category,
+					Description:        item.Description,
+					Id:                 item.Id,
+					InsightStatus:      item.InsightStatus,
+					KubernetesVersion:  item.KubernetesVersion,
+					LastRefreshTime:    item.LastRefreshTime,
+					LastTransitionTime: item.LastTransitionTime,
+					Name:               item.Name,
+				},
+			})
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getEksInsight(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var clusterName, id string
+	if h.Item != nil {
+		clusterName = aws.ToString(h.Item.(EksInsightInfo).ClusterName)
+		id = aws.ToString(h.Item.(EksInsightInfo).Id)
+	} else {
+		clusterName = d.EqualsQualString("cluster_name")
+		id = d.EqualsQualString("id")
+	}
+
+	// check for empty parameters
+	if clusterName == "" || id == "" {
+		return nil, nil
+	}
+
+	// create service
+	svc, err := EKSClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_eks_insight.getEksInsight", "get_client_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region check
+		return nil, nil
+	}
+
+	params := &eks.DescribeInsightInput{
+		ClusterName: aws.String(clusterName),
+		Id:          aws.String(id),
+	}
+
+	op, err := svc.DescribeInsight(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_eks_insight.getEksInsight", "api_error", err)
+		return nil, err
+	}
+
+	if op.Insight == nil {
+		return nil, nil
+	}
+
+	return EksInsightInfo{
+		ClusterName: aws.String(clusterName),
+		Insight:     *op.Insight,
+	}, nil
+}
@@ -0,0 +1,234 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+func tableAwsEventBridgeArchive(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_eventbridge_archive",
+		Description: "AWS EventBridge Archive",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException", "ValidationException"}),
+			},
+			Hydrate: getAwsEventBridgeArchive,
+			Tags:    map[string]string{"service": "events", "action": "DescribeArchive"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listAwsEventBridgeArchives,
+			Tags:    map[string]string{"service": "events", "action": "ListArchives"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "event_source_arn", Require: plugin.Optional},
+				{Name: "state", Require: plugin.Optional},
+			},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getAwsEventBridgeArchive,
+				Tags: map[string]string{"service": "events", "action": "DescribeArchive"},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(AWS_EVENTS_SERVICE_ID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the archive.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ArchiveName"),
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the archive.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getAwsEventBridgeArchive,
+				Transform:   transform.FromField("ArchiveArn"),
+			},
+			{
+				Name:        "state",
+				Description: "The current state of the archive.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state_reason",
+				Description: "A description for the reason that the archive is in the current state.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "event_source_arn",
+				Description: "The ARN of the event bus associated with the archive. Only events from this event bus are sent to the archive.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "creation_time",
+				Description: "The time at which the archive was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "retention_days",
+				Description: "The number of days to retain events in the archive. A value of 0 means events are retained indefinitely.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "event_count",
+				Description: "The number of events in the archive.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "size_bytes",
+				Description: "The size of the archive, in bytes.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the archive.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getAwsEventBridgeArchive,
+			},
+			{
+				Name:        "event_pattern",
+				Description: "The event pattern used to filter the events sent to the archive.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getAwsEventBridgeArchive,
+			},
+
+			// Standard columns for all tables
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ArchiveName"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getAwsEventBridgeArchive,
+				Transform:   transform.FromField("ArchiveArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAwsEventBridgeArchives(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Get client
+	svc, err := EventBridgeClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_eventbridge_archive.listAwsEventBridgeArchives", "get_client_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	params := &eventbridge.ListArchivesInput{
+		Limit: aws.Int32(maxLimit),
+	}
+	if d.EqualsQualString("event_source_arn") != "" {
+		params.EventSourceArn = aws.String(d.EqualsQualString("event_source_arn"))
+	}
+	if d.EqualsQualString("state") != "" {
+		params.State = types.ArchiveState(d.EqualsQualString("state"))
+	}
+
+	// API doesn't support aws-go-sdk-v2 paginator as of date
+	for {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := svc.ListArchives(ctx, params)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_eventbridge_archive.listAwsEventBridgeArchives", "api_error", err)
+			return nil, err
+		}
+
+		for _, archive := range output.Archives {
+			d.StreamListItem(ctx, &eventbridge.DescribeArchiveOutput{
+				ArchiveName:    archive.ArchiveName,
+				CreationTime:   archive.CreationTime,
+				EventCount:     archive.EventCount,
+				EventSourceArn: archive.EventSourceArn,
+				RetentionDays:  archive.RetentionDays,
+				SizeBytes:      archive.SizeBytes,
+				State:          archive.State,
+				StateReason:    archive.StateReason,
+			})
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+		params.NextToken = output.NextToken
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getAwsEventBridgeArchive(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var name string
+	if h.Item != nil {
+		name = aws.ToString(h.Item.(*eventbridge.DescribeArchiveOutput).ArchiveName)
+	} else {
+		name = d.EqualsQualString("name")
+	}
+
+	// Empty check
+	if name == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := EventBridgeClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_eventbridge_archive.getAwsEventBridgeArchive", "get_client_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &eventbridge.DescribeArchiveInput{
+		ArchiveName: aws.String(name),
+	}
+
+	data, err := svc.DescribeArchive(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_eventbridge_archive.getAwsEventBridgeArchive", "api_error", err)
+		return nil, err
+	}
+
+	return data, nil
+}
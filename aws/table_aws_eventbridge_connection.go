@@ -0,0 +1,213 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+func tableAwsEventBridgeConnection(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_eventbridge_connection",
+		Description: "AWS EventBridge Connection",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException", "ValidationException"}),
+			},
+			Hydrate: getAwsEventBridgeConnection,
+			Tags:    map[string]string{"service": "events", "action": "DescribeConnection"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listAwsEventBridgeConnections,
+			Tags:    map[string]string{"service": "events", "action": "ListConnections"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "connection_state", Require: plugin.Optional},
+			},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getAwsEventBridgeConnection,
+				Tags: map[string]string{"service": "events", "action": "DescribeConnection"},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(AWS_EVENTS_SERVICE_ID),
+		// The authorization parameters of a connection are deliberately not exposed,
+		// only the authorization type is.
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the connection.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the connection.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ConnectionArn"),
+			},
+			{
+				Name:        "connection_state",
+				Description: "The state of the connection, e.g. AUTHORIZED or DEAUTHORIZED.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state_reason",
+				Description: "The reason that the connection is in the current connection state.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "authorization_type",
+				Description: "The authorization type of the connection, either BASIC, OAUTH_CLIENT_CREDENTIALS or API_KEY.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "creation_time",
+				Description: "A time stamp for the time that the connection was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "last_authorized_time",
+				Description: "A time stamp for the time that the connection was last authorized.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "last_modified_time",
+				Description: "A time stamp for the time that the connection was last modified.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the connection.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getAwsEventBridgeConnection,
+			},
+
+			// Standard columns for all tables
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ConnectionArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAwsEventBridgeConnections(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Get client
+	svc, err := EventBridgeClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_eventbridge_connection.listAwsEventBridgeConnections", "get_client_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	params := &eventbridge.ListConnectionsInput{
+		Limit: aws.Int32(maxLimit),
+	}
+	if d.EqualsQualString("connection_state") != "" {
+		params.ConnectionState = types.ConnectionState(d.EqualsQualString("connection_state"))
+	}
+
+	// API doesn't support aws-go-sdk-v2 paginator as of date
+	for {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := svc.ListConnections(ctx, params)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_eventbridge_connection.listAwsEventBridgeConnections", "api_error", err)
+			return nil, err
+		}
+
+		for _, connection := range output.Connections {
+			d.StreamListItem(ctx, connection)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+		params.NextToken = output.NextToken
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getAwsEventBridgeConnection(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var name string
+	if h.Item != nil {
+		name = aws.ToString(h.Item.(types.Connection).Name)
+	} else {
+		name = d.EqualsQualString("name")
+	}
+
+	// Empty check
+	if name == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := EventBridgeClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_eventbridge_connection.getAwsEventBridgeConnection", "get_client_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &eventbridge.DescribeConnectionInput{
+		Name: aws.String(name),
+	}
+
+	data, err := svc.DescribeConnection(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_eventbridge_connection.getAwsEventBridgeConnection", "api_error", err)
+		return nil, err
+	}
+
+	// Drop the authorization parameters so they can never be surfaced
+	data.AuthParameters = nil
+	data.SecretArn = nil
+
+	return data, nil
+}
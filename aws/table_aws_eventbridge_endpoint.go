@@ -0,0 +1,220 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+func tableAwsEventBridgeEndpoint(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_eventbridge_endpoint",
+		Description: "AWS EventBridge Global Endpoint",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException", "ValidationException"}),
+			},
+			Hydrate: getAwsEventBridgeEndpoint,
+			Tags:    map[string]string{"service": "events", "action": "DescribeEndpoint"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listAwsEventBridgeEndpoints,
+			Tags:    map[string]string{"service": "events", "action": "ListEndpoints"},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(AWS_EVENTS_SERVICE_ID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the endpoint.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the endpoint.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "endpoint_id",
+				Description: "The ID of the endpoint, e.g. abcde.veo.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "endpoint_url",
+				Description: "The URL of the endpoint.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "The current state of the endpoint.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state_reason",
+				Description: "The reason the endpoint is in its current state.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "A description of the endpoint.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "role_arn",
+				Description: "The ARN of the role used by event replication for the endpoint.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "creation_time",
+				Description: "The time the endpoint was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "last_modified_time",
+				Description: "The last time the endpoint was modified.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "replication_state",
+				Description: "Indicates whether event replication is ENABLED or DISABLED for the endpoint.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ReplicationConfig.State"),
+			},
+			{
+				Name:        "health_check",
+				Description: "The ARN of the Route 53 health check used by the endpoint to determine whether failover is triggered.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RoutingConfig.FailoverConfig.Primary.HealthCheck"),
+			},
+			{
+				Name:        "secondary_route",
+				Description: "The secondary Region that processes events when failover is triggered or replication is enabled.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RoutingConfig.FailoverConfig.Secondary.Route"),
+			},
+			{
+				Name:        "event_buses",
+				Description: "The event buses being used by the endpoint.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "routing_config",
+				Description: "The routing configuration of the endpoint.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard columns for all tables
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAwsEventBridgeEndpoints(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Get client
+	svc, err := EventBridgeClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_eventbridge_endpoint.listAwsEventBridgeEndpoints", "get_client_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	params := &eventbridge.ListEndpointsInput{
+		MaxResults: aws.Int32(maxLimit),
+	}
+
+	// API doesn't support aws-go-sdk-v2 paginator as of date
+	for {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := svc.ListEndpoints(ctx, params)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_eventbridge_endpoint.listAwsEventBridgeEndpoints", "api_error", err)
+			return nil, err
+		}
+
+		for _, endpoint := range output.Endpoints {
+			d.StreamListItem(ctx, endpoint)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+		params.NextToken = output.NextToken
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getAwsEventBridgeEndpoint(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+
+	// Empty check
+	if name == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := EventBridgeClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_eventbridge_endpoint.getAwsEventBridgeEndpoint", "get_client_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &eventbridge.DescribeEndpointInput{
+		Name: aws.String(name),
+	}
+
+	data, err := svc.DescribeEndpoint(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_eventbridge_endpoint.getAwsEventBridgeEndpoint", "api_error", err)
+		return nil, err
+	}
+
+	return data, nil
+}
@@ -0,0 +1,240 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+func tableAwsEventBridgeReplay(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_eventbridge_replay",
+		Description: "AWS EventBridge Replay",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException", "ValidationException"}),
+			},
+			Hydrate: getAwsEventBridgeReplay,
+			Tags:    map[string]string{"service": "events", "action": "DescribeReplay"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listAwsEventBridgeReplays,
+			Tags:    map[string]string{"service": "events", "action": "ListReplays"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "event_source_arn", Require: plugin.Optional},
+				{Name: "state", Require: plugin.Optional},
+			},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getAwsEventBridgeReplay,
+				Tags: map[string]string{"service": "events", "action": "DescribeReplay"},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(AWS_EVENTS_SERVICE_ID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the replay.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ReplayName"),
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the replay.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getAwsEventBridgeReplay,
+				Transform:   transform.FromField("ReplayArn"),
+			},
+			{
+				Name:        "state",
+				Description: "The current state of the replay.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state_reason",
+				Description: "A description for the reason that the replay is in the current state.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "event_source_arn",
+				Description: "The ARN of the archive from which the events are replayed.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "event_start_time",
+				Description: "A time stamp for the time to start replaying events. Any event with a creation time prior to the event start time is not replayed.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "event_end_time",
+				Description: "A time stamp for the time to stop replaying events. Any event with a creation time after the event end time is not replayed.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "event_last_replayed_time",
+				Description: "A time stamp for the last event that was replayed from the archive.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "replay_start_time",
+				Description: "A time stamp for the time that the replay started.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "replay_end_time",
+				Description: "A time stamp for the time that the replay completed.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the replay.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getAwsEventBridgeReplay,
+			},
+			{
+				Name:        "destination",
+				Description: "The destination of the replay, with the event bus and the rules that the events are replayed to.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getAwsEventBridgeReplay,
+			},
+
+			// Standard columns for all tables
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ReplayName"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getAwsEventBridgeReplay,
+				Transform:   transform.FromField("ReplayArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAwsEventBridgeReplays(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Get client
+	svc, err := EventBridgeClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_eventbridge_replay.listAwsEventBridgeReplays", "get_client_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	params := &eventbridge.ListReplaysInput{
+		Limit: aws.Int32(maxLimit),
+	}
+	if d.EqualsQualString("event_source_arn") != "" {
+		params.EventSourceArn = aws.String(d.EqualsQualString("event_source_arn"))
+	}
+	if d.EqualsQualString("state") != "" {
+		params.State = types.ReplayState(d.EqualsQualString("state"))
+	}
+
+	// API doesn't support aws-go-sdk-v2 paginator as of date
+	for {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := svc.ListReplays(ctx, params)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_eventbridge_replay.listAwsEventBridgeReplays", "api_error", err)
+			return nil, err
+		}
+
+		for _, replay := range output.Replays {
+			d.StreamListItem(ctx, &eventbridge.DescribeReplayOutput{
+				ReplayName:            replay.ReplayName,
+				EventSourceArn:        replay.EventSourceArn,
+				EventStartTime:        replay.EventStartTime,
+				EventEndTime:          replay.EventEndTime,
+				EventLastReplayedTime: replay.EventLastReplayedTime,
+				ReplayStartTime:       replay.ReplayStartTime,
+				ReplayEndTime:         replay.ReplayEndTime,
+				State:                 replay.State,
+				StateReason:           replay.StateReason,
+			})
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+		params.NextToken = output.NextToken
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getAwsEventBridgeReplay(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var name string
+	if h.Item != nil {
+		name = aws.ToString(h.Item.(*eventbridge.DescribeReplayOutput).ReplayName)
+	} else {
+		name = d.EqualsQualString("name")
+	}
+
+	// Empty check
+	if name == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := EventBridgeClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_eventbridge_replay.getAwsEventBridgeReplay", "get_client_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &eventbridge.DescribeReplayInput{
+		ReplayName: aws.String(name),
+	}
+
+	data, err := svc.DescribeReplay(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_eventbridge_replay.getAwsEventBridgeReplay", "api_error", err)
+		return nil, err
+	}
+
+	return data, nil
+}
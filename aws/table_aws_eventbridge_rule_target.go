@@ -0,0 +1,267 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+func tableAwsEventBridgeRuleTarget(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_eventbridge_rule_target",
+		Description: "AWS EventBridge Rule Target",
+		List: &plugin.ListConfig{
+			ParentHydrate: listAwsEventBridgeBuses,
+			Hydrate:       listAwsEventBridgeRuleTargets,
+			Tags:          map[string]string{"service": "events", "action": "ListTargetsByRule"},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException"}),
+			},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "event_bus_name", Require: plugin.Optional},
+				{Name: "rule_name", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(AWS_EVENTS_SERVICE_ID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The ID of the target within the specified rule.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the target.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "rule_name",
+				Description: "The name of the rule the target is assigned to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "rule_arn",
+				Description: "The Amazon Resource Name (ARN) of the rule the target is assigned to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "event_bus_name",
+				Description: "The name of the event bus associated with the rule.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "role_arn",
+				Description: "The Amazon Resource Name (ARN) of the IAM role to be used for this target when the rule is triggered.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "dead_letter_arn",
+				Description: "The ARN of the SQS queue used as the dead-letter queue of the target.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DeadLetterConfig.Arn"),
+			},
+			{
+				Name:        "maximum_retry_attempts",
+				Description: "The maximum number of retry attempts to make before the request fails.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("RetryPolicy.MaximumRetryAttempts"),
+			},
+			{
+				Name:        "maximum_event_age_in_seconds",
+				Description: "The maximum amount of time, in seconds, to continue to make retry attempts.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("RetryPolicy.MaximumEventAgeInSeconds"),
+			},
+			{
+				Name:        "input",
+				Description: "Valid JSON text passed to the target, in place of the matched event.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "input_path",
+				Description: "The value of the JSONPath that is used for extracting part of the matched event when passing it to the target.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "input_transformer",
+				Description: "Settings to enable you to provide custom input to a target based on certain event data.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "dead_letter_config",
+				Description: "The dead-letter queue of the target, where events that are not successfully delivered are sent.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "retry_policy",
+				Description: "The retry policy of the target, used to retry failed event deliveries.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "app_sync_parameters",
+				Description: "Contains the GraphQL operation to be parsed and executed, if the event target is an AppSync API.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "batch_parameters",
+				Description: "The parameters used if the event target is an Batch job.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "ecs_parameters",
+				Description: "The parameters used if the event target is an Amazon ECS task.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "http_parameters",
+				Description: "The HTTP parameters to use if the event target is an API Gateway endpoint or an API destination.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "kinesis_parameters",
+				Description: "The custom parameter you can use to control the shard assignment, if the event target is a Kinesis data stream.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "redshift_data_parameters",
+				Description: "The parameters used if the event target is an Amazon Redshift cluster.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "run_command_parameters",
+				Description: "The parameters used if the event target is Systems Manager Run Command.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "sage_maker_pipeline_parameters",
+				Description: "The parameters used if the event target is a SageMaker Pipeline.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "sqs_parameters",
+				Description: "The parameters used if the event target is an SQS FIFO queue.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard columns for all tables
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Id"),
+			},
+		}),
+	}
+}
+
+type EventBridgeRuleTargetInfo struct {
+	RuleName     *string
+	RuleArn      *string
+	EventBusName *string
+	types.Target
+}
+
+//// LIST FUNCTION
+
+func listAwsEventBridgeRuleTargets(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	eventBusName := h.Item.(*eventbridge.DescribeEventBusOutput).Name
+
+	// The parent hydrate lists the event buses by prefix, only keep the requested one
+	if d.EqualsQualString("event_bus_name") != "" && d.EqualsQualString("event_bus_name") != aws.ToString(eventBusName) {
+		return nil, nil
+	}
+
+	// Get client
+	svc, err := EventBridgeClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_eventbridge_rule_target.listAwsEventBridgeRuleTargets", "get_client_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	ruleName := d.EqualsQualString("rule_name")
+
+	rulesInput := &eventbridge.ListRulesInput{
+		EventBusName: eventBusName,
+		Limit:        aws.Int32(100),
+	}
+	if ruleName != "" {
+		rulesInput.NamePrefix = aws.String(ruleName)
+	}
+
+	var rules []types.Rule
+
+	// API doesn't support aws-go-sdk-v2 paginator as of date
+	for {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := svc.ListRules(ctx, rulesInput)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_eventbridge_rule_target.listAwsEventBridgeRuleTargets", "list_rules_api_error", err)
+			return nil, err
+		}
+
+		for _, rule := range output.Rules {
+			// NamePrefix also matches the rules whose name starts with the requested name
+			if ruleName != "" && aws.ToString(rule.Name) != ruleName {
+				continue
+			}
+			rules = append(rules, rule)
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+		rulesInput.NextToken = output.NextToken
+	}
+
+	for _, rule := range rules {
+		params := &eventbridge.ListTargetsByRuleInput{
+			EventBusName: eventBusName,
+			Rule:         rule.Name,
+			Limit:        aws.Int32(100),
+		}
+
+		for {
+			// apply rate limiting
+			d.WaitForListRateLimit(ctx)
+
+			output, err := svc.ListTargetsByRule(ctx, params)
+			if err != nil {
+				plugin.Logger(ctx).Error("aws_eventbridge_rule_target.listAwsEventBridgeRuleTargets", "api_error", err)
+				return nil, err
+			}
+
+			for _, target := range output.Targets {
+				d.StreamListItem(ctx, EventBridgeRuleTargetInfo{
+					RuleName:     rule.Name,
+					RuleArn:      rule.Arn,
+					EventBusName: eventBusName,
+					Target:       target,
+				})
+
+				// Context may get cancelled due to manual cancellation or if the limit has been reached
+				if d.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
+			}
+
+			if output.NextToken == nil {
+				break
+			}
+			params.NextToken = output.NextToken
+		}
+	}
+
+	return nil, nil
+}
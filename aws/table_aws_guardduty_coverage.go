@@ -0,0 +1,271 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/guardduty"
+	"github.com/aws/aws-sdk-go-v2/service/guardduty/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsGuardDutyCoverage(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_guardduty_coverage",
+		Description: "AWS GuardDuty Coverage",
+		List: &plugin.ListConfig{
+			ParentHydrate: listGuardDutyDetectors,
+			Hydrate:       listGuardDutyCoverage,
+			Tags:          map[string]string{"service": "guardduty", "action": "ListCoverage"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "detector_id", Require: plugin.Optional},
+				{Name: "coverage_status", Require: plugin.Optional, Operators: []string{"=", "<>"}},
+				{Name: "resource_type", Require: plugin.Optional, Operators: []string{"=", "<>"}},
+				{Name: "resource_account_id", Require: plugin.Optional, Operators: []string{"=", "<>"}},
+				{Name: "cluster_name", Require: plugin.Optional, Operators: []string{"=", "<>"}},
+				{Name: "instance_id", Require: plugin.Optional, Operators: []string{"=", "<>"}},
+				{Name: "management_type", Require: plugin.Optional, Operators: []string{"=", "<>"}},
+			},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"BadRequestException"}),
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(AWS_GUARDDUTY_SERVICE_ID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "resource_id",
+				Description: "The unique ID of the resource, i.e. the EKS or ECS cluster name or the EC2 instance ID.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "detector_id",
+				Description: "The ID of the detector.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "resource_type",
+				Description: "The type of the covered resource. Can be one of EKS, ECS or EC2.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ResourceDetails.ResourceType"),
+			},
+			{
+				Name:        "coverage_status",
+				Description: "Indicates whether the resource is covered by runtime monitoring. Can be HEALTHY or UNHEALTHY.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "issue",
+				Description: "The reason why the coverage of the resource is unhealthy.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "resource_account_id",
+				Description: "The ID of the Amazon Web Services account that owns the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AccountId"),
+			},
+			{
+				Name:        "cluster_name",
+				Description: "The name of the EKS or ECS cluster.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(guardDutyCoverageClusterName),
+			},
+			{
+				Name:        "instance_id",
+				Description: "The ID of the EC2 instance.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ResourceDetails.Ec2InstanceDetails.InstanceId"),
+			},
+			{
+				Name:        "management_type",
+				Description: "Indicates how the security agent is managed. Can be one of AUTO_MANAGED, MANUAL or DISABLED.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(guardDutyCoverageManagementType),
+			},
+			{
+				Name:        "agent_version",
+				Description: "The version of the security agent on the EC2 instance, or of the EKS add-on.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(guardDutyCoverageAgentVersion),
+			},
+			{
+				Name:        "updated_at",
+				Description: "The time at which the coverage details of the resource were last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "resource_details",
+				Description: "The details of the EKS cluster, ECS cluster or EC2 instance, including the agent or add-on status and the number of covered nodes or tasks.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ResourceId"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listGuardDutyCoverage(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	detectorId := h.Item.(detectorInfo).DetectorID
+
+	// Minimize the API call with the given detector_id
+	if d.EqualsQualString("detector_id") != "" && d.EqualsQualString("detector_id") != detectorId {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := GuardDutyClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_guardduty_coverage.listGuardDutyCoverage", "connection_error", err)
+		return nil, err
+	}
+
+	maxItems := int32(50)
+	input := &guardduty.ListCoverageInput{
+		DetectorId: aws.String(detectorId),
+	}
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxItems {
+			if limit < 1 {
+				maxItems = 1
+			} else {
+				maxItems = limit
+			}
+		}
+	}
+	input.MaxResults = aws.Int32(maxItems)
+
+	if criteria := buildGuardDutyCoverageFilterCriteria(d.Quals); len(criteria) > 0 {
+		input.FilterCriteria = &types.CoverageFilterCriteria{
+			FilterCriterion: criteria,
+		}
+	}
+
+	paginator := guardduty.NewListCoveragePaginator(svc, input, func(o *guardduty.ListCoveragePaginatorOptions) {
+		o.Limit = maxItems
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_guardduty_coverage.listGuardDutyCoverage", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range output.Resources {
+			d.StreamListItem(ctx, item)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// UTILITY FUNCTION
+
+// Build guardduty coverage filter param
+func buildGuardDutyCoverageFilterCriteria(quals plugin.KeyColumnQualMap) []types.CoverageFilterCriterion {
+	filterKeys := []struct {
+		ColumnName string
+		Key        types.CoverageFilterCriterionKey
+	}{
+		{"coverage_status", types.CoverageFilterCriterionKeyCoverageStatus},
+		{"resource_type", types.CoverageFilterCriterionKeyResourceType},
+		{"resource_account_id", types.CoverageFilterCriterionKeyAccountId},
+		{"cluster_name", types.CoverageFilterCriterionKeyClusterName},
+		{"instance_id", types.CoverageFilterCriterionKeyInstanceId},
+		{"management_type", types.CoverageFilterCriterionKeyManagementType},
+	}
+
+	var criteria []types.CoverageFilterCriterion
+	for _, filterKey := range filterKeys {
+		if quals[filterKey.ColumnName] == nil {
+			continue
+		}
+		condition := &types.CoverageFilterCondition{}
+		for _, q := range quals[filterKey.ColumnName].Quals {
+			value := q.Value.GetStringValue()
+			switch q.Operator {
+			case "=":
+				condition.Equals = append(condition.Equals, value)
+			case "<>":
+				condition.NotEquals = append(condition.NotEquals, value)
+			}
+		}
+		criteria = append(criteria, types.CoverageFilterCriterion{
+			CriterionKey:    filterKey.Key,
+			FilterCondition: condition,
+		})
+	}
+
+	return criteria
+}
+
+//// TRANSFORM FUNCTIONS
+
+func guardDutyCoverageClusterName(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	details := d.HydrateItem.(types.CoverageResource).ResourceDetails
+	if details == nil {
+		return nil, nil
+	}
+	switch {
+	case details.EksClusterDetails != nil:
+		return details.EksClusterDetails.ClusterName, nil
+	case details.EcsClusterDetails != nil:
+		return details.EcsClusterDetails.ClusterName, nil
+	}
+	return nil, nil
+}
+
+func guardDutyCoverageManagementType(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	details := d.HydrateItem.(types.CoverageResource).ResourceDetails
+	if details == nil {
+		return nil, nil
+	}
+	switch {
+	case details.EksClusterDetails != nil:
+		return details.EksClusterDetails.ManagementType, nil
+	case details.Ec2InstanceDetails != nil:
+		return details.Ec2InstanceDetails.ManagementType, nil
+	case details.EcsClusterDetails != nil && details.EcsClusterDetails.FargateDetails != nil:
+		return details.EcsClusterDetails.FargateDetails.ManagementType, nil
+	}
+	return nil, nil
+}
+
+func guardDutyCoverageAgentVersion(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	details := d.HydrateItem.(types.CoverageResource).ResourceDetails
+	if details == nil {
+		return nil, nil
+	}
+	switch {
+	case details.EksClusterDetails != nil && details.EksClusterDetails.AddonDetails != nil:
+		return details.EksClusterDetails.AddonDetails.AddonVersion, nil
+	case details.Ec2InstanceDetails != nil && details.Ec2InstanceDetails.AgentDetails != nil:
+		return details.Ec2InstanceDetails.AgentDetails.Version, nil
+	}
+	return nil, nil
+}
@@ -2,9 +2,11 @@ package aws
 
 import (
 	"context"
+	"slices"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/guardduty"
+	"github.com/aws/aws-sdk-go-v2/service/guardduty/types"
 
 	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
@@ -43,6 +45,15 @@ func tableAwsGuardDutyDetector(_ context.Context) *plugin.Table {
 				Func: getGuardDutyDetectorMasterAccount,
 				Tags: map[string]string{"service": "guardduty", "action": "GetAdministratorAccount"},
 			},
+			{
+				Func: getGuardDutyDetectorCoverageStatistics,
+				Tags: map[string]string{"service": "guardduty", "action": "GetCoverageStatistics"},
+			},
+			{
+				Func:    getGuardDutyDetectorUsageStatistics,
+				Depends: []plugin.HydrateFunc{getGuardDutyDetector},
+				Tags:    map[string]string{"service": "guardduty", "action": "GetUsageStatistics"},
+			},
 		},
 		GetMatrixItemFunc: SupportedRegionMatrix(AWS_GUARDDUTY_SERVICE_ID),
 		Columns: awsRegionalColumns([]*plugin.Column{
@@ -108,6 +119,20 @@ func tableAwsGuardDutyDetector(_ context.Context) *plugin.Table {
 				Hydrate:     getGuardDutyDetectorMasterAccount,
 				Transform:   transform.FromValue(),
 			},
+			{
+				Name:        "coverage_statistics",
+				Description: "The number of resources covered by runtime monitoring, by coverage status and by resource type.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getGuardDutyDetectorCoverageStatistics,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "usage_by_feature",
+				Description: "The usage of each GuardDuty feature enabled on the detector in the last 30 days, with the estimated cost.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getGuardDutyDetectorUsageStatistics,
+				Transform:   transform.FromValue(),
+			},
 
 			// Standard columns
 			{
@@ -239,6 +264,110 @@ func getGuardDutyDetectorMasterAccount(ctx context.Context, d *plugin.QueryData,
 	return op.Administrator, nil
 }
 
+func getGuardDutyDetectorCoverageStatistics(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	id := h.Item.(detectorInfo).DetectorID
+
+	// Create Session
+	svc, err := GuardDutyClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_guardduty_detector.getGuardDutyDetectorCoverageStatistics", "get_client_error", err)
+		return nil, err
+	}
+
+	params := &guardduty.GetCoverageStatisticsInput{
+		DetectorId: &id,
+		StatisticsType: []types.CoverageStatisticsType{
+			types.CoverageStatisticsTypeCountByCoverageStatus,
+			types.CoverageStatisticsTypeCountByResourceType,
+		},
+	}
+
+	op, err := svc.GetCoverageStatistics(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_guardduty_detector.getGuardDutyDetectorCoverageStatistics", "api_error", err)
+		return nil, err
+	}
+
+	return op.CoverageStatistics, nil
+}
+
+func getGuardDutyDetectorUsageStatistics(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// The detector is the item itself for get calls
+	detector, ok := h.HydrateResults["getGuardDutyDetector"].(detectorInfo)
+	if !ok {
+		detector = h.Item.(detectorInfo)
+	}
+	id := detector.DetectorID
+
+	// Requesting the usage of a feature that is not enabled on the detector
+	// fails with a BadRequestException, so only ask for the enabled ones
+	features := guardDutyDetectorUsageFeatures(detector.Features)
+	if len(features) == 0 {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := GuardDutyClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_guardduty_detector.getGuardDutyDetectorUsageStatistics", "get_client_error", err)
+		return nil, err
+	}
+
+	params := &guardduty.GetUsageStatisticsInput{
+		DetectorId:         &id,
+		UsageStatisticType: types.UsageStatisticTypeSumByFeatures,
+		UsageCriteria: &types.UsageCriteria{
+			Features: features,
+		},
+	}
+
+	var usage []types.UsageFeatureResult
+	paginator := guardduty.NewGetUsageStatisticsPaginator(svc, params, func(o *guardduty.GetUsageStatisticsPaginatorOptions) {
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_guardduty_detector.getGuardDutyDetectorUsageStatistics", "api_error", err)
+			return nil, err
+		}
+		if output.UsageStatistics != nil {
+			usage = append(usage, output.UsageStatistics.SumByFeature...)
+		}
+	}
+
+	return usage, nil
+}
+
+// guardDutyDetectorUsageFeatures returns the usage features of the features
+// enabled on a detector. Runtime monitoring is reported separately for each
+// kind of resource it covers.
+func guardDutyDetectorUsageFeatures(features []types.DetectorFeatureConfigurationResult) []types.UsageFeature {
+	var usageFeatures []types.UsageFeature
+	for _, feature := range features {
+		if feature.Status != types.FeatureStatusEnabled {
+			continue
+		}
+		if feature.Name == types.DetectorFeatureResultRuntimeMonitoring {
+			usageFeatures = append(usageFeatures,
+				types.UsageFeatureEksRuntimeMonitoring,
+				types.UsageFeatureFargateRuntimeMonitoring,
+				types.UsageFeatureEc2RuntimeMonitoring,
+			)
+			continue
+		}
+		usageFeatures = append(usageFeatures, types.UsageFeature(feature.Name))
+	}
+
+	// EKS runtime monitoring may be listed on its own as well as through runtime monitoring
+	slices.Sort(usageFeatures)
+	return slices.Compact(usageFeatures)
+}
+
 func getGuardDutyDetectorARN(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	region := d.EqualsQualString(matrixKeyRegion)
 	data := h.Item.(detectorInfo)
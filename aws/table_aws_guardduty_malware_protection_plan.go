@@ -0,0 +1,213 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/guardduty"
+	"github.com/aws/aws-sdk-go-v2/service/guardduty/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+type GuardDutyMalwareProtectionPlanInfo struct {
+	guardduty.GetMalwareProtectionPlanOutput
+	MalwareProtectionPlanId *string
+}
+
+//// TABLE DEFINITION
+
+func tableAwsGuardDutyMalwareProtectionPlan(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_guardduty_malware_protection_plan",
+		Description: "AWS GuardDuty Malware Protection Plan",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("malware_protection_plan_id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException", "BadRequestException"}),
+			},
+			Hydrate: getGuardDutyMalwareProtectionPlan,
+			Tags:    map[string]string{"service": "guardduty", "action": "GetMalwareProtectionPlan"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listGuardDutyMalwareProtectionPlans,
+			Tags:    map[string]string{"service": "guardduty", "action": "ListMalwareProtectionPlans"},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getGuardDutyMalwareProtectionPlan,
+				Tags: map[string]string{"service": "guardduty", "action": "GetMalwareProtectionPlan"},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(AWS_GUARDDUTY_SERVICE_ID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "malware_protection_plan_id",
+				Description: "The unique identifier of the malware protection plan.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the malware protection plan.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getGuardDutyMalwareProtectionPlan,
+			},
+			{
+				Name:        "status",
+				Description: "The status of the malware protection plan. Can be one of ACTIVE, WARNING or ERROR.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getGuardDutyMalwareProtectionPlan,
+			},
+			{
+				Name:        "created_at",
+				Description: "The timestamp when the malware protection plan resource was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Hydrate:     getGuardDutyMalwareProtectionPlan,
+			},
+			{
+				Name:        "role",
+				Description: "The IAM role that includes the permissions required to scan and add tags to the associated protected resource.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getGuardDutyMalwareProtectionPlan,
+			},
+			{
+				Name:        "s3_bucket_name",
+				Description: "The name of the S3 bucket that is protected by the plan.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getGuardDutyMalwareProtectionPlan,
+				Transform:   transform.FromField("ProtectedResource.S3Bucket.BucketName"),
+			},
+			{
+				Name:        "object_prefixes",
+				Description: "The S3 object prefixes that are scanned. If empty, every object in the bucket is scanned.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getGuardDutyMalwareProtectionPlan,
+				Transform:   transform.FromField("ProtectedResource.S3Bucket.ObjectPrefixes"),
+			},
+			{
+				Name:        "tagging_action_status",
+				Description: "Indicates whether scanned S3 objects are tagged with the scan result. Can be ENABLED or DISABLED.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getGuardDutyMalwareProtectionPlan,
+				Transform:   transform.FromField("Actions.Tagging.Status"),
+			},
+			{
+				Name:        "status_reasons",
+				Description: "Information about the issue code and message associated to the status of the malware protection plan.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getGuardDutyMalwareProtectionPlan,
+			},
+			{
+				Name:        "protected_resource",
+				Description: "Information about the protected resource that is associated with the malware protection plan.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getGuardDutyMalwareProtectionPlan,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("MalwareProtectionPlanId"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getGuardDutyMalwareProtectionPlan,
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getGuardDutyMalwareProtectionPlan,
+				Transform:   transform.FromField("Arn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listGuardDutyMalwareProtectionPlans(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := GuardDutyClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_guardduty_malware_protection_plan.listGuardDutyMalwareProtectionPlans", "connection_error", err)
+		return nil, err
+	}
+
+	input := &guardduty.ListMalwareProtectionPlansInput{}
+
+	// API doesn't support aws-sdk-go-v2 paginator as of date.
+	pagesLeft := true
+	for pagesLeft {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := svc.ListMalwareProtectionPlans(ctx, input)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_guardduty_malware_protection_plan.listGuardDutyMalwareProtectionPlans", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range output.MalwareProtectionPlans {
+			d.StreamListItem(ctx, item)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if output.NextToken != nil {
+			input.NextToken = output.NextToken
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getGuardDutyMalwareProtectionPlan(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var id string
+	if h.Item != nil {
+		switch item := h.Item.(type) {
+		case types.MalwareProtectionPlanSummary:
+			id = aws.ToString(item.MalwareProtectionPlanId)
+		case GuardDutyMalwareProtectionPlanInfo:
+			return item, nil
+		}
+	} else {
+		id = d.EqualsQualString("malware_protection_plan_id")
+	}
+
+	if id == "" {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := GuardDutyClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_guardduty_malware_protection_plan.getGuardDutyMalwareProtectionPlan", "connection_error", err)
+		return nil, err
+	}
+
+	params := &guardduty.GetMalwareProtectionPlanInput{
+		MalwareProtectionPlanId: aws.String(id),
+	}
+
+	op, err := svc.GetMalwareProtectionPlan(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_guardduty_malware_protection_plan.getGuardDutyMalwareProtectionPlan", "api_error", err)
+		return nil, err
+	}
+
+	return GuardDutyMalwareProtectionPlanInfo{*op, aws.String(id)}, nil
+}
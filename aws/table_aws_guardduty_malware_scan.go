@@ -0,0 +1,257 @@
+package aws
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/guardduty"
+	"github.com/aws/aws-sdk-go-v2/service/guardduty/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsGuardDutyMalwareScan(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_guardduty_malware_scan",
+		Description: "AWS GuardDuty Malware Scan",
+		List: &plugin.ListConfig{
+			ParentHydrate: listGuardDutyDetectors,
+			Hydrate:       listGuardDutyMalwareScans,
+			Tags:          map[string]string{"service": "guardduty", "action": "DescribeMalwareScans"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "detector_id", Require: plugin.Optional},
+				{Name: "scan_id", Require: plugin.Optional},
+				{Name: "scan_status", Require: plugin.Optional},
+				{Name: "scan_type", Require: plugin.Optional},
+				{Name: "resource_account_id", Require: plugin.Optional},
+				{Name: "instance_arn", Require: plugin.Optional},
+				{Name: "guardduty_finding_id", Require: plugin.Optional},
+				{Name: "scan_start_time", Require: plugin.Optional, Operators: []string{">", ">=", "<", "<="}},
+			},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"BadRequestException"}),
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(AWS_GUARDDUTY_SERVICE_ID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "scan_id",
+				Description: "The unique scan ID associated with a scan entry.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "detector_id",
+				Description: "The unique ID of the detector that the request is associated with.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "admin_detector_id",
+				Description: "The unique detector ID of the administrator account that the request is associated with.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "scan_status",
+				Description: "An enum value representing the possible scan statuses. Can be one of RUNNING, COMPLETED, FAILED or SKIPPED.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "scan_type",
+				Description: "Specifies the scan type that invoked the malware scan. Can be GUARDDUTY_INITIATED or ON_DEMAND.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "scan_result",
+				Description: "The result of the scan. Can be CLEAN or INFECTED.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ScanResultDetails.ScanResult"),
+			},
+			{
+				Name:        "failure_reason",
+				Description: "Represents the reason for a FAILED scan status.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "resource_account_id",
+				Description: "The ID for the account that belongs to the scan.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AccountId"),
+			},
+			{
+				Name:        "instance_arn",
+				Description: "The Amazon Resource Name (ARN) of the EC2 instance that was scanned.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ResourceDetails.InstanceArn"),
+			},
+			{
+				Name:        "guardduty_finding_id",
+				Description: "The ID of the GuardDuty finding that triggered the malware scan.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("TriggerDetails.GuardDutyFindingId"),
+			},
+			{
+				Name:        "scan_start_time",
+				Description: "The timestamp of when the scan was triggered.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "scan_end_time",
+				Description: "The timestamp of when the scan was finished.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "file_count",
+				Description: "Represents the number of files that were scanned.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "total_bytes",
+				Description: "Represents total bytes that were scanned.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "attached_volumes",
+				Description: "List of volumes that were attached to the original instance to be scanned.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "trigger_details",
+				Description: "Specifies the reason why the scan was initiated.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ScanId"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listGuardDutyMalwareScans(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	detectorId := h.Item.(detectorInfo).DetectorID
+
+	// Minimize the API call with the given detector_id
+	if d.EqualsQualString("detector_id") != "" && d.EqualsQualString("detector_id") != detectorId {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := GuardDutyClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_guardduty_malware_scan.listGuardDutyMalwareScans", "connection_error", err)
+		return nil, err
+	}
+
+	maxItems := int32(50)
+	input := &guardduty.DescribeMalwareScansInput{
+		DetectorId: aws.String(detectorId),
+	}
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxItems {
+			if limit < 1 {
+				maxItems = 1
+			} else {
+				maxItems = limit
+			}
+		}
+	}
+	input.MaxResults = aws.Int32(maxItems)
+
+	if criteria := buildGuardDutyMalwareScanFilterCriteria(d.Quals); len(criteria) > 0 {
+		input.FilterCriteria = &types.FilterCriteria{
+			FilterCriterion: criteria,
+		}
+	}
+
+	paginator := guardduty.NewDescribeMalwareScansPaginator(svc, input, func(o *guardduty.DescribeMalwareScansPaginatorOptions) {
+		o.Limit = maxItems
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_guardduty_malware_scan.listGuardDutyMalwareScans", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range output.Scans {
+			d.StreamListItem(ctx, item)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// UTILITY FUNCTION
+
+// Build guardduty malware scan filter param
+func buildGuardDutyMalwareScanFilterCriteria(quals plugin.KeyColumnQualMap) []types.FilterCriterion {
+	filterKeys := []struct {
+		ColumnName string
+		Key        types.CriterionKey
+	}{
+		{"scan_id", types.CriterionKeyScanId},
+		{"scan_status", types.CriterionKeyScanStatus},
+		{"scan_type", types.CriterionKeyScanType},
+		{"resource_account_id", types.CriterionKeyAccountId},
+		{"instance_arn", types.CriterionKeyEc2InstanceArn},
+		{"guardduty_finding_id", types.CriterionKeyGuarddutyFindingId},
+	}
+
+	var criteria []types.FilterCriterion
+	for _, filterKey := range filterKeys {
+		if quals[filterKey.ColumnName] == nil {
+			continue
+		}
+		for _, q := range quals[filterKey.ColumnName].Quals {
+			if q.Operator == "=" {
+				criteria = append(criteria, types.FilterCriterion{
+					CriterionKey:    filterKey.Key,
+					FilterCondition: &types.FilterCondition{EqualsValue: aws.String(q.Value.GetStringValue())},
+				})
+			}
+		}
+	}
+
+	// The scan start time is filtered in milliseconds since the epoch
+	if quals["scan_start_time"] != nil {
+		condition := &types.FilterCondition{}
+		for _, q := range quals["scan_start_time"].Quals {
+			timestamp := q.Value.GetTimestampValue().AsTime()
+			switch q.Operator {
+			case ">", ">=":
+				condition.GreaterThan = aws.Int64(timestamp.Add(-time.Millisecond).UnixMilli())
+			case "<", "<=":
+				condition.LessThan = aws.Int64(timestamp.Add(time.Millisecond).UnixMilli())
+			}
+		}
+		criteria = append(criteria, types.FilterCriterion{
+			CriterionKey:    types.CriterionKeyScanStartTime,
+			FilterCondition: condition,
+		})
+	}
+
+	return criteria
+}
@@ -73,6 +73,20 @@ func tableAwsIamServerCertificate(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Hydrate:     getIamServerCertificate,
 			},
+			{
+				Name:        "certificate_decoded",
+				Description: "The decoded X.509 fields of the public key certificate, such as subject, subject alternative names, key usage, validity and serial number.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getIamServerCertificate,
+				Transform:   transform.FromField("CertificateBody").Transform(decodeX509CertificatePem),
+			},
+			{
+				Name:        "certificate_chain_decoded",
+				Description: "The decoded X.509 fields of each certificate in the public key certificate chain.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getIamServerCertificate,
+				Transform:   transform.FromField("CertificateChain").Transform(decodeX509CertificateChainPem),
+			},
 			{
 				Name:        "path",
 				Description: "The path to the server certificate.",
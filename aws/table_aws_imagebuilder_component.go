@@ -0,0 +1,280 @@
+package aws
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/imagebuilder"
+	"github.com/aws/aws-sdk-go-v2/service/imagebuilder/types"
+	"github.com/goccy/go-yaml"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+func tableAwsImageBuilderComponent(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_imagebuilder_component",
+		Description: "AWS EC2 Image Builder Component",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("arn"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException", "InvalidParameterValueException"}),
+			},
+			Hydrate: getImageBuilderComponent,
+			Tags:    map[string]string{"service": "imagebuilder", "action": "GetComponent"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listImageBuilderComponents,
+			Tags:    map[string]string{"service": "imagebuilder", "action": "ListComponentBuildVersions"},
+		},
+		GetMatrixItemFunc: ImageBuilderRegionsMatrix,
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getImageBuilderComponent,
+				Tags: map[string]string{"service": "imagebuilder", "action": "GetComponent"},
+			},
+		},
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the component.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the component build version.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "version",
+				Description: "The semantic version of the component.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the component.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "change_description",
+				Description: "The change description of the component version.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type",
+				Description: "The type of the component, either BUILD or TEST.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "platform",
+				Description: "The operating system platform of the component.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "owner",
+				Description: "The owner of the component.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "publisher",
+				Description: "The publisher of the component, for AWS Marketplace components.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "The status of the component, either ACTIVE or DEPRECATED.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("State.Status"),
+			},
+			{
+				Name:        "date_created",
+				Description: "The date on which the component was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "encrypted",
+				Description: "Indicates whether the component is encrypted.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getImageBuilderComponent,
+			},
+			{
+				Name:        "kms_key_id",
+				Description: "The KMS key used to encrypt the component.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getImageBuilderComponent,
+			},
+			{
+				Name:        "supported_os_versions",
+				Description: "The operating system versions supported by the component.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "parameters",
+				Description: "The input parameters of the component.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getImageBuilderComponent,
+			},
+			{
+				Name:        "data",
+				Description: "The YAML document of the component, as a string.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getImageBuilderComponent,
+			},
+			{
+				Name:        "document",
+				Description: "The YAML document of the component, parsed into JSON, with its phases and steps.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getImageBuilderComponent,
+				Transform:   transform.FromField("Data").Transform(imageBuilderComponentDocument),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listImageBuilderComponents(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := ImageBuilderClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_imagebuilder_component.listImageBuilderComponents", "connection_error", err)
+		return nil, err
+	}
+
+	input := &imagebuilder.ListComponentsInput{
+		MaxResults: aws.Int32(25),
+		Owner:      types.OwnershipSelf,
+	}
+
+	paginator := imagebuilder.NewListComponentsPaginator(svc, input, func(o *imagebuilder.ListComponentsPaginatorOptions) {
+		o.Limit = 25
+		o.StopOnDuplicateToken = true
+	})
+
+	// List call
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_imagebuilder_component.listImageBuilderComponents", "api_error", err)
+			return nil, err
+		}
+
+		// Each semantic version of a component can have several build versions
+		for _, version := range output.ComponentVersionList {
+			buildPaginator := imagebuilder.NewListComponentBuildVersionsPaginator(svc, &imagebuilder.ListComponentBuildVersionsInput{
+				ComponentVersionArn: version.Arn,
+				MaxResults:          aws.Int32(25),
+			}, func(o *imagebuilder.ListComponentBuildVersionsPaginatorOptions) {
+				o.Limit = 25
+				o.StopOnDuplicateToken = true
+			})
+
+			for buildPaginator.HasMorePages() {
+				// apply rate limiting
+				d.WaitForListRateLimit(ctx)
+
+				buildOutput, err := buildPaginator.NextPage(ctx)
+				if err != nil {
+					plugin.Logger(ctx).Error("aws_imagebuilder_component.listImageBuilderComponents", "api_error", err)
+					return nil, err
+				}
+
+				for _, component := range buildOutput.ComponentSummaryList {
+					d.StreamListItem(ctx, component)
+
+					// Context can be cancelled due to manual cancellation or the limit has been hit
+					if d.RowsRemaining(ctx) == 0 {
+						return nil, nil
+					}
+				}
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getImageBuilderComponent(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var arn string
+	switch item := h.Item.(type) {
+	case types.ComponentSummary:
+		arn = aws.ToString(item.Arn)
+	case types.Component:
+		return item, nil
+	default:
+		arn = d.EqualsQualString("arn")
+	}
+
+	// Empty check
+	if arn == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := ImageBuilderClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_imagebuilder_component.getImageBuilderComponent", "connection_error", err)
+		return nil, err
+	}
+
+	output, err := svc.GetComponent(ctx, &imagebuilder.GetComponentInput{
+		ComponentBuildVersionArn: aws.String(arn),
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_imagebuilder_component.getImageBuilderComponent", "api_error", err)
+		return nil, err
+	}
+
+	if output.Component != nil {
+		return *output.Component, nil
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+// imageBuilderComponentDocument parses the YAML document of a component. The
+// document is returned as is by the API, so unlike transform.UnmarshalYAML it
+// is not URL unescaped, which would alter the scripts of its steps.
+func imageBuilderComponentDocument(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data, ok := d.Value.(*string)
+	if !ok || data == nil || *data == "" {
+		return nil, nil
+	}
+
+	var document interface{}
+	err := yaml.Unmarshal([]byte(strings.ReplaceAll(*data, "\r\n", "\n")), &document)
+	if err != nil {
+		return nil, nil
+	}
+	return document, nil
+}
@@ -0,0 +1,247 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/imagebuilder"
+	"github.com/aws/aws-sdk-go-v2/service/imagebuilder/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+func tableAwsImageBuilderContainerRecipe(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_imagebuilder_container_recipe",
+		Description: "AWS EC2 Image Builder Container Recipe",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("arn"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException", "InvalidParameterValueException"}),
+			},
+			Hydrate: getImageBuilderContainerRecipe,
+			Tags:    map[string]string{"service": "imagebuilder", "action": "GetContainerRecipe"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listImageBuilderContainerRecipes,
+			Tags:    map[string]string{"service": "imagebuilder", "action": "ListContainerRecipes"},
+		},
+		GetMatrixItemFunc: ImageBuilderRegionsMatrix,
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getImageBuilderContainerRecipe,
+				Tags: map[string]string{"service": "imagebuilder", "action": "GetContainerRecipe"},
+			},
+		},
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the container recipe.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the container recipe.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "version",
+				Description: "The semantic version of the container recipe.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getImageBuilderContainerRecipe,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the container recipe.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getImageBuilderContainerRecipe,
+			},
+			{
+				Name:        "owner",
+				Description: "The owner of the container recipe.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "platform",
+				Description: "The platform of the container recipe, either Windows or Linux.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "parent_image",
+				Description: "The base image of the container recipe, either a container image URI or an Image Builder image ARN.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "container_type",
+				Description: "Specifies the type of container, such as Docker.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "date_created",
+				Description: "The date on which the container recipe was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "encrypted",
+				Description: "Indicates whether the container image is encrypted.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getImageBuilderContainerRecipe,
+			},
+			{
+				Name:        "kms_key_id",
+				Description: "The KMS key used to encrypt the container image.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getImageBuilderContainerRecipe,
+			},
+			{
+				Name:        "working_directory",
+				Description: "The working directory to be used during build and test workflows.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getImageBuilderContainerRecipe,
+			},
+			{
+				Name:        "dockerfile_template_data",
+				Description: "The Dockerfile template used to build the image.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getImageBuilderContainerRecipe,
+			},
+			{
+				Name:        "components",
+				Description: "The components of the container recipe, with their parameters, in the order they are applied.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getImageBuilderContainerRecipe,
+			},
+			{
+				Name:        "instance_configuration",
+				Description: "The configuration of the instance used to build the container image, such as its AMI and block device mappings.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getImageBuilderContainerRecipe,
+			},
+			{
+				Name:        "target_repository",
+				Description: "The destination repository for the container image.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getImageBuilderContainerRecipe,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listImageBuilderContainerRecipes(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := ImageBuilderClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_imagebuilder_container_recipe.listImageBuilderContainerRecipes", "connection_error", err)
+		return nil, err
+	}
+
+	// Limiting the results
+	maxLimit := int32(25)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &imagebuilder.ListContainerRecipesInput{
+		MaxResults: aws.Int32(maxLimit),
+		Owner:      types.OwnershipSelf,
+	}
+
+	paginator := imagebuilder.NewListContainerRecipesPaginator(svc, input, func(o *imagebuilder.ListContainerRecipesPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	// List call
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_imagebuilder_container_recipe.listImageBuilderContainerRecipes", "api_error", err)
+			return nil, err
+		}
+
+		for _, recipe := range output.ContainerRecipeSummaryList {
+			d.StreamListItem(ctx, recipe)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getImageBuilderContainerRecipe(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var arn string
+	switch item := h.Item.(type) {
+	case types.ContainerRecipeSummary:
+		arn = aws.ToString(item.Arn)
+	case types.ContainerRecipe:
+		return item, nil
+	default:
+		arn = d.EqualsQualString("arn")
+	}
+
+	// Empty check
+	if arn == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := ImageBuilderClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_imagebuilder_container_recipe.getImageBuilderContainerRecipe", "connection_error", err)
+		return nil, err
+	}
+
+	output, err := svc.GetContainerRecipe(ctx, &imagebuilder.GetContainerRecipeInput{
+		ContainerRecipeArn: aws.String(arn),
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_imagebuilder_container_recipe.getImageBuilderContainerRecipe", "api_error", err)
+		return nil, err
+	}
+
+	if output.ContainerRecipe != nil {
+		return *output.ContainerRecipe, nil
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,215 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/imagebuilder"
+	"github.com/aws/aws-sdk-go-v2/service/imagebuilder/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+func tableAwsImageBuilderDistributionConfiguration(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_imagebuilder_distribution_configuration",
+		Description: "AWS EC2 Image Builder Distribution Configuration",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("arn"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException", "InvalidParameterValueException"}),
+			},
+			Hydrate: getImageBuilderDistributionConfiguration,
+			Tags:    map[string]string{"service": "imagebuilder", "action": "GetDistributionConfiguration"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listImageBuilderDistributionConfigurations,
+			Tags:    map[string]string{"service": "imagebuilder", "action": "ListDistributionConfigurations"},
+		},
+		GetMatrixItemFunc: ImageBuilderRegionsMatrix,
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getImageBuilderDistributionConfiguration,
+				Tags: map[string]string{"service": "imagebuilder", "action": "GetDistributionConfiguration"},
+			},
+		},
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the distribution configuration.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the distribution configuration.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the distribution configuration.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "regions",
+				Description: "The Regions the images are distributed to.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getImageBuilderDistributionConfiguration,
+				Transform:   transform.From(imageBuilderDistributionRegions),
+			},
+			{
+				Name:        "timeout_minutes",
+				Description: "The maximum duration in minutes for the distribution.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getImageBuilderDistributionConfiguration,
+			},
+			{
+				Name:        "date_created",
+				Description: "The date on which the distribution configuration was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "date_updated",
+				Description: "The date on which the distribution configuration was last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "distributions",
+				Description: "The distribution settings per Region, such as the AMI name, target accounts, launch permissions and launch templates.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getImageBuilderDistributionConfiguration,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listImageBuilderDistributionConfigurations(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := ImageBuilderClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_imagebuilder_distribution_configuration.listImageBuilderDistributionConfigurations", "connection_error", err)
+		return nil, err
+	}
+
+	// Limiting the results
+	maxLimit := int32(25)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &imagebuilder.ListDistributionConfigurationsInput{
+		MaxResults: aws.Int32(maxLimit),
+	}
+
+	paginator := imagebuilder.NewListDistributionConfigurationsPaginator(svc, input, func(o *imagebuilder.ListDistributionConfigurationsPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	// List call
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_imagebuilder_distribution_configuration.listImageBuilderDistributionConfigurations", "api_error", err)
+			return nil, err
+		}
+
+		for _, config := range output.DistributionConfigurationSummaryList {
+			d.StreamListItem(ctx, config)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getImageBuilderDistributionConfiguration(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var arn string
+	switch item := h.Item.(type) {
+	case types.DistributionConfigurationSummary:
+		arn = aws.ToString(item.Arn)
+	case types.DistributionConfiguration:
+		return item, nil
+	default:
+		arn = d.EqualsQualString("arn")
+	}
+
+	// Empty check
+	if arn == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := ImageBuilderClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_imagebuilder_distribution_configuration.getImageBuilderDistributionConfiguration", "connection_error", err)
+		return nil, err
+	}
+
+	output, err := svc.GetDistributionConfiguration(ctx, &imagebuilder.GetDistributionConfigurationInput{
+		DistributionConfigurationArn: aws.String(arn),
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_imagebuilder_distribution_configuration.getImageBuilderDistributionConfiguration", "api_error", err)
+		return nil, err
+	}
+
+	if output.DistributionConfiguration != nil {
+		return *output.DistributionConfiguration, nil
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func imageBuilderDistributionRegions(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	config, ok := d.HydrateItem.(types.DistributionConfiguration)
+	if !ok {
+		return nil, nil
+	}
+	regions := []string{}
+	for _, distribution := range config.Distributions {
+		regions = append(regions, aws.ToString(distribution.Region))
+	}
+	return regions, nil
+}
@@ -0,0 +1,339 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/imagebuilder"
+	"github.com/aws/aws-sdk-go-v2/service/imagebuilder/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+func tableAwsImageBuilderImage(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_imagebuilder_image",
+		Description: "AWS EC2 Image Builder Image",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("arn"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException", "InvalidParameterValueException"}),
+			},
+			Hydrate: getImageBuilderImage,
+			Tags:    map[string]string{"service": "imagebuilder", "action": "GetImage"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listImageBuilderImages,
+			Tags:    map[string]string{"service": "imagebuilder", "action": "ListImageBuildVersions"},
+		},
+		GetMatrixItemFunc: ImageBuilderRegionsMatrix,
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getImageBuilderImage,
+				Tags: map[string]string{"service": "imagebuilder", "action": "GetImage"},
+			},
+		},
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the image.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the image build version.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "version",
+				Description: "The semantic version of the image.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type",
+				Description: "The type of the image, either AMI or DOCKER.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "build_type",
+				Description: "Indicates how the image was created, either USER_INITIATED, SCHEDULED or IMPORT.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "image_source",
+				Description: "The origin of the base image, e.g. CUSTOM, IMPORTED or AWS_MARKETPLACE.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "platform",
+				Description: "The platform of the image, either Windows, Linux or macOS.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "os_version",
+				Description: "The operating system version of the image.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "owner",
+				Description: "The owner of the image.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "The status of the image, e.g. BUILDING, TESTING, DISTRIBUTING, AVAILABLE, FAILED or DEPRECATED.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("State.Status"),
+			},
+			{
+				Name:        "state_reason",
+				Description: "The reason for the status of the image.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("State.Reason"),
+			},
+			{
+				Name:        "date_created",
+				Description: "The date on which the image was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "deprecation_time",
+				Description: "The time when the output AMI of the image is deprecated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "output_ami_ids",
+				Description: "The IDs of the AMIs created by the image, in all the Regions it was distributed to. Join with the image_id column of aws_ec2_ami.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("OutputResources.Amis").Transform(imageBuilderOutputAmiIds),
+			},
+			{
+				Name:        "output_resources",
+				Description: "The resources created by the image, i.e. the AMIs per Region and account, and the container images.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "image_recipe_arn",
+				Description: "The Amazon Resource Name (ARN) of the image recipe used to create the image.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getImageBuilderImage,
+				Transform:   transform.FromField("ImageRecipe.Arn"),
+			},
+			{
+				Name:        "container_recipe_arn",
+				Description: "The Amazon Resource Name (ARN) of the container recipe used to create the image.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getImageBuilderImage,
+				Transform:   transform.FromField("ContainerRecipe.Arn"),
+			},
+			{
+				Name:        "source_pipeline_arn",
+				Description: "The Amazon Resource Name (ARN) of the image pipeline that created the image.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getImageBuilderImage,
+			},
+			{
+				Name:        "source_pipeline_name",
+				Description: "The name of the image pipeline that created the image.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getImageBuilderImage,
+			},
+			{
+				Name:        "infrastructure_configuration_arn",
+				Description: "The Amazon Resource Name (ARN) of the infrastructure configuration used to create the image.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getImageBuilderImage,
+				Transform:   transform.FromField("InfrastructureConfiguration.Arn"),
+			},
+			{
+				Name:        "distribution_configuration_arn",
+				Description: "The Amazon Resource Name (ARN) of the distribution configuration used to distribute the image.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getImageBuilderImage,
+				Transform:   transform.FromField("DistributionConfiguration.Arn"),
+			},
+			{
+				Name:        "execution_role",
+				Description: "The name or ARN of the IAM role used to run the workflows of the image.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getImageBuilderImage,
+			},
+			{
+				Name:        "enhanced_image_metadata_enabled",
+				Description: "Indicates whether Image Builder collected additional information about the image, such as the operating system and installed packages.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getImageBuilderImage,
+			},
+			{
+				Name:        "scan_state",
+				Description: "The status of the vulnerability scan of the image.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getImageBuilderImage,
+				Transform:   transform.FromField("ScanState.Status"),
+			},
+			{
+				Name:        "image_scanning_configuration",
+				Description: "Contains settings for the vulnerability scans of the image.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getImageBuilderImage,
+			},
+			{
+				Name:        "image_tests_configuration",
+				Description: "The image tests configuration used to create the image.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getImageBuilderImage,
+			},
+			{
+				Name:        "workflows",
+				Description: "The workflows that ran to create the image.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getImageBuilderImage,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listImageBuilderImages(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := ImageBuilderClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_imagebuilder_image.listImageBuilderImages", "connection_error", err)
+		return nil, err
+	}
+
+	input := &imagebuilder.ListImagesInput{
+		IncludeDeprecated: aws.Bool(true),
+		MaxResults:        aws.Int32(25),
+		Owner:             types.OwnershipSelf,
+	}
+
+	paginator := imagebuilder.NewListImagesPaginator(svc, input, func(o *imagebuilder.ListImagesPaginatorOptions) {
+		o.Limit = 25
+		o.StopOnDuplicateToken = true
+	})
+
+	// List call
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_imagebuilder_image.listImageBuilderImages", "api_error", err)
+			return nil, err
+		}
+
+		// Each semantic version of an image can have several build versions
+		for _, version := range output.ImageVersionList {
+			buildPaginator := imagebuilder.NewListImageBuildVersionsPaginator(svc, &imagebuilder.ListImageBuildVersionsInput{
+				ImageVersionArn: version.Arn,
+				MaxResults:      aws.Int32(25),
+			}, func(o *imagebuilder.ListImageBuildVersionsPaginatorOptions) {
+				o.Limit = 25
+				o.StopOnDuplicateToken = true
+			})
+
+			for buildPaginator.HasMorePages() {
+				// apply rate limiting
+				d.WaitForListRateLimit(ctx)
+
+				buildOutput, err := buildPaginator.NextPage(ctx)
+				if err != nil {
+					plugin.Logger(ctx).Error("aws_imagebuilder_image.listImageBuilderImages", "api_error", err)
+					return nil, err
+				}
+
+				for _, image := range buildOutput.ImageSummaryList {
+					d.StreamListItem(ctx, image)
+
+					// Context can be cancelled due to manual cancellation or the limit has been hit
+					if d.RowsRemaining(ctx) == 0 {
+						return nil, nil
+					}
+				}
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getImageBuilderImage(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var arn string
+	switch item := h.Item.(type) {
+	case types.ImageSummary:
+		arn = aws.ToString(item.Arn)
+	case types.Image:
+		return item, nil
+	default:
+		arn = d.EqualsQualString("arn")
+	}
+
+	// Empty check
+	if arn == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := ImageBuilderClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_imagebuilder_image.getImageBuilderImage", "connection_error", err)
+		return nil, err
+	}
+
+	output, err := svc.GetImage(ctx, &imagebuilder.GetImageInput{
+		ImageBuildVersionArn: aws.String(arn),
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_imagebuilder_image.getImageBuilderImage", "api_error", err)
+		return nil, err
+	}
+
+	if output.Image != nil {
+		return *output.Image, nil
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func imageBuilderOutputAmiIds(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	amis, ok := d.Value.([]types.Ami)
+	if !ok {
+		return nil, nil
+	}
+	ids := []string{}
+	for _, ami := range amis {
+		if ami.Image != nil {
+			ids = append(ids, *ami.Image)
+		}
+	}
+	return ids, nil
+}
@@ -0,0 +1,257 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/imagebuilder"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+func tableAwsImageBuilderImagePipeline(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_imagebuilder_image_pipeline",
+		Description: "AWS EC2 Image Builder Image Pipeline",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("arn"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException", "InvalidParameterValueException"}),
+			},
+			Hydrate: getImageBuilderImagePipeline,
+			Tags:    map[string]string{"service": "imagebuilder", "action": "GetImagePipeline"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listImageBuilderImagePipelines,
+			Tags:    map[string]string{"service": "imagebuilder", "action": "ListImagePipelines"},
+		},
+		GetMatrixItemFunc: ImageBuilderRegionsMatrix,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the image pipeline.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the image pipeline.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the image pipeline.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "The status of the image pipeline, either ENABLED or DISABLED.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "platform",
+				Description: "The platform of the image pipeline, either Windows, Linux or macOS.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "image_recipe_arn",
+				Description: "The Amazon Resource Name (ARN) of the image recipe of the pipeline.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "container_recipe_arn",
+				Description: "The Amazon Resource Name (ARN) of the container recipe of the pipeline.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "infrastructure_configuration_arn",
+				Description: "The Amazon Resource Name (ARN) of the infrastructure configuration of the pipeline.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "distribution_configuration_arn",
+				Description: "The Amazon Resource Name (ARN) of the distribution configuration of the pipeline.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "execution_role",
+				Description: "The name or ARN of the IAM role that grants Image Builder access to perform workflow actions.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "enhanced_image_metadata_enabled",
+				Description: "Indicates whether Image Builder collects additional information about the image, such as the operating system and installed packages.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "last_run_status",
+				Description: "The status of the last image built by the pipeline.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "consecutive_failures",
+				Description: "The number of consecutive runs of the pipeline that failed.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "date_created",
+				Description: "The date on which the image pipeline was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "date_updated",
+				Description: "The date on which the image pipeline was last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "date_last_run",
+				Description: "The date on which the image pipeline last ran.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "date_next_run",
+				Description: "The date on which the image pipeline is scheduled to run next.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "schedule",
+				Description: "The schedule of the image pipeline.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "image_scanning_configuration",
+				Description: "Contains settings for vulnerability scans of the images built by the pipeline.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "image_tests_configuration",
+				Description: "The image tests configuration of the image pipeline.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "image_tags",
+				Description: "The tags applied to the images built by the pipeline.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "logging_configuration",
+				Description: "The logging configuration of the image pipeline.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "workflows",
+				Description: "The workflows that run for the image pipeline.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listImageBuilderImagePipelines(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := ImageBuilderClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_imagebuilder_image_pipeline.listImageBuilderImagePipelines", "connection_error", err)
+		return nil, err
+	}
+
+	// Limiting the results
+	maxLimit := int32(25)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &imagebuilder.ListImagePipelinesInput{
+		MaxResults: aws.Int32(maxLimit),
+	}
+
+	paginator := imagebuilder.NewListImagePipelinesPaginator(svc, input, func(o *imagebuilder.ListImagePipelinesPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	// List call
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_imagebuilder_image_pipeline.listImageBuilderImagePipelines", "api_error", err)
+			return nil, err
+		}
+
+		for _, pipeline := range output.ImagePipelineList {
+			d.StreamListItem(ctx, pipeline)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getImageBuilderImagePipeline(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	arn := d.EqualsQualString("arn")
+
+	// Empty check
+	if arn == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := ImageBuilderClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_imagebuilder_image_pipeline.getImageBuilderImagePipeline", "connection_error", err)
+		return nil, err
+	}
+
+	output, err := svc.GetImagePipeline(ctx, &imagebuilder.GetImagePipelineInput{
+		ImagePipelineArn: aws.String(arn),
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_imagebuilder_image_pipeline.getImageBuilderImagePipeline", "api_error", err)
+		return nil, err
+	}
+
+	if output.ImagePipeline != nil {
+		return *output.ImagePipeline, nil
+	}
+
+	return nil, nil
+}
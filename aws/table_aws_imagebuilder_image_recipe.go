@@ -0,0 +1,236 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/imagebuilder"
+	"github.com/aws/aws-sdk-go-v2/service/imagebuilder/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+func tableAwsImageBuilderImageRecipe(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_imagebuilder_image_recipe",
+		Description: "AWS EC2 Image Builder Image Recipe",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("arn"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException", "InvalidParameterValueException"}),
+			},
+			Hydrate: getImageBuilderImageRecipe,
+			Tags:    map[string]string{"service": "imagebuilder", "action": "GetImageRecipe"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listImageBuilderImageRecipes,
+			Tags:    map[string]string{"service": "imagebuilder", "action": "ListImageRecipes"},
+		},
+		GetMatrixItemFunc: ImageBuilderRegionsMatrix,
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getImageBuilderImageRecipe,
+				Tags: map[string]string{"service": "imagebuilder", "action": "GetImageRecipe"},
+			},
+		},
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the image recipe.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the image recipe.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "version",
+				Description: "The semantic version of the image recipe.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getImageBuilderImageRecipe,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the image recipe.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getImageBuilderImageRecipe,
+			},
+			{
+				Name:        "owner",
+				Description: "The owner of the image recipe.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "platform",
+				Description: "The platform of the image recipe, either Windows, Linux or macOS.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "parent_image",
+				Description: "The base image of the image recipe, either an AMI ID or an Image Builder image ARN.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type",
+				Description: "Specifies which type of image is created by the recipe, either AMI or DOCKER.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getImageBuilderImageRecipe,
+			},
+			{
+				Name:        "date_created",
+				Description: "The date on which the image recipe was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "working_directory",
+				Description: "The working directory to be used during build and test workflows.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getImageBuilderImageRecipe,
+			},
+			{
+				Name:        "components",
+				Description: "The components of the image recipe, with their parameters, in the order they are applied.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getImageBuilderImageRecipe,
+			},
+			{
+				Name:        "block_device_mappings",
+				Description: "The block device mappings to apply when creating images from the recipe.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getImageBuilderImageRecipe,
+			},
+			{
+				Name:        "additional_instance_configuration",
+				Description: "Additional configuration of the build instance, such as the Systems Manager agent settings and user data.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getImageBuilderImageRecipe,
+			},
+			{
+				Name:        "ami_tags",
+				Description: "The tags applied to the AMIs created from the recipe.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getImageBuilderImageRecipe,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listImageBuilderImageRecipes(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := ImageBuilderClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_imagebuilder_image_recipe.listImageBuilderImageRecipes", "connection_error", err)
+		return nil, err
+	}
+
+	// Limiting the results
+	maxLimit := int32(25)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &imagebuilder.ListImageRecipesInput{
+		MaxResults: aws.Int32(maxLimit),
+		Owner:      types.OwnershipSelf,
+	}
+
+	paginator := imagebuilder.NewListImageRecipesPaginator(svc, input, func(o *imagebuilder.ListImageRecipesPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	// List call
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_imagebuilder_image_recipe.listImageBuilderImageRecipes", "api_error", err)
+			return nil, err
+		}
+
+		for _, recipe := range output.ImageRecipeSummaryList {
+			d.StreamListItem(ctx, recipe)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getImageBuilderImageRecipe(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var arn string
+	switch item := h.Item.(type) {
+	case types.ImageRecipeSummary:
+		arn = aws.ToString(item.Arn)
+	case types.ImageRecipe:
+		return item, nil
+	default:
+		arn = d.EqualsQualString("arn")
+	}
+
+	// Empty check
+	if arn == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := ImageBuilderClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_imagebuilder_image_recipe.getImageBuilderImageRecipe", "connection_error", err)
+		return nil, err
+	}
+
+	output, err := svc.GetImageRecipe(ctx, &imagebuilder.GetImageRecipeInput{
+		ImageRecipeArn: aws.String(arn),
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_imagebuilder_image_recipe.getImageBuilderImageRecipe", "api_error", err)
+		return nil, err
+	}
+
+	if output.ImageRecipe != nil {
+		return *output.ImageRecipe, nil
+	}
+
+	return nil, nil
+}
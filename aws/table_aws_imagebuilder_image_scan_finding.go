@@ -0,0 +1,175 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/imagebuilder"
+	"github.com/aws/aws-sdk-go-v2/service/imagebuilder/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+func tableAwsImageBuilderImageScanFinding(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_imagebuilder_image_scan_finding",
+		Description: "AWS EC2 Image Builder Image Scan Finding",
+		List: &plugin.ListConfig{
+			Hydrate: listImageBuilderImageScanFindings,
+			Tags:    map[string]string{"service": "imagebuilder", "action": "ListImageScanFindings"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "image_build_version_arn", Require: plugin.Optional},
+				{Name: "image_pipeline_arn", Require: plugin.Optional},
+				{Name: "vulnerability_id", Require: plugin.Optional},
+				{Name: "severity", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: ImageBuilderRegionsMatrix,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "title",
+				Description: "The title of the finding.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "image_build_version_arn",
+				Description: "The Amazon Resource Name (ARN) of the image build version the finding is for.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "image_pipeline_arn",
+				Description: "The Amazon Resource Name (ARN) of the image pipeline that created the scanned image.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "vulnerability_id",
+				Description: "The ID of the vulnerability, e.g. a CVE ID.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("PackageVulnerabilityDetails.VulnerabilityId"),
+			},
+			{
+				Name:        "severity",
+				Description: "The severity of the finding, e.g. CRITICAL, HIGH, MEDIUM or LOW.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type",
+				Description: "The type of the finding, e.g. PACKAGE_VULNERABILITY.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the finding.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "fix_available",
+				Description: "Indicates whether a fix is available for the vulnerable packages, either YES, NO or PARTIAL.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "inspector_score",
+				Description: "The score that Amazon Inspector assigned to the finding.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "first_observed_at",
+				Description: "The date and time when the finding was first observed.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "updated_at",
+				Description: "The date and time when the finding was last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "inspector_score_details",
+				Description: "The details of the Amazon Inspector score, such as the adjusted CVSS score.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "package_vulnerability_details",
+				Description: "The details of the vulnerability, such as the vulnerable packages, CVSS scores and references.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "remediation",
+				Description: "The recommended remediation of the finding.",
+				Type:        proto.ColumnType_JSON,
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listImageBuilderImageScanFindings(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := ImageBuilderClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_imagebuilder_image_scan_finding.listImageBuilderImageScanFindings", "connection_error", err)
+		return nil, err
+	}
+
+	// Limiting the results
+	maxLimit := int32(25)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &imagebuilder.ListImageScanFindingsInput{
+		MaxResults: aws.Int32(maxLimit),
+	}
+
+	filterQuals := map[string]string{
+		"image_build_version_arn": "imageBuildVersionArn",
+		"image_pipeline_arn":      "imagePipelineArn",
+		"vulnerability_id":        "vulnerabilityId",
+		"severity":                "severity",
+	}
+	for columnName, filterName := range filterQuals {
+		if value := d.EqualsQualString(columnName); value != "" {
+			input.Filters = append(input.Filters, types.ImageScanFindingsFilter{
+				Name:   aws.String(filterName),
+				Values: []string{value},
+			})
+		}
+	}
+
+	paginator := imagebuilder.NewListImageScanFindingsPaginator(svc, input, func(o *imagebuilder.ListImageScanFindingsPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	// List call
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_imagebuilder_image_scan_finding.listImageBuilderImageScanFindings", "api_error", err)
+			return nil, err
+		}
+
+		for _, finding := range output.Findings {
+			d.StreamListItem(ctx, finding)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,251 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/imagebuilder"
+	"github.com/aws/aws-sdk-go-v2/service/imagebuilder/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+func tableAwsImageBuilderInfrastructureConfiguration(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_imagebuilder_infrastructure_configuration",
+		Description: "AWS EC2 Image Builder Infrastructure Configuration",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("arn"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException", "InvalidParameterValueException"}),
+			},
+			Hydrate: getImageBuilderInfrastructureConfiguration,
+			Tags:    map[string]string{"service": "imagebuilder", "action": "GetInfrastructureConfiguration"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listImageBuilderInfrastructureConfigurations,
+			Tags:    map[string]string{"service": "imagebuilder", "action": "ListInfrastructureConfigurations"},
+		},
+		GetMatrixItemFunc: ImageBuilderRegionsMatrix,
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getImageBuilderInfrastructureConfiguration,
+				Tags: map[string]string{"service": "imagebuilder", "action": "GetInfrastructureConfiguration"},
+			},
+		},
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the infrastructure configuration.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the infrastructure configuration.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the infrastructure configuration.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "instance_profile_name",
+				Description: "The instance profile of the build and test instances.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "instance_types",
+				Description: "The instance types of the build and test instances.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "subnet_id",
+				Description: "The subnet ID of the build and test instances.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getImageBuilderInfrastructureConfiguration,
+			},
+			{
+				Name:        "security_group_ids",
+				Description: "The security group IDs of the build and test instances.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getImageBuilderInfrastructureConfiguration,
+			},
+			{
+				Name:        "key_pair",
+				Description: "The EC2 key pair of the build and test instances.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getImageBuilderInfrastructureConfiguration,
+			},
+			{
+				Name:        "sns_topic_arn",
+				Description: "The Amazon Resource Name (ARN) of the SNS topic Image Builder publishes notifications to.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getImageBuilderInfrastructureConfiguration,
+			},
+			{
+				Name:        "terminate_instance_on_failure",
+				Description: "Indicates whether the build instance is terminated when the build fails.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getImageBuilderInfrastructureConfiguration,
+			},
+			{
+				Name:        "http_tokens",
+				Description: "Indicates whether a token is required to retrieve the instance metadata of the build and test instances, either required or optional.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getImageBuilderInfrastructureConfiguration,
+				Transform:   transform.FromField("InstanceMetadataOptions.HttpTokens"),
+			},
+			{
+				Name:        "date_created",
+				Description: "The date on which the infrastructure configuration was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "date_updated",
+				Description: "The date on which the infrastructure configuration was last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "instance_metadata_options",
+				Description: "The instance metadata options of the build and test instances.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getImageBuilderInfrastructureConfiguration,
+			},
+			{
+				Name:        "logging",
+				Description: "The logging configuration of the build and test instances.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getImageBuilderInfrastructureConfiguration,
+			},
+			{
+				Name:        "placement",
+				Description: "The placement of the build and test instances, such as the Availability Zone, tenancy or dedicated host.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "resource_tags",
+				Description: "The tags attached to the resources created by Image Builder during builds.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listImageBuilderInfrastructureConfigurations(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := ImageBuilderClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_imagebuilder_infrastructure_configuration.listImageBuilderInfrastructureConfigurations", "connection_error", err)
+		return nil, err
+	}
+
+	// Limiting the results
+	maxLimit := int32(25)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &imagebuilder.ListInfrastructureConfigurationsInput{
+		MaxResults: aws.Int32(maxLimit),
+	}
+
+	paginator := imagebuilder.NewListInfrastructureConfigurationsPaginator(svc, input, func(o *imagebuilder.ListInfrastructureConfigurationsPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	// List call
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_imagebuilder_infrastructure_configuration.listImageBuilderInfrastructureConfigurations", "api_error", err)
+			return nil, err
+		}
+
+		for _, config := range output.InfrastructureConfigurationSummaryList {
+			d.StreamListItem(ctx, config)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getImageBuilderInfrastructureConfiguration(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var arn string
+	switch item := h.Item.(type) {
+	case types.InfrastructureConfigurationSummary:
+		arn = aws.ToString(item.Arn)
+	case types.InfrastructureConfiguration:
+		return item, nil
+	default:
+		arn = d.EqualsQualString("arn")
+	}
+
+	// Empty check
+	if arn == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := ImageBuilderClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_imagebuilder_infrastructure_configuration.getImageBuilderInfrastructureConfiguration", "connection_error", err)
+		return nil, err
+	}
+
+	output, err := svc.GetInfrastructureConfiguration(ctx, &imagebuilder.GetInfrastructureConfigurationInput{
+		InfrastructureConfigurationArn: aws.String(arn),
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_imagebuilder_infrastructure_configuration.getImageBuilderInfrastructureConfiguration", "api_error", err)
+		return nil, err
+	}
+
+	if output.InfrastructureConfiguration != nil {
+		return *output.InfrastructureConfiguration, nil
+	}
+
+	return nil, nil
+}
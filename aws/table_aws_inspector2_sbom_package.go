@@ -0,0 +1,359 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/inspector2"
+	"github.com/aws/aws-sdk-go-v2/service/inspector2/types"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/memoize"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsInspector2SbomPackage(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_inspector2_sbom_package",
+		Description: "AWS Inspector2 SBOM Package",
+		List: &plugin.ListConfig{
+			Hydrate: listInspector2SbomPackages,
+			Tags:    map[string]string{"service": "inspector2", "action": "CreateSbomExport"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "resource_id", Require: plugin.Optional, Operators: []string{"=", "<>"}},
+				{Name: "resource_type", Require: plugin.Optional, Operators: []string{"=", "<>"}},
+				{Name: "resource_account_id", Require: plugin.Optional, Operators: []string{"=", "<>"}},
+				{Name: "report_format", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(AWS_INSPECTOR2_SERVICE_ID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the package.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "version",
+				Description: "The version of the package.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "purl",
+				Description: "The package URL (purl) of the package, e.g. pkg:npm/lodash@4.17.21.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "package_type",
+				Description: "The type of the package, taken from the package URL, e.g. npm, pypi, rpm or deb.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "licenses",
+				Description: "The licenses of the package.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "vulnerability_ids",
+				Description: "The IDs of the vulnerabilities that affect the package, e.g. CVE-2021-44228.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "resource_id",
+				Description: "The ID of the resource that contains the package, e.g. an EC2 instance ID, ECR image or Lambda function.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "resource_type",
+				Description: "The type of the resource. Can be one of AWS_EC2_INSTANCE, AWS_ECR_CONTAINER_IMAGE or AWS_LAMBDA_FUNCTION.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "resource_account_id",
+				Description: "The ID of the Amazon Web Services account that owns the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "report_id",
+				Description: "The ID of the SBOM export that the package was read from.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "report_format",
+				Description: "The format of the SBOM export. Can be CYCLONEDX_1_4 or SPDX_2_3. Defaults to CYCLONEDX_1_4.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "s3_key",
+				Description: "The S3 key of the SBOM file that the package was read from.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listInspector2SbomPackages(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	export, err := getInspector2SbomExport(ctx, d, h)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_inspector2_sbom_package.listInspector2SbomPackages", "export_error", err)
+		return nil, err
+	}
+	if export == nil {
+		return nil, nil
+	}
+
+	for _, item := range export.([]Inspector2SbomPackage) {
+		d.StreamListItem(ctx, item)
+
+		// Context may get cancelled due to manual cancellation or if the limit has been reached
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+// inspector2SbomExportTimeout is how long to wait for an SBOM export to complete
+const inspector2SbomExportTimeout = 5 * time.Minute
+
+// An SBOM export takes minutes to run, so the packages are cached per
+// connection, region and filter criteria
+var getInspector2SbomExportMemoized = plugin.HydrateFunc(getInspector2SbomExportUncached).Memoize(memoize.WithCacheKeyFunction(getInspector2SbomExportCacheKey), memoize.WithTtl(time.Hour))
+
+func getInspector2SbomExport(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	return getInspector2SbomExportMemoized(ctx, d, h)
+}
+
+func getInspector2SbomExportCacheKey(_ context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	region := d.EqualsQualString(matrixKeyRegion)
+	key := fmt.Sprintf("getInspector2SbomExport-%s-%s-%s", region, inspector2SbomReportFormat(d), inspector2SbomFilterKey(d.Quals))
+	return key, nil
+}
+
+func getInspector2SbomExportUncached(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	awsSpcConfig := GetConfig(d.Connection)
+	if awsSpcConfig.Inspector2SbomBucket == nil || awsSpcConfig.Inspector2SbomKmsKeyArn == nil {
+		return nil, errors.New("inspector2_sbom_bucket and inspector2_sbom_kms_key_arn must be set in the connection config to query aws_inspector2_sbom_package")
+	}
+
+	// Create session
+	svc, err := Inspector2Client(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_inspector2_sbom_package.getInspector2SbomExportUncached", "client_error", err)
+		return nil, err
+	}
+
+	// Unsupported region check
+	if svc == nil {
+		return nil, nil
+	}
+
+	reportFormat := inspector2SbomReportFormat(d)
+	input := &inspector2.CreateSbomExportInput{
+		ReportFormat:           reportFormat,
+		ResourceFilterCriteria: buildInspector2SbomResourceFilterCriteria(d.Quals),
+		S3Destination: &types.Destination{
+			BucketName: awsSpcConfig.Inspector2SbomBucket,
+			KmsKeyArn:  awsSpcConfig.Inspector2SbomKmsKeyArn,
+			KeyPrefix:  awsSpcConfig.Inspector2SbomKeyPrefix,
+		},
+	}
+
+	created, err := svc.CreateSbomExport(ctx, input)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_inspector2_sbom_package.getInspector2SbomExportUncached", "api_error", err)
+		return nil, err
+	}
+	reportId := aws.ToString(created.ReportId)
+
+	// Wait for the export to complete, but not for longer than the deadline
+	waitCtx, cancel := context.WithTimeout(ctx, inspector2SbomExportTimeout)
+	defer cancel()
+	var export *inspector2.GetSbomExportOutput
+	for {
+		export, err = svc.GetSbomExport(ctx, &inspector2.GetSbomExportInput{ReportId: created.ReportId})
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_inspector2_sbom_package.getInspector2SbomExportUncached", "api_error", err)
+			return nil, err
+		}
+		if export.Status != types.ExternalReportStatusInProgress {
+			break
+		}
+
+		select {
+		case <-waitCtx.Done():
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			return nil, fmt.Errorf("SBOM export %s did not complete within %s", reportId, inspector2SbomExportTimeout)
+		case <-time.After(5 * time.Second):
+		}
+	}
+
+	if export.Status != types.ExternalReportStatusSucceeded {
+		return nil, fmt.Errorf("SBOM export %s %s: %s %s", reportId, strings.ToLower(string(export.Status)), export.ErrorCode, aws.ToString(export.ErrorMessage))
+	}
+
+	// Read the SBOM files of the export from the bucket
+	bucket := aws.ToString(awsSpcConfig.Inspector2SbomBucket)
+	bucketRegion, err := doGetBucketRegion(ctx, d, h, bucket)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_inspector2_sbom_package.getInspector2SbomExportUncached", "get_bucket_region_error", err)
+		return nil, err
+	}
+	s3Svc, err := S3Client(ctx, d, bucketRegion)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_inspector2_sbom_package.getInspector2SbomExportUncached", "client_error", err)
+		return nil, err
+	}
+
+	paginator := s3.NewListObjectsV2Paginator(s3Svc, &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+		Prefix: aws.String(inspector2SbomReportKeyPrefix(aws.ToString(awsSpcConfig.Inspector2SbomKeyPrefix), reportId)),
+	}, func(o *s3.ListObjectsV2PaginatorOptions) {
+		o.StopOnDuplicateToken = true
+	})
+
+	packages := []Inspector2SbomPackage{}
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_inspector2_sbom_package.getInspector2SbomExportUncached", "api_error", err)
+			return nil, err
+		}
+
+		for _, object := range output.Contents {
+			key := aws.ToString(object.Key)
+			if !strings.HasSuffix(key, ".json") {
+				continue
+			}
+
+			content, err := readInspector2SbomObject(ctx, s3Svc, bucket, key)
+			if err != nil {
+				plugin.Logger(ctx).Error("aws_inspector2_sbom_package.getInspector2SbomExportUncached", "get_object_error", err)
+				return nil, err
+			}
+
+			items, err := parseInspector2Sbom(key, content)
+			if err != nil {
+				plugin.Logger(ctx).Error("aws_inspector2_sbom_package.getInspector2SbomExportUncached", "parse_error", err)
+				return nil, err
+			}
+			for _, item := range items {
+				item.ReportId = reportId
+				item.ReportFormat = string(reportFormat)
+				packages = append(packages, item)
+			}
+		}
+	}
+
+	return packages, nil
+}
+
+//// UTILITY FUNCTIONS
+
+// inspector2SbomReportKeyPrefix returns the key prefix the files of an export
+// are written under, which is the report ID below the configured key prefix.
+func inspector2SbomReportKeyPrefix(keyPrefix string, reportId string) string {
+	if keyPrefix = strings.TrimSuffix(keyPrefix, "/"); keyPrefix != "" {
+		keyPrefix += "/"
+	}
+	return keyPrefix + reportId + "/"
+}
+
+func readInspector2SbomObject(ctx context.Context, svc *s3.Client, bucket string, key string) ([]byte, error) {
+	object, err := svc.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer object.Body.Close()
+
+	return io.ReadAll(object.Body)
+}
+
+func inspector2SbomReportFormat(d *plugin.QueryData) types.SbomReportFormat {
+	if d.EqualsQualString("report_format") != "" {
+		return types.SbomReportFormat(d.EqualsQualString("report_format"))
+	}
+	return types.SbomReportFormatCyclonedx14
+}
+
+var inspector2SbomFilterColumns = []string{"resource_id", "resource_type", "resource_account_id"}
+
+// Build inspector2 SBOM export resource filter param
+func buildInspector2SbomResourceFilterCriteria(quals plugin.KeyColumnQualMap) *types.ResourceFilterCriteria {
+	criteria := &types.ResourceFilterCriteria{}
+	filters := map[string]*[]types.ResourceStringFilter{
+		"resource_id":         &criteria.ResourceId,
+		"resource_type":       &criteria.ResourceType,
+		"resource_account_id": &criteria.AccountId,
+	}
+
+	isFiltered := false
+	for _, columnName := range inspector2SbomFilterColumns {
+		if quals[columnName] == nil {
+			continue
+		}
+		for _, q := range quals[columnName].Quals {
+			comparison := types.ResourceStringComparisonEquals
+			if q.Operator == "<>" {
+				comparison = types.ResourceStringComparisonNotEquals
+			}
+			*filters[columnName] = append(*filters[columnName], types.ResourceStringFilter{
+				Comparison: comparison,
+				Value:      aws.String(q.Value.GetStringValue()),
+			})
+			isFiltered = true
+		}
+	}
+
+	if !isFiltered {
+		return nil
+	}
+	return criteria
+}
+
+// inspector2SbomFilterKey renders the filter quals as a stable string, so that
+// queries with the same filters share a cached export
+func inspector2SbomFilterKey(quals plugin.KeyColumnQualMap) string {
+	var parts []string
+	for _, columnName := range inspector2SbomFilterColumns {
+		if quals[columnName] == nil {
+			continue
+		}
+		for _, q := range quals[columnName].Quals {
+			parts = append(parts, columnName+q.Operator+q.Value.GetStringValue())
+		}
+	}
+	sort.Strings(parts)
+	return strings.Join(parts, ",")
+}
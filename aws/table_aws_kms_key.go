@@ -57,6 +57,14 @@ func tableAwsKmsKey(ctx context.Context) *plugin.Table {
 				Func: getAwsKmsKeyTagging,
 				Tags: map[string]string{"service": "kms", "action": "ListResourceTags"},
 			},
+			{
+				Func: getAwsKmsKeyGrants,
+				Tags: map[string]string{"service": "kms", "action": "ListGrants"},
+			},
+			{
+				Func:    getAwsKmsKeyDecryptAccess,
+				Depends: []plugin.HydrateFunc{getAwsKmsKeyPolicy, getAwsKmsKeyGrants},
+			},
 		},
 		Columns: awsRegionalColumns([]*plugin.Column{
 			{
@@ -195,6 +203,27 @@ func tableAwsKmsKey(ctx context.Context) *plugin.Table {
 				Hydrate:     getAwsKmsKeyData,
 				Transform:   transform.FromField("KeyMetadata.MultiRegionConfiguration"),
 			},
+			{
+				Name:        "decrypt_access",
+				Description: "The principals allowed to decrypt with the key by the key policy or by a grant, with the source of each permission and whether it is limited by conditions or grant constraints. Principals named in unconditional Deny statements are excluded.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getAwsKmsKeyDecryptAccess,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "decrypt_principals",
+				Description: "The unique principals allowed to decrypt with the key by the key policy or by a grant.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getAwsKmsKeyDecryptAccess,
+				Transform:   transform.FromValue().Transform(kmsKeyDecryptPrincipalsTransform),
+			},
+			{
+				Name:        "decrypt_accounts",
+				Description: "The unique AWS account IDs of the principals allowed to decrypt with the key by the key policy or by a grant. A wildcard principal is returned as *.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getAwsKmsKeyDecryptAccess,
+				Transform:   transform.FromValue().Transform(kmsKeyDecryptAccountsTransform),
+			},
 
 			/// Standard columns for all tables
 			{
@@ -470,6 +499,62 @@ func getAwsKmsKeyAliases(ctx context.Context, d *plugin.QueryData, h *plugin.Hyd
 	return keyData, nil
 }
 
+func getAwsKmsKeyGrants(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	key := h.Item.(types.KeyListEntry)
+
+	// Create Session
+	svc, err := KMSClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_kms_key.getAwsKmsKeyGrants", "connection_error", err)
+		return nil, err
+	}
+
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &kms.ListGrantsInput{
+		KeyId: key.KeyId,
+	}
+
+	grants := []types.GrantListEntry{}
+	paginator := kms.NewListGrantsPaginator(svc, params, func(o *kms.ListGrantsPaginatorOptions) {
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_kms_key.getAwsKmsKeyGrants", "api_error", err)
+			return nil, err
+		}
+		grants = append(grants, output.Grants...)
+	}
+
+	return grants, nil
+}
+
+func getAwsKmsKeyDecryptAccess(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var policy string
+	if output, ok := h.HydrateResults["getAwsKmsKeyPolicy"].(*kms.GetKeyPolicyOutput); ok && output.Policy != nil {
+		policy = *output.Policy
+	}
+
+	grants, _ := h.HydrateResults["getAwsKmsKeyGrants"].([]types.GrantListEntry)
+
+	access, err := kmsKeyDecryptAccess(policy, grants)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_kms_key.getAwsKmsKeyDecryptAccess", "policy_error", err)
+		return nil, err
+	}
+
+	return access, nil
+}
+
 func kmsKeyTitle(ctx context.Context, d *transform.TransformData) (interface{}, error) {
 	// Use the first alias if one is set, else fallback to the key ID
 	key := d.HydrateItem.([]types.AliasListEntry)
@@ -520,3 +605,19 @@ func getAwsKmsKeyPolicy(ctx context.Context, d *plugin.QueryData, h *plugin.Hydr
 	}
 	return keyPolicy, nil
 }
+
+func kmsKeyDecryptPrincipalsTransform(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	access, ok := d.Value.([]KmsKeyDecryptAccess)
+	if !ok {
+		return nil, nil
+	}
+	return kmsKeyDecryptPrincipals(access), nil
+}
+
+func kmsKeyDecryptAccountsTransform(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	access, ok := d.Value.([]KmsKeyDecryptAccess)
+	if !ok {
+		return nil, nil
+	}
+	return kmsKeyDecryptAccounts(access), nil
+}
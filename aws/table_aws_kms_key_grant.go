@@ -0,0 +1,318 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	"github.com/aws/aws-sdk-go-v2/service/kms/types"
+
+	"github.com/aws/smithy-go"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsKmsKeyGrant(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_kms_key_grant",
+		Description: "AWS KMS Key Grant",
+		List: &plugin.ListConfig{
+			Hydrate: listKmsKeyGrants,
+			Tags:    map[string]string{"service": "kms", "action": "ListGrants"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "key_id", Require: plugin.Optional},
+				{Name: "key_arn", Require: plugin.Optional},
+				{Name: "grant_id", Require: plugin.Optional},
+				{Name: "grantee_principal", Require: plugin.Optional},
+				{Name: "retiring_principal", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(AWS_KMS_SERVICE_ID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "grant_id",
+				Description: "The unique identifier for the grant.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Grant.GrantId"),
+			},
+			{
+				Name:        "name",
+				Description: "The friendly name that identifies the grant.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Grant.Name"),
+			},
+			{
+				Name:        "key_id",
+				Description: "The unique identifier of the KMS key to which the grant applies. As a qual, only the bare key ID is accepted, not a key ARN or alias.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "key_arn",
+				Description: "The ARN of the KMS key to which the grant applies.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "grantee_principal",
+				Description: "The identity that gets the permissions in the grant.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Grant.GranteePrincipal"),
+			},
+			{
+				Name:        "retiring_principal",
+				Description: "The principal that can retire the grant.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Grant.RetiringPrincipal"),
+			},
+			{
+				Name:        "issuing_account",
+				Description: "The Amazon Web Services account under which the grant was issued.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Grant.IssuingAccount"),
+			},
+			{
+				Name:        "creation_date",
+				Description: "The date and time when the grant was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Grant.CreationDate"),
+			},
+			{
+				Name:        "operations",
+				Description: "The list of operations permitted by the grant.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Grant.Operations"),
+			},
+			{
+				Name:        "constraints",
+				Description: "A list of key-value pairs that must be present in the encryption context of certain subsequent operations that the grant allows.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Grant.Constraints"),
+			},
+
+			// Standard columns for all tables
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(kmsKeyGrantTitle),
+			},
+		}),
+	}
+}
+
+type KmsKeyGrantInfo struct {
+	Grant  types.GrantListEntry
+	KeyId  string
+	KeyArn string
+}
+
+//// LIST FUNCTION
+
+func listKmsKeyGrants(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := KMSClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_kms_key_grant.listKmsKeyGrants", "connection_error", err)
+		return nil, err
+	}
+
+	if svc == nil {
+		// Unsupported region check
+		return nil, nil
+	}
+
+	// ListRetirableGrants returns the grants of every key in the Region that
+	// the principal can retire, so use it instead of listing each key's grants
+	if d.EqualsQualString("retiring_principal") != "" {
+		return nil, listKmsRetirableGrants(ctx, d, svc)
+	}
+
+	// Limit the grants to a single key if it is specified in the query. The
+	// key_id column only ever holds the bare key ID, so a key ARN or alias in
+	// the key_id qual cannot match any row.
+	keyId := d.EqualsQualString("key_id")
+	if keyId != "" && strings.ContainsAny(keyId, ":/") {
+		return nil, nil
+	}
+	if keyArn := d.EqualsQualString("key_arn"); keyArn != "" {
+		if !strings.HasPrefix(keyArn, "arn:") || (keyId != "" && kmsKeyIdFromArn(keyArn) != keyId) {
+			return nil, nil
+		}
+		keyId = keyArn
+	}
+	if keyId != "" {
+		_, err := listKmsGrantsForKey(ctx, d, svc, keyId)
+		return nil, err
+	}
+
+	paginator := kms.NewListKeysPaginator(svc, &kms.ListKeysInput{}, func(o *kms.ListKeysPaginatorOptions) {
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_kms_key_grant.listKmsKeyGrants", "list_keys_api_error", err)
+			return nil, err
+		}
+
+		for _, key := range output.Keys {
+			done, err := listKmsGrantsForKey(ctx, d, svc, *key.KeyId)
+			if err != nil || done {
+				return nil, err
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// listKmsGrantsForKey streams the grants of a key and reports whether the row
+// limit has been reached
+func listKmsGrantsForKey(ctx context.Context, d *plugin.QueryData, svc *kms.Client, keyId string) (bool, error) {
+	maxItems := int32(100)
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxItems {
+			if limit < 1 {
+				maxItems = int32(1)
+			} else {
+				maxItems = int32(limit)
+			}
+		}
+	}
+
+	input := &kms.ListGrantsInput{
+		KeyId: aws.String(keyId),
+		Limit: aws.Int32(maxItems),
+	}
+	if d.EqualsQualString("grant_id") != "" {
+		input.GrantId = aws.String(d.EqualsQualString("grant_id"))
+	}
+	if d.EqualsQualString("grantee_principal") != "" {
+		input.GranteePrincipal = aws.String(d.EqualsQualString("grantee_principal"))
+	}
+
+	paginator := kms.NewListGrantsPaginator(svc, input, func(o *kms.ListGrantsPaginatorOptions) {
+		o.Limit = maxItems
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			// The key may have been deleted since it was listed, or the key ID in the qual may not exist
+			var ae smithy.APIError
+			if errors.As(err, &ae) {
+				if ae.ErrorCode() == "NotFoundException" || ae.ErrorCode() == "InvalidArnException" {
+					return false, nil
+				}
+			}
+			plugin.Logger(ctx).Error("aws_kms_key_grant.listKmsGrantsForKey", "api_error", err)
+			return false, err
+		}
+
+		for _, grant := range output.Grants {
+			d.StreamListItem(ctx, kmsKeyGrantInfo(grant))
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
+
+func listKmsRetirableGrants(ctx context.Context, d *plugin.QueryData, svc *kms.Client) error {
+	maxItems := int32(100)
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxItems {
+			if limit < 1 {
+				maxItems = int32(1)
+			} else {
+				maxItems = int32(limit)
+			}
+		}
+	}
+
+	input := &kms.ListRetirableGrantsInput{
+		RetiringPrincipal: aws.String(d.EqualsQualString("retiring_principal")),
+		Limit:             aws.Int32(maxItems),
+	}
+
+	paginator := kms.NewListRetirableGrantsPaginator(svc, input, func(o *kms.ListRetirableGrantsPaginatorOptions) {
+		o.Limit = maxItems
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_kms_key_grant.listKmsRetirableGrants", "api_error", err)
+			return err
+		}
+
+		for _, grant := range output.Grants {
+			d.StreamListItem(ctx, kmsKeyGrantInfo(grant))
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil
+			}
+		}
+	}
+
+	return nil
+}
+
+// kmsKeyGrantInfo wraps a grant with the ID and ARN of its key. Grants identify
+// their key by ARN, so the key ID is taken from the end of the ARN.
+func kmsKeyGrantInfo(grant types.GrantListEntry) *KmsKeyGrantInfo {
+	keyArn := aws.ToString(grant.KeyId)
+
+	return &KmsKeyGrantInfo{
+		Grant:  grant,
+		KeyId:  kmsKeyIdFromArn(keyArn),
+		KeyArn: keyArn,
+	}
+}
+
+// kmsKeyIdFromArn returns the key ID at the end of a key ARN, e.g.
+// arn:aws:kms:us-east-1:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab
+func kmsKeyIdFromArn(keyArn string) string {
+	if strings.HasPrefix(keyArn, "arn:") {
+		return keyArn[strings.LastIndex(keyArn, "/")+1:]
+	}
+	return keyArn
+}
+
+//// TRANSFORM FUNCTIONS
+
+func kmsKeyGrantTitle(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	grant := d.HydrateItem.(*KmsKeyGrantInfo)
+	if aws.ToString(grant.Grant.Name) != "" {
+		return *grant.Grant.Name, nil
+	}
+	return grant.Grant.GrantId, nil
+}
@@ -0,0 +1,227 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+func tableAwsLambdaCodeSigningConfig(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_lambda_code_signing_config",
+		Description: "AWS Lambda Code Signing Config",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("arn"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"InvalidParameterValueException", "ResourceNotFoundException"}),
+			},
+			Hydrate: getLambdaCodeSigningConfig,
+			Tags:    map[string]string{"service": "lambda", "action": "GetCodeSigningConfig"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listLambdaCodeSigningConfigs,
+			Tags:    map[string]string{"service": "lambda", "action": "ListCodeSigningConfigs"},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(AWS_LAMBDA_SERVICE_ID),
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: listLambdaCodeSigningConfigFunctions,
+				Tags: map[string]string{"service": "lambda", "action": "ListFunctionsByCodeSigningConfig"},
+			},
+		},
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "code_signing_config_id",
+				Description: "Unique identifier for the code signing configuration.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the code signing configuration.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CodeSigningConfigArn"),
+			},
+			{
+				Name:        "description",
+				Description: "The code signing configuration description.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "last_modified",
+				Description: "The date and time that the code signing configuration was last modified.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "untrusted_artifact_on_deployment",
+				Description: "The action to take when a deployment fails the signature validation check, either Warn or Enforce.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CodeSigningPolicies.UntrustedArtifactOnDeployment"),
+			},
+			{
+				Name:        "allowed_publishers",
+				Description: "The signing profile version ARNs allowed to sign the code of the functions.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("AllowedPublishers.SigningProfileVersionArns"),
+			},
+			{
+				Name:        "function_arns",
+				Description: "The ARNs of the functions that use the code signing configuration.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listLambdaCodeSigningConfigFunctions,
+				Transform:   transform.FromValue(),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CodeSigningConfigId"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("CodeSigningConfigArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listLambdaCodeSigningConfigs(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := LambdaClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_lambda_code_signing_config.listLambdaCodeSigningConfigs", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxItems := int32(50)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxItems {
+			if limit < 1 {
+				maxItems = 1
+			} else {
+				maxItems = limit
+			}
+		}
+	}
+
+	input := &lambda.ListCodeSigningConfigsInput{
+		MaxItems: aws.Int32(maxItems),
+	}
+
+	paginator := lambda.NewListCodeSigningConfigsPaginator(svc, input, func(o *lambda.ListCodeSigningConfigsPaginatorOptions) {
+		o.Limit = maxItems
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_lambda_code_signing_config.listLambdaCodeSigningConfigs", "api_error", err)
+			return nil, err
+		}
+
+		for _, config := range output.CodeSigningConfigs {
+			d.StreamListItem(ctx, config)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getLambdaCodeSigningConfig(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	arn := d.EqualsQualString("arn")
+
+	// Empty check
+	if arn == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := LambdaClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_lambda_code_signing_config.getLambdaCodeSigningConfig", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region check
+		return nil, nil
+	}
+
+	output, err := svc.GetCodeSigningConfig(ctx, &lambda.GetCodeSigningConfigInput{
+		CodeSigningConfigArn: aws.String(arn),
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_lambda_code_signing_config.getLambdaCodeSigningConfig", "api_error", err)
+		return nil, err
+	}
+
+	if output.CodeSigningConfig != nil {
+		return *output.CodeSigningConfig, nil
+	}
+
+	return nil, nil
+}
+
+func listLambdaCodeSigningConfigFunctions(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	config := h.Item.(types.CodeSigningConfig)
+
+	// Create Session
+	svc, err := LambdaClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_lambda_code_signing_config.listLambdaCodeSigningConfigFunctions", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region check
+		return nil, nil
+	}
+
+	input := &lambda.ListFunctionsByCodeSigningConfigInput{
+		CodeSigningConfigArn: config.CodeSigningConfigArn,
+		MaxItems:             aws.Int32(10000),
+	}
+
+	paginator := lambda.NewListFunctionsByCodeSigningConfigPaginator(svc, input, func(o *lambda.ListFunctionsByCodeSigningConfigPaginatorOptions) {
+		o.Limit = 10000
+		o.StopOnDuplicateToken = true
+	})
+
+	functionArns := []string{}
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_lambda_code_signing_config.listLambdaCodeSigningConfigFunctions", "api_error", err)
+			return nil, err
+		}
+		functionArns = append(functionArns, output.FunctionArns...)
+	}
+
+	return functionArns, nil
+}
@@ -43,6 +43,18 @@ func tableAwsLambdaFunction(_ context.Context) *plugin.Table {
 				Func: getLambdaFunctionUrlConfig,
 				Tags: map[string]string{"service": "lambda", "action": "GetFunctionUrlConfig"},
 			},
+			{
+				Func: getLambdaFunctionRecursionConfig,
+				Tags: map[string]string{"service": "lambda", "action": "GetFunctionRecursionConfig"},
+			},
+			{
+				Func: getLambdaFunctionRuntimeManagementConfig,
+				Tags: map[string]string{"service": "lambda", "action": "GetRuntimeManagementConfig"},
+			},
+			{
+				Func: getLambdaFunctionCodeSigningConfig,
+				Tags: map[string]string{"service": "lambda", "action": "GetFunctionCodeSigningConfig"},
+			},
 		},
 		Columns: awsRegionalColumns([]*plugin.Column{
 			{
@@ -260,6 +272,34 @@ func tableAwsLambdaFunction(_ context.Context) *plugin.Table {
 				Hydrate:     getLambdaFunctionUrlConfig,
 				Transform:   transform.FromValue(),
 			},
+			{
+				Name:        "recursive_loop",
+				Description: "Whether Lambda terminates the function when it detects that it's being invoked as part of a recursive loop, either Terminate or Allow.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getLambdaFunctionRecursionConfig,
+				Transform:   transform.FromField("RecursiveLoop"),
+			},
+			{
+				Name:        "runtime_update_mode",
+				Description: "The runtime update mode of the function, either Auto, FunctionUpdate or Manual.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getLambdaFunctionRuntimeManagementConfig,
+				Transform:   transform.FromField("UpdateRuntimeOn"),
+			},
+			{
+				Name:        "runtime_version_arn",
+				Description: "The ARN of the runtime the function is configured to use. Only set when the runtime update mode is Manual.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getLambdaFunctionRuntimeManagementConfig,
+				Transform:   transform.FromField("RuntimeVersionArn"),
+			},
+			{
+				Name:        "code_signing_config_arn",
+				Description: "The Amazon Resource Name (ARN) of the code signing configuration of the function.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getLambdaFunctionCodeSigningConfig,
+				Transform:   transform.FromField("CodeSigningConfigArn"),
+			},
 			{
 				Name:        "logging_config",
 				Description: "The function's Amazon CloudWatch Logs configuration settings.",
@@ -490,6 +530,109 @@ func getLambdaFunctionUrlConfig(ctx context.Context, d *plugin.QueryData, h *plu
 	return urlConfigs, nil
 }
 
+func getLambdaFunctionRecursionConfig(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	functionName := functionName(h.Item)
+
+	// Create Session
+	svc, err := LambdaClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_lambda_function.getLambdaFunctionRecursionConfig", "connection_error", err)
+		return nil, err
+	}
+
+	if svc == nil {
+		// Unsupported region check
+		return nil, nil
+	}
+
+	input := &lambda.GetFunctionRecursionConfigInput{
+		FunctionName: aws.String(functionName),
+	}
+
+	recursionConfig, err := svc.GetFunctionRecursionConfig(ctx, input)
+	if err != nil {
+		var ae smithy.APIError
+		if errors.As(err, &ae) {
+			if ae.ErrorCode() == "ResourceNotFoundException" {
+				return nil, nil
+			}
+		}
+		plugin.Logger(ctx).Error("aws_lambda_function.getLambdaFunctionRecursionConfig", "api_error", err)
+		return nil, err
+	}
+
+	return recursionConfig, nil
+}
+
+func getLambdaFunctionRuntimeManagementConfig(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	functionName := functionName(h.Item)
+
+	// Create Session
+	svc, err := LambdaClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_lambda_function.getLambdaFunctionRuntimeManagementConfig", "connection_error", err)
+		return nil, err
+	}
+
+	if svc == nil {
+		// Unsupported region check
+		return nil, nil
+	}
+
+	input := &lambda.GetRuntimeManagementConfigInput{
+		FunctionName: aws.String(functionName),
+	}
+
+	runtimeConfig, err := svc.GetRuntimeManagementConfig(ctx, input)
+	if err != nil {
+		var ae smithy.APIError
+		if errors.As(err, &ae) {
+			// Functions deployed as container images have no runtime management configuration
+			if ae.ErrorCode() == "ResourceNotFoundException" || ae.ErrorCode() == "InvalidParameterValueException" {
+				return nil, nil
+			}
+		}
+		plugin.Logger(ctx).Error("aws_lambda_function.getLambdaFunctionRuntimeManagementConfig", "api_error", err)
+		return nil, err
+	}
+
+	return runtimeConfig, nil
+}
+
+func getLambdaFunctionCodeSigningConfig(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	functionName := functionName(h.Item)
+
+	// Create Session
+	svc, err := LambdaClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_lambda_function.getLambdaFunctionCodeSigningConfig", "connection_error", err)
+		return nil, err
+	}
+
+	if svc == nil {
+		// Unsupported region check
+		return nil, nil
+	}
+
+	input := &lambda.GetFunctionCodeSigningConfigInput{
+		FunctionName: aws.String(functionName),
+	}
+
+	codeSigningConfig, err := svc.GetFunctionCodeSigningConfig(ctx, input)
+	if err != nil {
+		var ae smithy.APIError
+		if errors.As(err, &ae) {
+			if ae.ErrorCode() == "ResourceNotFoundException" {
+				return nil, nil
+			}
+		}
+		plugin.Logger(ctx).Error("aws_lambda_function.getLambdaFunctionCodeSigningConfig", "api_error", err)
+		return nil, err
+	}
+
+	return codeSigningConfig, nil
+}
+
 func filterCodeLocation(_ context.Context, d *transform.TransformData) (interface{}, error) {
 	code, ok := d.Value.(*types.FunctionCodeLocation)
 	if !ok || code == nil {
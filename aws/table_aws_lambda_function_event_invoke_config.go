@@ -0,0 +1,172 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+type LambdaFunctionEventInvokeConfigInfo struct {
+	types.FunctionEventInvokeConfig
+	FunctionName *string
+	Qualifier    *string
+}
+
+func tableAwsLambdaFunctionEventInvokeConfig(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_lambda_function_event_invoke_config",
+		Description: "AWS Lambda Function Event Invoke Config",
+		List: &plugin.ListConfig{
+			ParentHydrate: listAwsLambdaFunctions,
+			Hydrate:       listLambdaFunctionEventInvokeConfigs,
+			Tags:          map[string]string{"service": "lambda", "action": "ListFunctionEventInvokeConfigs"},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException"}),
+			},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "function_name", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(AWS_LAMBDA_SERVICE_ID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "function_name",
+				Description: "The name of the function.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "qualifier",
+				Description: "The version number, alias name or $LATEST the configuration applies to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "function_arn",
+				Description: "The Amazon Resource Name (ARN) of the function, version or alias.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "last_modified",
+				Description: "The date and time that the configuration was last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "maximum_event_age_in_seconds",
+				Description: "The maximum age of a request that Lambda sends to a function for processing.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "maximum_retry_attempts",
+				Description: "The maximum number of times to retry when the function returns an error.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "on_success_destination",
+				Description: "The Amazon Resource Name (ARN) of the destination for invocations that succeed.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DestinationConfig.OnSuccess.Destination"),
+			},
+			{
+				Name:        "on_failure_destination",
+				Description: "The Amazon Resource Name (ARN) of the destination for invocations that fail or are discarded.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DestinationConfig.OnFailure.Destination"),
+			},
+			{
+				Name:        "destination_config",
+				Description: "The destinations for successful and failed asynchronous invocations.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("FunctionArn").Transform(lambdaQualifiedFunctionTitle),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("FunctionArn").Transform(arnToAkas),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listLambdaFunctionEventInvokeConfigs(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	function := h.Item.(types.FunctionConfiguration)
+
+	// Minimize the API call with the given function name
+	if name := d.EqualsQualString("function_name"); name != "" && name != aws.ToString(function.FunctionName) {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := LambdaClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_lambda_function_event_invoke_config.listLambdaFunctionEventInvokeConfigs", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxItems := int32(50)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxItems {
+			if limit < 1 {
+				maxItems = 1
+			} else {
+				maxItems = limit
+			}
+		}
+	}
+
+	input := &lambda.ListFunctionEventInvokeConfigsInput{
+		FunctionName: function.FunctionName,
+		MaxItems:     aws.Int32(maxItems),
+	}
+
+	paginator := lambda.NewListFunctionEventInvokeConfigsPaginator(svc, input, func(o *lambda.ListFunctionEventInvokeConfigsPaginatorOptions) {
+		o.Limit = maxItems
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_lambda_function_event_invoke_config.listLambdaFunctionEventInvokeConfigs", "api_error", err)
+			return nil, err
+		}
+
+		for _, config := range output.FunctionEventInvokeConfigs {
+			d.StreamListItem(ctx, &LambdaFunctionEventInvokeConfigInfo{
+				FunctionEventInvokeConfig: config,
+				FunctionName:              function.FunctionName,
+				Qualifier:                 aws.String(lambdaQualifierFromArn(aws.ToString(config.FunctionArn))),
+			})
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,260 @@
+package aws
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+type LambdaProvisionedConcurrencyConfigInfo struct {
+	types.ProvisionedConcurrencyConfigListItem
+	FunctionName *string
+	Qualifier    *string
+}
+
+func tableAwsLambdaProvisionedConcurrencyConfig(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_lambda_provisioned_concurrency_config",
+		Description: "AWS Lambda Provisioned Concurrency Config",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"function_name", "qualifier"}),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"InvalidParameterValueException", "ResourceNotFoundException", "ProvisionedConcurrencyConfigNotFoundException"}),
+			},
+			Hydrate: getLambdaProvisionedConcurrencyConfig,
+			Tags:    map[string]string{"service": "lambda", "action": "GetProvisionedConcurrencyConfig"},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listAwsLambdaFunctions,
+			Hydrate:       listLambdaProvisionedConcurrencyConfigs,
+			Tags:          map[string]string{"service": "lambda", "action": "ListProvisionedConcurrencyConfigs"},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException"}),
+			},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "function_name", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(AWS_LAMBDA_SERVICE_ID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "function_name",
+				Description: "The name of the function.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "qualifier",
+				Description: "The version number or alias name the configuration applies to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "function_arn",
+				Description: "The Amazon Resource Name (ARN) of the alias or version.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "The status of the allocation process, either IN_PROGRESS, READY or FAILED.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status_reason",
+				Description: "For failed allocations, the reason that provisioned concurrency could not be allocated.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "requested_provisioned_concurrent_executions",
+				Description: "The amount of provisioned concurrency requested.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "allocated_provisioned_concurrent_executions",
+				Description: "The amount of provisioned concurrency allocated.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "available_provisioned_concurrent_executions",
+				Description: "The amount of provisioned concurrency available.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "last_modified",
+				Description: "The date and time that a user last updated the configuration.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("FunctionArn").Transform(lambdaQualifiedFunctionTitle),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("FunctionArn").Transform(arnToAkas),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listLambdaProvisionedConcurrencyConfigs(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	function := h.Item.(types.FunctionConfiguration)
+
+	// Minimize the API call with the given function name
+	if name := d.EqualsQualString("function_name"); name != "" && name != aws.ToString(function.FunctionName) {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := LambdaClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_lambda_provisioned_concurrency_config.listLambdaProvisionedConcurrencyConfigs", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxItems := int32(50)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxItems {
+			if limit < 1 {
+				maxItems = 1
+			} else {
+				maxItems = limit
+			}
+		}
+	}
+
+	input := &lambda.ListProvisionedConcurrencyConfigsInput{
+		FunctionName: function.FunctionName,
+		MaxItems:     aws.Int32(maxItems),
+	}
+
+	paginator := lambda.NewListProvisionedConcurrencyConfigsPaginator(svc, input, func(o *lambda.ListProvisionedConcurrencyConfigsPaginatorOptions) {
+		o.Limit = maxItems
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_lambda_provisioned_concurrency_config.listLambdaProvisionedConcurrencyConfigs", "api_error", err)
+			return nil, err
+		}
+
+		for _, config := range output.ProvisionedConcurrencyConfigs {
+			d.StreamListItem(ctx, &LambdaProvisionedConcurrencyConfigInfo{
+				ProvisionedConcurrencyConfigListItem: config,
+				FunctionName:                         function.FunctionName,
+				Qualifier:                            aws.String(lambdaQualifierFromArn(aws.ToString(config.FunctionArn))),
+			})
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getLambdaProvisionedConcurrencyConfig(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	functionName := d.EqualsQualString("function_name")
+	qualifier := d.EqualsQualString("qualifier")
+
+	// Empty check
+	if functionName == "" || qualifier == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := LambdaClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_lambda_provisioned_concurrency_config.getLambdaProvisionedConcurrencyConfig", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region check
+		return nil, nil
+	}
+
+	output, err := svc.GetProvisionedConcurrencyConfig(ctx, &lambda.GetProvisionedConcurrencyConfigInput{
+		FunctionName: aws.String(functionName),
+		Qualifier:    aws.String(qualifier),
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_lambda_provisioned_concurrency_config.getLambdaProvisionedConcurrencyConfig", "api_error", err)
+		return nil, err
+	}
+
+	// The output does not include the ARN, so build it from the function name
+	commonData, err := getCommonColumns(ctx, d, nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_lambda_provisioned_concurrency_config.getLambdaProvisionedConcurrencyConfig", "common_data_error", err)
+		return nil, err
+	}
+	commonColumnData := commonData.(*awsCommonColumnData)
+	functionArn := "arn:" + commonColumnData.Partition + ":lambda:" + commonColumnData.Region + ":" + commonColumnData.AccountId + ":function:" + functionName + ":" + qualifier
+
+	return &LambdaProvisionedConcurrencyConfigInfo{
+		ProvisionedConcurrencyConfigListItem: types.ProvisionedConcurrencyConfigListItem{
+			AllocatedProvisionedConcurrentExecutions: output.AllocatedProvisionedConcurrentExecutions,
+			AvailableProvisionedConcurrentExecutions: output.AvailableProvisionedConcurrentExecutions,
+			FunctionArn:                              aws.String(functionArn),
+			LastModified:                             output.LastModified,
+			RequestedProvisionedConcurrentExecutions: output.RequestedProvisionedConcurrentExecutions,
+			Status:                                   output.Status,
+			StatusReason:                             output.StatusReason,
+		},
+		FunctionName: aws.String(functionName),
+		Qualifier:    aws.String(qualifier),
+	}, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+// lambdaQualifierFromArn returns the version or alias of a qualified function
+// ARN, or $LATEST for an unqualified one
+func lambdaQualifierFromArn(arn string) string {
+	parts := strings.Split(arn, ":")
+	if len(parts) > 7 && parts[7] != "" {
+		return parts[7]
+	}
+	return "$LATEST"
+}
+
+// lambdaQualifiedFunctionTitle returns the function name and qualifier of a
+// function ARN, e.g. my-function:live
+func lambdaQualifiedFunctionTitle(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	arn, ok := d.Value.(*string)
+	if !ok || arn == nil {
+		return nil, nil
+	}
+	parts := strings.Split(*arn, ":")
+	if len(parts) < 7 {
+		return *arn, nil
+	}
+	return parts[6] + ":" + lambdaQualifierFromArn(*arn), nil
+}
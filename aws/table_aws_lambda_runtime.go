@@ -0,0 +1,172 @@
+package aws
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+var lambdaRuntimeCatalog LambdaRuntimeCatalog
+
+//// TABLE DEFINITION
+
+func tableAwsLambdaRuntime(_ context.Context) *plugin.Table {
+	lambdaRuntimeCatalog = getLambdaRuntimeCatalog()
+
+	return &plugin.Table{
+		Name:        "aws_lambda_runtime",
+		Description: "AWS Lambda Runtime",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("runtime"),
+			Hydrate:    getLambdaRuntime,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listLambdaRuntimes,
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "runtime",
+				Description: "The runtime identifier, as used by the runtime column of aws_lambda_function, e.g. python3.12.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "name",
+				Description: "The name of the runtime, e.g. Python 3.12.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "operating_system",
+				Description: "The operating system the runtime is based on.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "deprecation_date",
+				Description: "The date the runtime is deprecated, after which it no longer receives security patches or updates.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "block_function_create_date",
+				Description: "The date from which new functions can no longer be created with the runtime.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "block_function_update_date",
+				Description: "The date from which existing functions using the runtime can no longer be updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "deprecated",
+				Description: "True if the deprecation date of the runtime has passed.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.From(lambdaRuntimeDeprecated),
+			},
+			{
+				Name:        "days_until_deprecation",
+				Description: "The number of days until the runtime is deprecated, negative once it has been deprecated.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.From(lambdaRuntimeDaysUntilDeprecation),
+			},
+			{
+				Name:        "catalog_version",
+				Description: "The date the runtime catalog embedded in the plugin was generated.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "catalog_source",
+				Description: "The documentation page the runtime catalog was generated from.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+		},
+	}
+}
+
+type awsLambdaRuntimeData struct {
+	Runtime                 string
+	Name                    string
+	OperatingSystem         string
+	DeprecationDate         *time.Time
+	BlockFunctionCreateDate *time.Time
+	BlockFunctionUpdateDate *time.Time
+	CatalogVersion          string
+	CatalogSource           string
+}
+
+//// LIST FUNCTION
+
+func listLambdaRuntimes(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	for _, runtime := range lambdaRuntimeCatalog.Runtimes {
+		d.StreamListItem(ctx, newLambdaRuntimeData(runtime))
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getLambdaRuntime(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	runtime := d.EqualsQualString("runtime")
+
+	for _, r := range lambdaRuntimeCatalog.Runtimes {
+		if r.Runtime == runtime {
+			return newLambdaRuntimeData(r), nil
+		}
+	}
+	return nil, nil
+}
+
+func newLambdaRuntimeData(runtime LambdaRuntime) awsLambdaRuntimeData {
+	return awsLambdaRuntimeData{
+		Runtime:                 runtime.Runtime,
+		Name:                    runtime.Name,
+		OperatingSystem:         runtime.OperatingSystem,
+		DeprecationDate:         parseLambdaRuntimeDate(runtime.DeprecationDate),
+		BlockFunctionCreateDate: parseLambdaRuntimeDate(runtime.BlockFunctionCreateDate),
+		BlockFunctionUpdateDate: parseLambdaRuntimeDate(runtime.BlockFunctionUpdateDate),
+		CatalogVersion:          lambdaRuntimeCatalog.Version,
+		CatalogSource:           lambdaRuntimeCatalog.Source,
+	}
+}
+
+// parseLambdaRuntimeDate parses a catalog date, which is empty when no date
+// is scheduled
+func parseLambdaRuntimeDate(date string) *time.Time {
+	t, err := time.Parse("2006-01-02", date)
+	if err != nil {
+		return nil
+	}
+	return &t
+}
+
+//// TRANSFORM FUNCTIONS
+
+func lambdaRuntimeDeprecated(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	runtime := d.HydrateItem.(awsLambdaRuntimeData)
+	if runtime.DeprecationDate == nil {
+		return false, nil
+	}
+	return !time.Now().Before(*runtime.DeprecationDate), nil
+}
+
+func lambdaRuntimeDaysUntilDeprecation(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	runtime := d.HydrateItem.(awsLambdaRuntimeData)
+	if runtime.DeprecationDate == nil {
+		return nil, nil
+	}
+	return int(math.Ceil(time.Until(*runtime.DeprecationDate).Hours() / 24)), nil
+}
@@ -0,0 +1,279 @@
+package aws
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/networkmanager"
+	"github.com/aws/aws-sdk-go-v2/service/networkmanager/types"
+	"github.com/aws/smithy-go"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsNetworkManagerCoreNetwork(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_networkmanager_core_network",
+		Description: "AWS Network Manager Core Network",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("core_network_id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException", "ValidationException"}),
+			},
+			Hydrate: getNetworkManagerCoreNetwork,
+			Tags:    map[string]string{"service": "networkmanager", "action": "GetCoreNetwork"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listNetworkManagerCoreNetworks,
+			Tags:    map[string]string{"service": "networkmanager", "action": "ListCoreNetworks"},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getNetworkManagerCoreNetwork,
+				Tags: map[string]string{"service": "networkmanager", "action": "GetCoreNetwork"},
+			},
+			{
+				Func: getNetworkManagerCoreNetworkPolicy,
+				Tags: map[string]string{"service": "networkmanager", "action": "GetCoreNetworkPolicy"},
+			},
+		},
+		Columns: awsGlobalRegionColumns([]*plugin.Column{
+			{
+				Name:        "core_network_id",
+				Description: "The ID of the core network.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the core network.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CoreNetworkArn"),
+			},
+			{
+				Name:        "global_network_id",
+				Description: "The ID of the global network that the core network is a part of.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the core network.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "The current state of the core network.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "owner_account_id",
+				Description: "The ID of the account that owns the core network.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "created_at",
+				Description: "The timestamp when the core network was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Hydrate:     getNetworkManagerCoreNetwork,
+			},
+			{
+				Name:        "edges",
+				Description: "The edges within the core network, one for each Region the core network operates in.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getNetworkManagerCoreNetwork,
+			},
+			{
+				Name:        "segments",
+				Description: "The segments within the core network.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getNetworkManagerCoreNetwork,
+			},
+			{
+				Name:        "network_function_groups",
+				Description: "The network function groups associated with the core network.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getNetworkManagerCoreNetwork,
+			},
+			{
+				Name:        "policy_version_id",
+				Description: "The ID of the live policy version of the core network.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getNetworkManagerCoreNetworkPolicy,
+			},
+			{
+				Name:        "policy_change_set_state",
+				Description: "The change set state of the live policy version of the core network.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getNetworkManagerCoreNetworkPolicy,
+				Transform:   transform.FromField("ChangeSetState"),
+			},
+			{
+				Name:        "policy_document",
+				Description: "The live policy document of the core network.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getNetworkManagerCoreNetworkPolicy,
+				Transform:   transform.FromField("PolicyDocument").Transform(transform.UnmarshalYAML),
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags assigned to the core network.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CoreNetworkId"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags").Transform(networkManagerTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("CoreNetworkArn").Transform(arnToAkas),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listNetworkManagerCoreNetworks(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+
+	// Create session
+	svc, err := NetworkManagerClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_networkmanager_core_network.listNetworkManagerCoreNetworks", "client_error", err)
+		return nil, err
+	}
+
+	maxItems := int32(500)
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxItems {
+			if limit < 1 {
+				maxItems = int32(1)
+			} else {
+				maxItems = int32(limit)
+			}
+		}
+	}
+
+	input := &networkmanager.ListCoreNetworksInput{
+		MaxResults: aws.Int32(maxItems),
+	}
+
+	paginator := networkmanager.NewListCoreNetworksPaginator(svc, input, func(o *networkmanager.ListCoreNetworksPaginatorOptions) {
+		o.Limit = maxItems
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_networkmanager_core_network.listNetworkManagerCoreNetworks", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range output.CoreNetworks {
+			d.StreamListItem(ctx, item)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getNetworkManagerCoreNetwork(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var coreNetworkId string
+	if h.Item != nil {
+		if item, ok := h.Item.(types.CoreNetworkSummary); ok {
+			coreNetworkId = *item.CoreNetworkId
+		}
+	} else {
+		coreNetworkId = d.EqualsQualString("core_network_id")
+	}
+
+	if coreNetworkId == "" {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := NetworkManagerClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_networkmanager_core_network.getNetworkManagerCoreNetwork", "client_error", err)
+		return nil, err
+	}
+
+	params := &networkmanager.GetCoreNetworkInput{
+		CoreNetworkId: aws.String(coreNetworkId),
+	}
+
+	op, err := svc.GetCoreNetwork(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_networkmanager_core_network.getNetworkManagerCoreNetwork", "api_error", err)
+		return nil, err
+	}
+
+	return op.CoreNetwork, nil
+}
+
+func getNetworkManagerCoreNetworkPolicy(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var coreNetworkId string
+	switch item := h.Item.(type) {
+	case types.CoreNetworkSummary:
+		coreNetworkId = *item.CoreNetworkId
+	case *types.CoreNetwork:
+		coreNetworkId = *item.CoreNetworkId
+	}
+
+	// Create session
+	svc, err := NetworkManagerClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_networkmanager_core_network.getNetworkManagerCoreNetworkPolicy", "client_error", err)
+		return nil, err
+	}
+
+	params := &networkmanager.GetCoreNetworkPolicyInput{
+		CoreNetworkId: aws.String(coreNetworkId),
+		Alias:         types.CoreNetworkPolicyAliasLive,
+	}
+
+	op, err := svc.GetCoreNetworkPolicy(ctx, params)
+	if err != nil {
+		// A core network has no live policy until the first policy version has been executed
+		var ae smithy.APIError
+		if errors.As(err, &ae) {
+			if ae.ErrorCode() == "ResourceNotFoundException" {
+				return nil, nil
+			}
+		}
+		plugin.Logger(ctx).Error("aws_networkmanager_core_network.getNetworkManagerCoreNetworkPolicy", "api_error", err)
+		return nil, err
+	}
+
+	return op.CoreNetworkPolicy, nil
+}
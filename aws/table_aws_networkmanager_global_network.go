@@ -0,0 +1,192 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/networkmanager"
+	"github.com/aws/aws-sdk-go-v2/service/networkmanager/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsNetworkManagerGlobalNetwork(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_networkmanager_global_network",
+		Description: "AWS Network Manager Global Network",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("global_network_id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException", "ValidationException"}),
+			},
+			Hydrate: getNetworkManagerGlobalNetwork,
+			Tags:    map[string]string{"service": "networkmanager", "action": "DescribeGlobalNetworks"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listNetworkManagerGlobalNetworks,
+			Tags:    map[string]string{"service": "networkmanager", "action": "DescribeGlobalNetworks"},
+		},
+		Columns: awsGlobalRegionColumns([]*plugin.Column{
+			{
+				Name:        "global_network_id",
+				Description: "The ID of the global network.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the global network.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("GlobalNetworkArn"),
+			},
+			{
+				Name:        "description",
+				Description: "The description of the global network.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "The state of the global network.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "created_at",
+				Description: "The date and time that the global network was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags assigned to the global network.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("GlobalNetworkId"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags").Transform(networkManagerTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("GlobalNetworkArn").Transform(arnToAkas),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listNetworkManagerGlobalNetworks(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+
+	// Create session
+	svc, err := NetworkManagerClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_networkmanager_global_network.listNetworkManagerGlobalNetworks", "client_error", err)
+		return nil, err
+	}
+
+	maxItems := int32(500)
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxItems {
+			if limit < 1 {
+				maxItems = int32(1)
+			} else {
+				maxItems = int32(limit)
+			}
+		}
+	}
+
+	input := &networkmanager.DescribeGlobalNetworksInput{
+		MaxResults: aws.Int32(maxItems),
+	}
+
+	paginator := networkmanager.NewDescribeGlobalNetworksPaginator(svc, input, func(o *networkmanager.DescribeGlobalNetworksPaginatorOptions) {
+		o.Limit = maxItems
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_networkmanager_global_network.listNetworkManagerGlobalNetworks", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range output.GlobalNetworks {
+			d.StreamListItem(ctx, item)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getNetworkManagerGlobalNetwork(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	globalNetworkId := d.EqualsQualString("global_network_id")
+	if globalNetworkId == "" {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := NetworkManagerClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_networkmanager_global_network.getNetworkManagerGlobalNetwork", "client_error", err)
+		return nil, err
+	}
+
+	params := &networkmanager.DescribeGlobalNetworksInput{
+		GlobalNetworkIds: []string{globalNetworkId},
+	}
+
+	op, err := svc.DescribeGlobalNetworks(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_networkmanager_global_network.getNetworkManagerGlobalNetwork", "api_error", err)
+		return nil, err
+	}
+
+	if len(op.GlobalNetworks) > 0 {
+		return op.GlobalNetworks[0], nil
+	}
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func networkManagerTurbotTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	tags, ok := d.Value.([]types.Tag)
+	if !ok || len(tags) == 0 {
+		return nil, nil
+	}
+
+	turbotTagsMap := map[string]string{}
+	for _, i := range tags {
+		turbotTagsMap[*i.Key] = aws.ToString(i.Value)
+	}
+
+	return turbotTagsMap, nil
+}
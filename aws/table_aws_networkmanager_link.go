@@ -0,0 +1,236 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/networkmanager"
+	"github.com/aws/aws-sdk-go-v2/service/networkmanager/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsNetworkManagerLink(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_networkmanager_link",
+		Description: "AWS Network Manager Link",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"global_network_id", "link_id"}),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException", "ValidationException"}),
+			},
+			Hydrate: getNetworkManagerLink,
+			Tags:    map[string]string{"service": "networkmanager", "action": "GetLinks"},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listNetworkManagerGlobalNetworks,
+			Hydrate:       listNetworkManagerLinks,
+			Tags:          map[string]string{"service": "networkmanager", "action": "GetLinks"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "global_network_id", Require: plugin.Optional},
+				{Name: "site_id", Require: plugin.Optional},
+				{Name: "provider", Require: plugin.Optional},
+				{Name: "type", Require: plugin.Optional},
+			},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException"}),
+			},
+		},
+		Columns: awsGlobalRegionColumns([]*plugin.Column{
+			{
+				Name:        "link_id",
+				Description: "The ID of the link.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the link.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LinkArn"),
+			},
+			{
+				Name:        "global_network_id",
+				Description: "The ID of the global network.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "site_id",
+				Description: "The ID of the site.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the link.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "provider",
+				Description: "The provider of the link.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type",
+				Description: "The type of the link.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "The state of the link.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "created_at",
+				Description: "The date and time that the link was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "download_speed",
+				Description: "The download speed of the link, in Mbps.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Bandwidth.DownloadSpeed"),
+			},
+			{
+				Name:        "upload_speed",
+				Description: "The upload speed of the link, in Mbps.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Bandwidth.UploadSpeed"),
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags assigned to the link.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LinkId"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags").Transform(networkManagerTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("LinkArn").Transform(arnToAkas),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listNetworkManagerLinks(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	globalNetwork := h.Item.(types.GlobalNetwork)
+
+	// Minimize the API calls if the global network ID is specified in the query
+	if d.EqualsQualString("global_network_id") != "" && d.EqualsQualString("global_network_id") != *globalNetwork.GlobalNetworkId {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := NetworkManagerClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_networkmanager_link.listNetworkManagerLinks", "client_error", err)
+		return nil, err
+	}
+
+	maxItems := int32(500)
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxItems {
+			if limit < 1 {
+				maxItems = int32(1)
+			} else {
+				maxItems = int32(limit)
+			}
+		}
+	}
+
+	input := &networkmanager.GetLinksInput{
+		GlobalNetworkId: globalNetwork.GlobalNetworkId,
+		MaxResults:      aws.Int32(maxItems),
+	}
+	if d.EqualsQualString("site_id") != "" {
+		input.SiteId = aws.String(d.EqualsQualString("site_id"))
+	}
+	if d.EqualsQualString("provider") != "" {
+		input.Provider = aws.String(d.EqualsQualString("provider"))
+	}
+	if d.EqualsQualString("type") != "" {
+		input.Type = aws.String(d.EqualsQualString("type"))
+	}
+
+	paginator := networkmanager.NewGetLinksPaginator(svc, input, func(o *networkmanager.GetLinksPaginatorOptions) {
+		o.Limit = maxItems
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_networkmanager_link.listNetworkManagerLinks", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range output.Links {
+			d.StreamListItem(ctx, item)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getNetworkManagerLink(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	globalNetworkId := d.EqualsQualString("global_network_id")
+	linkId := d.EqualsQualString("link_id")
+	if globalNetworkId == "" || linkId == "" {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := NetworkManagerClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_networkmanager_link.getNetworkManagerLink", "client_error", err)
+		return nil, err
+	}
+
+	params := &networkmanager.GetLinksInput{
+		GlobalNetworkId: aws.String(globalNetworkId),
+		LinkIds:         []string{linkId},
+	}
+
+	op, err := svc.GetLinks(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_networkmanager_link.getNetworkManagerLink", "api_error", err)
+		return nil, err
+	}
+
+	if len(op.Links) > 0 {
+		return op.Links[0], nil
+	}
+	return nil, nil
+}
@@ -0,0 +1,215 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/networkmanager"
+	"github.com/aws/aws-sdk-go-v2/service/networkmanager/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsNetworkManagerSite(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_networkmanager_site",
+		Description: "AWS Network Manager Site",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"global_network_id", "site_id"}),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException", "ValidationException"}),
+			},
+			Hydrate: getNetworkManagerSite,
+			Tags:    map[string]string{"service": "networkmanager", "action": "GetSites"},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listNetworkManagerGlobalNetworks,
+			Hydrate:       listNetworkManagerSites,
+			Tags:          map[string]string{"service": "networkmanager", "action": "GetSites"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "global_network_id", Require: plugin.Optional},
+			},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException"}),
+			},
+		},
+		Columns: awsGlobalRegionColumns([]*plugin.Column{
+			{
+				Name:        "site_id",
+				Description: "The ID of the site.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the site.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SiteArn"),
+			},
+			{
+				Name:        "global_network_id",
+				Description: "The ID of the global network.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the site.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "The state of the site.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "created_at",
+				Description: "The date and time that the site was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "address",
+				Description: "The physical address of the site.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location.Address"),
+			},
+			{
+				Name:        "latitude",
+				Description: "The latitude of the site.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location.Latitude"),
+			},
+			{
+				Name:        "longitude",
+				Description: "The longitude of the site.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location.Longitude"),
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags assigned to the site.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SiteId"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags").Transform(networkManagerTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("SiteArn").Transform(arnToAkas),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listNetworkManagerSites(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	globalNetwork := h.Item.(types.GlobalNetwork)
+
+	// Minimize the API calls if the global network ID is specified in the query
+	if d.EqualsQualString("global_network_id") != "" && d.EqualsQualString("global_network_id") != *globalNetwork.GlobalNetworkId {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := NetworkManagerClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_networkmanager_site.listNetworkManagerSites", "client_error", err)
+		return nil, err
+	}
+
+	maxItems := int32(500)
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxItems {
+			if limit < 1 {
+				maxItems = int32(1)
+			} else {
+				maxItems = int32(limit)
+			}
+		}
+	}
+
+	input := &networkmanager.GetSitesInput{
+		GlobalNetworkId: globalNetwork.GlobalNetworkId,
+		MaxResults:      aws.Int32(maxItems),
+	}
+
+	paginator := networkmanager.NewGetSitesPaginator(svc, input, func(o *networkmanager.GetSitesPaginatorOptions) {
+		o.Limit = maxItems
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_networkmanager_site.listNetworkManagerSites", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range output.Sites {
+			d.StreamListItem(ctx, item)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getNetworkManagerSite(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	globalNetworkId := d.EqualsQualString("global_network_id")
+	siteId := d.EqualsQualString("site_id")
+	if globalNetworkId == "" || siteId == "" {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := NetworkManagerClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_networkmanager_site.getNetworkManagerSite", "client_error", err)
+		return nil, err
+	}
+
+	params := &networkmanager.GetSitesInput{
+		GlobalNetworkId: aws.String(globalNetworkId),
+		SiteIds:         []string{siteId},
+	}
+
+	op, err := svc.GetSites(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_networkmanager_site.getNetworkManagerSite", "api_error", err)
+		return nil, err
+	}
+
+	if len(op.Sites) > 0 {
+		return op.Sites[0], nil
+	}
+	return nil, nil
+}
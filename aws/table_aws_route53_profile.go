@@ -0,0 +1,255 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53profiles"
+	"github.com/aws/aws-sdk-go-v2/service/route53profiles/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsRoute53Profile(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_route53_profile",
+		Description: "AWS Route 53 Profile",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException"}),
+			},
+			Hydrate: getRoute53Profile,
+			Tags:    map[string]string{"service": "route53profiles", "action": "GetProfile"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listRoute53Profiles,
+			Tags:    map[string]string{"service": "route53profiles", "action": "ListProfiles"},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getRoute53Profile,
+				Tags: map[string]string{"service": "route53profiles", "action": "GetProfile"},
+			},
+			{
+				Func: getRoute53ProfileTags,
+				Tags: map[string]string{"service": "route53profiles", "action": "ListTagsForResource"},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(AWS_ROUTE53PROFILES_SERVICE_ID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the profile.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the profile.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the profile.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "share_status",
+				Description: "Whether the profile is shared with other accounts, or was shared with the current account by another account. Sharing is configured through Resource Access Manager (RAM).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "The status of the profile.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getRoute53Profile,
+			},
+			{
+				Name:        "status_message",
+				Description: "The status message that includes additional information about the profile.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getRoute53Profile,
+			},
+			{
+				Name:        "owner_id",
+				Description: "The Amazon Web Services account ID of the account that created the profile.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getRoute53Profile,
+			},
+			{
+				Name:        "creation_time",
+				Description: "The date and time that the profile was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Hydrate:     getRoute53Profile,
+			},
+			{
+				Name:        "modification_time",
+				Description: "The date and time that the profile was last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Hydrate:     getRoute53Profile,
+			},
+
+			// Standard columns for all tables
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getRoute53ProfileTags,
+				Transform:   transform.FromField("Tags"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn").Transform(arnToAkas),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listRoute53Profiles(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+
+	// Create session
+	svc, err := Route53ProfilesClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_route53_profile.listRoute53Profiles", "client_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	maxItems := int32(100)
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxItems {
+			if limit < 1 {
+				maxItems = int32(1)
+			} else {
+				maxItems = int32(limit)
+			}
+		}
+	}
+
+	input := &route53profiles.ListProfilesInput{
+		MaxResults: aws.Int32(maxItems),
+	}
+
+	paginator := route53profiles.NewListProfilesPaginator(svc, input, func(o *route53profiles.ListProfilesPaginatorOptions) {
+		o.Limit = maxItems
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_route53_profile.listRoute53Profiles", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range output.ProfileSummaries {
+			d.StreamListItem(ctx, item)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getRoute53Profile(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var id string
+	if h.Item != nil {
+		switch item := h.Item.(type) {
+		case types.ProfileSummary:
+			id = aws.ToString(item.Id)
+		case *types.Profile:
+			id = aws.ToString(item.Id)
+		}
+	} else {
+		id = d.EqualsQualString("id")
+	}
+
+	if id == "" {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := Route53ProfilesClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_route53_profile.getRoute53Profile", "client_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &route53profiles.GetProfileInput{
+		ProfileId: aws.String(id),
+	}
+
+	op, err := svc.GetProfile(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_route53_profile.getRoute53Profile", "api_error", err)
+		return nil, err
+	}
+
+	return op.Profile, nil
+}
+
+func getRoute53ProfileTags(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var arn string
+	switch item := h.Item.(type) {
+	case types.ProfileSummary:
+		arn = aws.ToString(item.Arn)
+	case *types.Profile:
+		arn = aws.ToString(item.Arn)
+	}
+
+	// Create session
+	svc, err := Route53ProfilesClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_route53_profile.getRoute53ProfileTags", "client_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &route53profiles.ListTagsForResourceInput{
+		ResourceArn: aws.String(arn),
+	}
+
+	op, err := svc.ListTagsForResource(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_route53_profile.getRoute53ProfileTags", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
@@ -0,0 +1,192 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53profiles"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsRoute53ProfileAssociation(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_route53_profile_association",
+		Description: "AWS Route 53 Profile Association",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException"}),
+			},
+			Hydrate: getRoute53ProfileAssociation,
+			Tags:    map[string]string{"service": "route53profiles", "action": "GetProfileAssociation"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listRoute53ProfileAssociations,
+			Tags:    map[string]string{"service": "route53profiles", "action": "ListProfileAssociations"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "profile_id", Require: plugin.Optional},
+				{Name: "resource_id", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(AWS_ROUTE53PROFILES_SERVICE_ID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the profile association.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the profile association.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "profile_id",
+				Description: "The ID of the profile that the VPC is associated with.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "resource_id",
+				Description: "The ID of the VPC that the profile is associated with.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "The status of the profile association.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status_message",
+				Description: "Additional information about the status of the profile association.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "owner_id",
+				Description: "The Amazon Web Services account ID of the account that created the profile association.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "creation_time",
+				Description: "The date and time that the profile association was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "modification_time",
+				Description: "The date and time that the profile association was last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+
+			// Standard columns for all tables
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listRoute53ProfileAssociations(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+
+	// Create session
+	svc, err := Route53ProfilesClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_route53_profile_association.listRoute53ProfileAssociations", "client_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	maxItems := int32(100)
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxItems {
+			if limit < 1 {
+				maxItems = int32(1)
+			} else {
+				maxItems = int32(limit)
+			}
+		}
+	}
+
+	input := &route53profiles.ListProfileAssociationsInput{
+		MaxResults: aws.Int32(maxItems),
+	}
+	if d.EqualsQualString("profile_id") != "" {
+		input.ProfileId = aws.String(d.EqualsQualString("profile_id"))
+	}
+	if d.EqualsQualString("resource_id") != "" {
+		input.ResourceId = aws.String(d.EqualsQualString("resource_id"))
+	}
+
+	paginator := route53profiles.NewListProfileAssociationsPaginator(svc, input, func(o *route53profiles.ListProfileAssociationsPaginatorOptions) {
+		o.Limit = maxItems
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_route53_profile_association.listRoute53ProfileAssociations", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range output.ProfileAssociations {
+			d.StreamListItem(ctx, item)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getRoute53ProfileAssociation(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	id := d.EqualsQualString("id")
+	if id == "" {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := Route53ProfilesClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_route53_profile_association.getRoute53ProfileAssociation", "client_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &route53profiles.GetProfileAssociationInput{
+		ProfileAssociationId: aws.String(id),
+	}
+
+	op, err := svc.GetProfileAssociation(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_route53_profile_association.getRoute53ProfileAssociation", "api_error", err)
+		return nil, err
+	}
+
+	return *op.ProfileAssociation, nil
+}
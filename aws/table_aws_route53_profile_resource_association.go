@@ -0,0 +1,209 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53profiles"
+	"github.com/aws/aws-sdk-go-v2/service/route53profiles/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsRoute53ProfileResourceAssociation(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_route53_profile_resource_association",
+		Description: "AWS Route 53 Profile Resource Association",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException"}),
+			},
+			Hydrate: getRoute53ProfileResourceAssociation,
+			Tags:    map[string]string{"service": "route53profiles", "action": "GetProfileResourceAssociation"},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listRoute53Profiles,
+			Hydrate:       listRoute53ProfileResourceAssociations,
+			Tags:          map[string]string{"service": "route53profiles", "action": "ListProfileResourceAssociations"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "profile_id", Require: plugin.Optional},
+				{Name: "resource_type", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(AWS_ROUTE53PROFILES_SERVICE_ID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the resource association.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the resource association.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "profile_id",
+				Description: "The ID of the profile that the resource is associated with.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "resource_arn",
+				Description: "The Amazon Resource Name (ARN) of the associated resource, such as a DNS Firewall rule group, private hosted zone, Resolver rule or interface VPC endpoint.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "resource_type",
+				Description: "The type of the associated resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "resource_properties",
+				Description: "The properties of the association, such as the priority of a DNS Firewall rule group.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ResourceProperties").Transform(transform.UnmarshalYAML),
+			},
+			{
+				Name:        "status",
+				Description: "The status of the resource association.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status_message",
+				Description: "Additional information about the status of the resource association.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "owner_id",
+				Description: "The Amazon Web Services account ID of the account that created the resource association.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "creation_time",
+				Description: "The date and time that the resource association was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "modification_time",
+				Description: "The date and time that the resource association was last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+
+			// Standard columns for all tables
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listRoute53ProfileResourceAssociations(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	profile := h.Item.(types.ProfileSummary)
+
+	// Minimize the API calls if the profile ID is specified in the query
+	if d.EqualsQualString("profile_id") != "" && d.EqualsQualString("profile_id") != *profile.Id {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := Route53ProfilesClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_route53_profile_resource_association.listRoute53ProfileResourceAssociations", "client_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	maxItems := int32(100)
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxItems {
+			if limit < 1 {
+				maxItems = int32(1)
+			} else {
+				maxItems = int32(limit)
+			}
+		}
+	}
+
+	input := &route53profiles.ListProfileResourceAssociationsInput{
+		ProfileId:  profile.Id,
+		MaxResults: aws.Int32(maxItems),
+	}
+	if d.EqualsQualString("resource_type") != "" {
+		input.ResourceType = aws.String(d.EqualsQualString("resource_type"))
+	}
+
+	paginator := route53profiles.NewListProfileResourceAssociationsPaginator(svc, input, func(o *route53profiles.ListProfileResourceAssociationsPaginatorOptions) {
+		o.Limit = maxItems
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_route53_profile_resource_association.listRoute53ProfileResourceAssociations", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range output.ProfileResourceAssociations {
+			d.StreamListItem(ctx, item)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getRoute53ProfileResourceAssociation(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	id := d.EqualsQualString("id")
+	if id == "" {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := Route53ProfilesClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_route53_profile_resource_association.getRoute53ProfileResourceAssociation", "client_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &route53profiles.GetProfileResourceAssociationInput{
+		ProfileResourceAssociationId: aws.String(id),
+	}
+
+	op, err := svc.GetProfileResourceAssociation(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_route53_profile_resource_association.getRoute53ProfileResourceAssociation", "api_error", err)
+		return nil, err
+	}
+
+	return *op.ProfileResourceAssociation, nil
+}
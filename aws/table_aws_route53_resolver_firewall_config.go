@@ -0,0 +1,158 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53resolver"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsRoute53ResolverFirewallConfig(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_route53_resolver_firewall_config",
+		Description: "AWS Route53 Resolver DNS Firewall Config",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("resource_id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException", "ValidationException"}),
+			},
+			Hydrate: getRoute53ResolverFirewallConfig,
+			Tags:    map[string]string{"service": "route53resolver", "action": "GetFirewallConfig"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listRoute53ResolverFirewallConfigs,
+			Tags:    map[string]string{"service": "route53resolver", "action": "ListFirewallConfigs"},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(AWS_ROUTE53RESOLVER_SERVICE_ID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The ID of the firewall configuration.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "resource_id",
+				Description: "The ID of the VPC that this firewall configuration applies to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "owner_id",
+				Description: "The Amazon Web Services account ID of the owner of the VPC that this firewall configuration applies to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "firewall_fail_open",
+				Description: "Determines how DNS Firewall operates during failures, for example when all traffic that is sent to DNS Firewall fails to receive a reply. ENABLED means queries are allowed through (fail open), DISABLED means queries are blocked (fail closed).",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// Standard columns for all tables
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ResourceId"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listRoute53ResolverFirewallConfigs(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+
+	// Create session
+	svc, err := Route53ResolverClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_route53_resolver_firewall_config.listRoute53ResolverFirewallConfigs", "client_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// The API accepts a page size between 5 and 10
+	maxItems := int32(10)
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxItems {
+			if limit < 5 {
+				maxItems = int32(5)
+			} else {
+				maxItems = int32(limit)
+			}
+		}
+	}
+
+	input := &route53resolver.ListFirewallConfigsInput{
+		MaxResults: aws.Int32(maxItems),
+	}
+
+	paginator := route53resolver.NewListFirewallConfigsPaginator(svc, input, func(o *route53resolver.ListFirewallConfigsPaginatorOptions) {
+		o.Limit = maxItems
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_route53_resolver_firewall_config.listRoute53ResolverFirewallConfigs", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range output.FirewallConfigs {
+			d.StreamListItem(ctx, item)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getRoute53ResolverFirewallConfig(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	resourceId := d.EqualsQualString("resource_id")
+	if resourceId == "" {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := Route53ResolverClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_route53_resolver_firewall_config.getRoute53ResolverFirewallConfig", "client_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &route53resolver.GetFirewallConfigInput{
+		ResourceId: aws.String(resourceId),
+	}
+
+	op, err := svc.GetFirewallConfig(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_route53_resolver_firewall_config.getRoute53ResolverFirewallConfig", "api_error", err)
+		return nil, err
+	}
+
+	return *op.FirewallConfig, nil
+}
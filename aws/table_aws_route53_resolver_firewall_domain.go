@@ -0,0 +1,141 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53resolver"
+	"github.com/aws/aws-sdk-go-v2/service/route53resolver/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsRoute53ResolverFirewallDomain(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_route53_resolver_firewall_domain",
+		Description: "AWS Route53 Resolver DNS Firewall Domain",
+		List: &plugin.ListConfig{
+			ParentHydrate: listRoute53ResolverFirewallDomainLists,
+			Hydrate:       listRoute53ResolverFirewallDomains,
+			Tags:          map[string]string{"service": "route53resolver", "action": "ListFirewallDomains"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "firewall_domain_list_id", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(AWS_ROUTE53RESOLVER_SERVICE_ID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "domain",
+				Description: "The domain name, which can include a wildcard prefix such as *.example.com.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "firewall_domain_list_id",
+				Description: "The ID of the domain list that contains the domain.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "firewall_domain_list_name",
+				Description: "The name of the domain list that contains the domain.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// Standard columns for all tables
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Domain"),
+			},
+		}),
+	}
+}
+
+type Route53ResolverFirewallDomainInfo struct {
+	Domain                 string
+	FirewallDomainListId   string
+	FirewallDomainListName string
+}
+
+//// LIST FUNCTION
+
+func listRoute53ResolverFirewallDomains(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	domainList := h.Item.(types.FirewallDomainListMetadata)
+
+	// Minimize the API calls if the domain list ID is specified in the query
+	if d.EqualsQualString("firewall_domain_list_id") != "" && d.EqualsQualString("firewall_domain_list_id") != *domainList.Id {
+		return nil, nil
+	}
+
+	// The domains of AWS managed domain lists are not visible to customers
+	if domainList.ManagedOwnerName != nil {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := Route53ResolverClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_route53_resolver_firewall_domain.listRoute53ResolverFirewallDomains", "client_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Domain lists can hold hundreds of thousands of entries, so each page is
+	// streamed as it arrives rather than collected first
+	maxItems := int32(1000)
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxItems {
+			if limit < 1 {
+				maxItems = int32(1)
+			} else {
+				maxItems = int32(limit)
+			}
+		}
+	}
+
+	input := &route53resolver.ListFirewallDomainsInput{
+		FirewallDomainListId: domainList.Id,
+		MaxResults:           aws.Int32(maxItems),
+	}
+
+	paginator := route53resolver.NewListFirewallDomainsPaginator(svc, input, func(o *route53resolver.ListFirewallDomainsPaginatorOptions) {
+		o.Limit = maxItems
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_route53_resolver_firewall_domain.listRoute53ResolverFirewallDomains", "api_error", err)
+			return nil, err
+		}
+
+		for _, domain := range output.Domains {
+			d.StreamListItem(ctx, &Route53ResolverFirewallDomainInfo{
+				Domain:                 domain,
+				FirewallDomainListId:   *domainList.Id,
+				FirewallDomainListName: aws.ToString(domainList.Name),
+			})
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
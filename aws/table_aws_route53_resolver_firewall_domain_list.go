@@ -0,0 +1,263 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53resolver"
+	"github.com/aws/aws-sdk-go-v2/service/route53resolver/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsRoute53ResolverFirewallDomainList(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_route53_resolver_firewall_domain_list",
+		Description: "AWS Route53 Resolver DNS Firewall Domain List",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException"}),
+			},
+			Hydrate: getRoute53ResolverFirewallDomainList,
+			Tags:    map[string]string{"service": "route53resolver", "action": "GetFirewallDomainList"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listRoute53ResolverFirewallDomainLists,
+			Tags:    map[string]string{"service": "route53resolver", "action": "ListFirewallDomainLists"},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getRoute53ResolverFirewallDomainList,
+				Tags: map[string]string{"service": "route53resolver", "action": "GetFirewallDomainList"},
+			},
+			{
+				Func: getRoute53ResolverFirewallDomainListTags,
+				Tags: map[string]string{"service": "route53resolver", "action": "ListTagsForResource"},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(AWS_ROUTE53RESOLVER_SERVICE_ID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the domain list.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the domain list.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The ARN (Amazon Resource Name) of the domain list.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "managed_owner_name",
+				Description: "The owner of the list, used only for lists that are not managed by you. For example, the managed domain list AWSManagedDomainsMalwareDomainList has the managed owner name Route 53 Resolver DNS Firewall.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "The status of the domain list. Valid values include COMPLETE|COMPLETE_IMPORT_FAILED|IMPORTING|DELETING|UPDATING.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getRoute53ResolverFirewallDomainList,
+			},
+			{
+				Name:        "status_message",
+				Description: "Additional information about the status of the list, if available.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getRoute53ResolverFirewallDomainList,
+			},
+			{
+				Name:        "domain_count",
+				Description: "The number of domain names that are specified in the domain list.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getRoute53ResolverFirewallDomainList,
+			},
+			{
+				Name:        "creator_request_id",
+				Description: "A unique string defined by you to identify the request.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "creation_time",
+				Description: "The date and time that the domain list was created, in Unix time format and Coordinated Universal Time (UTC).",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Hydrate:     getRoute53ResolverFirewallDomainList,
+			},
+			{
+				Name:        "modification_time",
+				Description: "The date and time that the domain list was last modified, in Unix time format and Coordinated Universal Time (UTC).",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Hydrate:     getRoute53ResolverFirewallDomainList,
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags assigned to the domain list.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getRoute53ResolverFirewallDomainListTags,
+				Transform:   transform.FromField("Tags"),
+			},
+
+			// Standard columns for all tables
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getRoute53ResolverFirewallDomainListTags,
+				Transform:   transform.FromField("Tags").Transform(route53resolverTagListToTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn").Transform(arnToAkas),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listRoute53ResolverFirewallDomainLists(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+
+	// Create session
+	svc, err := Route53ResolverClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_route53_resolver_firewall_domain_list.listRoute53ResolverFirewallDomainLists", "client_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	maxItems := int32(100)
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxItems {
+			if limit < 1 {
+				maxItems = int32(1)
+			} else {
+				maxItems = int32(limit)
+			}
+		}
+	}
+
+	input := &route53resolver.ListFirewallDomainListsInput{
+		MaxResults: aws.Int32(maxItems),
+	}
+
+	paginator := route53resolver.NewListFirewallDomainListsPaginator(svc, input, func(o *route53resolver.ListFirewallDomainListsPaginatorOptions) {
+		o.Limit = maxItems
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_route53_resolver_firewall_domain_list.listRoute53ResolverFirewallDomainLists", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range output.FirewallDomainLists {
+			d.StreamListItem(ctx, item)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getRoute53ResolverFirewallDomainList(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var id string
+	if h.Item != nil {
+		id = route53ResolverFirewallDomainListId(h.Item)
+	} else {
+		id = d.EqualsQualString("id")
+	}
+
+	if id == "" {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := Route53ResolverClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_route53_resolver_firewall_domain_list.getRoute53ResolverFirewallDomainList", "client_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &route53resolver.GetFirewallDomainListInput{
+		FirewallDomainListId: aws.String(id),
+	}
+
+	op, err := svc.GetFirewallDomainList(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_route53_resolver_firewall_domain_list.getRoute53ResolverFirewallDomainList", "api_error", err)
+		return nil, err
+	}
+
+	return op.FirewallDomainList, nil
+}
+
+func getRoute53ResolverFirewallDomainListTags(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// AWS managed domain lists are not owned by the account and cannot be tagged
+	if metadata, ok := h.Item.(types.FirewallDomainListMetadata); ok && metadata.ManagedOwnerName != nil {
+		return nil, nil
+	}
+	if list, ok := h.Item.(*types.FirewallDomainList); ok && list.ManagedOwnerName != nil {
+		return nil, nil
+	}
+
+	return getRoute53ResolverResourceTags(ctx, d, route53ResolverFirewallDomainListArn(h.Item))
+}
+
+//// UTILITY FUNCTIONS
+
+func route53ResolverFirewallDomainListId(item interface{}) string {
+	switch item := item.(type) {
+	case types.FirewallDomainListMetadata:
+		return aws.ToString(item.Id)
+	case *types.FirewallDomainList:
+		return aws.ToString(item.Id)
+	}
+	return ""
+}
+
+func route53ResolverFirewallDomainListArn(item interface{}) string {
+	switch item := item.(type) {
+	case types.FirewallDomainListMetadata:
+		return aws.ToString(item.Arn)
+	case *types.FirewallDomainList:
+		return aws.ToString(item.Arn)
+	}
+	return ""
+}
@@ -0,0 +1,356 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53resolver"
+	"github.com/aws/aws-sdk-go-v2/service/route53resolver/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsRoute53ResolverFirewallRuleGroup(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_route53_resolver_firewall_rule_group",
+		Description: "AWS Route53 Resolver DNS Firewall Rule Group",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException"}),
+			},
+			Hydrate: getRoute53ResolverFirewallRuleGroup,
+			Tags:    map[string]string{"service": "route53resolver", "action": "GetFirewallRuleGroup"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listRoute53ResolverFirewallRuleGroups,
+			Tags:    map[string]string{"service": "route53resolver", "action": "ListFirewallRuleGroups"},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getRoute53ResolverFirewallRuleGroup,
+				Tags: map[string]string{"service": "route53resolver", "action": "GetFirewallRuleGroup"},
+			},
+			{
+				Func: getRoute53ResolverFirewallRuleGroupPolicy,
+				Tags: map[string]string{"service": "route53resolver", "action": "GetFirewallRuleGroupPolicy"},
+				// Rule groups shared with the account can be used but their sharing policy can only be read by the owner
+				IgnoreConfig: &plugin.IgnoreConfig{
+					ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException", "AccessDeniedException"}),
+				},
+			},
+			{
+				Func: getRoute53ResolverFirewallRuleGroupTags,
+				Tags: map[string]string{"service": "route53resolver", "action": "ListTagsForResource"},
+				IgnoreConfig: &plugin.IgnoreConfig{
+					ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"AccessDeniedException"}),
+				},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(AWS_ROUTE53RESOLVER_SERVICE_ID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the rule group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the rule group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The ARN (Amazon Resource Name) of the rule group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "The status of the rule group. Valid values include COMPLETE|DELETING|UPDATING.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getRoute53ResolverFirewallRuleGroup,
+			},
+			{
+				Name:        "status_message",
+				Description: "Additional information about the status of the rule group, if available.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getRoute53ResolverFirewallRuleGroup,
+			},
+			{
+				Name:        "rule_count",
+				Description: "The number of rules in the rule group.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getRoute53ResolverFirewallRuleGroup,
+			},
+			{
+				Name:        "owner_id",
+				Description: "The Amazon Web Services account ID for the account that created the rule group. When a rule group is shared with your account, this is the account that has shared the rule group with you.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "share_status",
+				Description: "Whether the rule group is shared with other Amazon Web Services accounts, or was shared with the current account by another Amazon Web Services account. Sharing is configured through Resource Access Manager (RAM).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "creator_request_id",
+				Description: "A unique string defined by you to identify the request.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "creation_time",
+				Description: "The date and time that the rule group was created, in Unix time format and Coordinated Universal Time (UTC).",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Hydrate:     getRoute53ResolverFirewallRuleGroup,
+			},
+			{
+				Name:        "modification_time",
+				Description: "The date and time that the rule group was last modified, in Unix time format and Coordinated Universal Time (UTC).",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Hydrate:     getRoute53ResolverFirewallRuleGroup,
+			},
+			{
+				Name:        "policy",
+				Description: "The Identity and Access Management (Amazon Web Services IAM) policy for sharing the rule group with other accounts.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getRoute53ResolverFirewallRuleGroupPolicy,
+				Transform:   transform.FromField("FirewallRuleGroupPolicy"),
+			},
+			{
+				Name:        "policy_std",
+				Description: "Contains the policy in a canonical form for easier searching.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getRoute53ResolverFirewallRuleGroupPolicy,
+				Transform:   transform.FromField("FirewallRuleGroupPolicy").Transform(unescape).Transform(policyToCanonical),
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags assigned to the rule group.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getRoute53ResolverFirewallRuleGroupTags,
+				Transform:   transform.FromField("Tags"),
+			},
+
+			// Standard columns for all tables
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getRoute53ResolverFirewallRuleGroupTags,
+				Transform:   transform.FromField("Tags").Transform(route53resolverTagListToTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn").Transform(arnToAkas),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listRoute53ResolverFirewallRuleGroups(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+
+	// Create session
+	svc, err := Route53ResolverClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_route53_resolver_firewall_rule_group.listRoute53ResolverFirewallRuleGroups", "client_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	maxItems := int32(100)
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxItems {
+			if limit < 1 {
+				maxItems = int32(1)
+			} else {
+				maxItems = int32(limit)
+			}
+		}
+	}
+
+	input := &route53resolver.ListFirewallRuleGroupsInput{
+		MaxResults: aws.Int32(maxItems),
+	}
+
+	paginator := route53resolver.NewListFirewallRuleGroupsPaginator(svc, input, func(o *route53resolver.ListFirewallRuleGroupsPaginatorOptions) {
+		o.Limit = maxItems
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_route53_resolver_firewall_rule_group.listRoute53ResolverFirewallRuleGroups", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range output.FirewallRuleGroups {
+			d.StreamListItem(ctx, item)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getRoute53ResolverFirewallRuleGroup(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var id string
+	if h.Item != nil {
+		id = route53ResolverFirewallRuleGroupId(h.Item)
+	} else {
+		id = d.EqualsQualString("id")
+	}
+
+	if id == "" {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := Route53ResolverClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_route53_resolver_firewall_rule_group.getRoute53ResolverFirewallRuleGroup", "client_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &route53resolver.GetFirewallRuleGroupInput{
+		FirewallRuleGroupId: aws.String(id),
+	}
+
+	op, err := svc.GetFirewallRuleGroup(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_route53_resolver_firewall_rule_group.getRoute53ResolverFirewallRuleGroup", "api_error", err)
+		return nil, err
+	}
+
+	return op.FirewallRuleGroup, nil
+}
+
+func getRoute53ResolverFirewallRuleGroupPolicy(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	arn := route53ResolverFirewallRuleGroupArn(h.Item)
+
+	// Create session
+	svc, err := Route53ResolverClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_route53_resolver_firewall_rule_group.getRoute53ResolverFirewallRuleGroupPolicy", "client_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &route53resolver.GetFirewallRuleGroupPolicyInput{
+		Arn: aws.String(arn),
+	}
+
+	op, err := svc.GetFirewallRuleGroupPolicy(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_route53_resolver_firewall_rule_group.getRoute53ResolverFirewallRuleGroupPolicy", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
+
+func getRoute53ResolverFirewallRuleGroupTags(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	return getRoute53ResolverResourceTags(ctx, d, route53ResolverFirewallRuleGroupArn(h.Item))
+}
+
+//// UTILITY FUNCTIONS
+
+func route53ResolverFirewallRuleGroupId(item interface{}) string {
+	switch item := item.(type) {
+	case types.FirewallRuleGroupMetadata:
+		return aws.ToString(item.Id)
+	case *types.FirewallRuleGroup:
+		return aws.ToString(item.Id)
+	}
+	return ""
+}
+
+func route53ResolverFirewallRuleGroupArn(item interface{}) string {
+	switch item := item.(type) {
+	case types.FirewallRuleGroupMetadata:
+		return aws.ToString(item.Arn)
+	case *types.FirewallRuleGroup:
+		return aws.ToString(item.Arn)
+	}
+	return ""
+}
+
+// getRoute53ResolverResourceTags returns all the tags of a Resolver resource
+// that supports tagging, such as the DNS Firewall rule groups and domain lists.
+func getRoute53ResolverResourceTags(ctx context.Context, d *plugin.QueryData, resourceArn string) (interface{}, error) {
+	if resourceArn == "" {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := Route53ResolverClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("getRoute53ResolverResourceTags", "client_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &route53resolver.ListTagsForResourceInput{
+		ResourceArn: aws.String(resourceArn),
+	}
+
+	var tags []types.Tag
+
+	// API doesn't support aws-sdk-go-v2 paginator as of date.
+	pagesLeft := true
+	for pagesLeft {
+		op, err := svc.ListTagsForResource(ctx, params)
+		if err != nil {
+			plugin.Logger(ctx).Error("getRoute53ResolverResourceTags", "api_error", err)
+			return nil, err
+		}
+		tags = append(tags, op.Tags...)
+
+		if op.NextToken != nil {
+			params.NextToken = op.NextToken
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return &route53resolver.ListTagsForResourceOutput{Tags: tags}, nil
+}
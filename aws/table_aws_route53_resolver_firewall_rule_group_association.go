@@ -0,0 +1,252 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53resolver"
+	"github.com/aws/aws-sdk-go-v2/service/route53resolver/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsRoute53ResolverFirewallRuleGroupAssociation(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_route53_resolver_firewall_rule_group_association",
+		Description: "AWS Route53 Resolver DNS Firewall Rule Group Association",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException"}),
+			},
+			Hydrate: getRoute53ResolverFirewallRuleGroupAssociation,
+			Tags:    map[string]string{"service": "route53resolver", "action": "GetFirewallRuleGroupAssociation"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listRoute53ResolverFirewallRuleGroupAssociations,
+			Tags:    map[string]string{"service": "route53resolver", "action": "ListFirewallRuleGroupAssociations"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "firewall_rule_group_id", Require: plugin.Optional},
+				{Name: "vpc_id", Require: plugin.Optional},
+				{Name: "status", Require: plugin.Optional},
+				{Name: "priority", Require: plugin.Optional},
+			},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getRoute53ResolverFirewallRuleGroupAssociationTags,
+				Tags: map[string]string{"service": "route53resolver", "action": "ListTagsForResource"},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(AWS_ROUTE53RESOLVER_SERVICE_ID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the association.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The identifier for the association.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The ARN (Amazon Resource Name) of the firewall rule group association.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "firewall_rule_group_id",
+				Description: "The unique identifier of the firewall rule group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "vpc_id",
+				Description: "The unique identifier of the VPC that is associated with the rule group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "priority",
+				Description: "The setting that determines the processing order of the rule group among the rule groups that are associated with a single VPC. DNS Firewall filters VPC traffic starting from the rule group with the lowest numeric priority setting.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "status",
+				Description: "The current status of the association. Valid values include COMPLETE|DELETING|UPDATING.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status_message",
+				Description: "Additional information about the status of the response, if available.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "mutation_protection",
+				Description: "If enabled, this setting disallows modification or removal of the association, to help prevent against accidentally altering DNS firewall protections.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "managed_owner_name",
+				Description: "The owner of the association, used only for associations that are not managed by you. If you use Firewall Manager to manage your DNS Firewalls, then this reports Firewall Manager as the managed owner.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "creator_request_id",
+				Description: "A unique string defined by you to identify the request.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "creation_time",
+				Description: "The date and time that the association was created, in Unix time format and Coordinated Universal Time (UTC).",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "modification_time",
+				Description: "The date and time that the association was last modified, in Unix time format and Coordinated Universal Time (UTC).",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags assigned to the association.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getRoute53ResolverFirewallRuleGroupAssociationTags,
+				Transform:   transform.FromField("Tags"),
+			},
+
+			// Standard columns for all tables
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getRoute53ResolverFirewallRuleGroupAssociationTags,
+				Transform:   transform.FromField("Tags").Transform(route53resolverTagListToTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn").Transform(arnToAkas),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listRoute53ResolverFirewallRuleGroupAssociations(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+
+	// Create session
+	svc, err := Route53ResolverClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_route53_resolver_firewall_rule_group_association.listRoute53ResolverFirewallRuleGroupAssociations", "client_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	maxItems := int32(100)
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxItems {
+			if limit < 1 {
+				maxItems = int32(1)
+			} else {
+				maxItems = int32(limit)
+			}
+		}
+	}
+
+	input := &route53resolver.ListFirewallRuleGroupAssociationsInput{
+		MaxResults: aws.Int32(maxItems),
+	}
+	if d.EqualsQualString("firewall_rule_group_id") != "" {
+		input.FirewallRuleGroupId = aws.String(d.EqualsQualString("firewall_rule_group_id"))
+	}
+	if d.EqualsQualString("vpc_id") != "" {
+		input.VpcId = aws.String(d.EqualsQualString("vpc_id"))
+	}
+	if d.EqualsQualString("status") != "" {
+		input.Status = types.FirewallRuleGroupAssociationStatus(d.EqualsQualString("status"))
+	}
+	if d.EqualsQuals["priority"] != nil {
+		input.Priority = aws.Int32(int32(d.EqualsQuals["priority"].GetInt64Value()))
+	}
+
+	paginator := route53resolver.NewListFirewallRuleGroupAssociationsPaginator(svc, input, func(o *route53resolver.ListFirewallRuleGroupAssociationsPaginatorOptions) {
+		o.Limit = maxItems
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_route53_resolver_firewall_rule_group_association.listRoute53ResolverFirewallRuleGroupAssociations", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range output.FirewallRuleGroupAssociations {
+			d.StreamListItem(ctx, item)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getRoute53ResolverFirewallRuleGroupAssociation(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	id := d.EqualsQualString("id")
+	if id == "" {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := Route53ResolverClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_route53_resolver_firewall_rule_group_association.getRoute53ResolverFirewallRuleGroupAssociation", "client_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &route53resolver.GetFirewallRuleGroupAssociationInput{
+		FirewallRuleGroupAssociationId: aws.String(id),
+	}
+
+	op, err := svc.GetFirewallRuleGroupAssociation(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_route53_resolver_firewall_rule_group_association.getRoute53ResolverFirewallRuleGroupAssociation", "api_error", err)
+		return nil, err
+	}
+
+	return *op.FirewallRuleGroupAssociation, nil
+}
+
+func getRoute53ResolverFirewallRuleGroupAssociationTags(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	association := h.Item.(types.FirewallRuleGroupAssociation)
+	return getRoute53ResolverResourceTags(ctx, d, aws.ToString(association.Arn))
+}
@@ -0,0 +1,198 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/schemas"
+	"github.com/aws/aws-sdk-go-v2/service/schemas/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsSchemasDiscoverer(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_schemas_discoverer",
+		Description: "AWS EventBridge Schemas Discoverer",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("discoverer_id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"NotFoundException", "BadRequestException"}),
+			},
+			Hydrate: getSchemasDiscoverer,
+			Tags:    map[string]string{"service": "schemas", "action": "DescribeDiscoverer"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listSchemasDiscoverers,
+			Tags:    map[string]string{"service": "schemas", "action": "ListDiscoverers"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "source_arn", Require: plugin.Optional},
+			},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getSchemasDiscoverer,
+				Tags: map[string]string{"service": "schemas", "action": "DescribeDiscoverer"},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(AWS_SCHEMAS_SERVICE_ID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "discoverer_id",
+				Description: "The ID of the discoverer.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the discoverer.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DiscovererArn"),
+			},
+			{
+				Name:        "source_arn",
+				Description: "The ARN of the event bus that the discoverer discovers schemas from.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "The state of the discoverer, either STARTED or STOPPED.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "cross_account",
+				Description: "Indicates whether the discoverer discovers schemas from events sent from other accounts.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the discoverer.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getSchemasDiscoverer,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DiscovererId"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("DiscovererArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listSchemasDiscoverers(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := SchemasClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_schemas_discoverer.listSchemasDiscoverers", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region check
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &schemas.ListDiscoverersInput{
+		Limit: aws.Int32(maxLimit),
+	}
+	if d.EqualsQualString("source_arn") != "" {
+		input.SourceArnPrefix = aws.String(d.EqualsQualString("source_arn"))
+	}
+
+	paginator := schemas.NewListDiscoverersPaginator(svc, input, func(o *schemas.ListDiscoverersPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_schemas_discoverer.listSchemasDiscoverers", "api_error", err)
+			return nil, err
+		}
+
+		for _, discoverer := range output.Discoverers {
+			d.StreamListItem(ctx, discoverer)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getSchemasDiscoverer(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var id string
+	if h.Item != nil {
+		id = aws.ToString(h.Item.(types.DiscovererSummary).DiscovererId)
+	} else {
+		id = d.EqualsQualString("discoverer_id")
+	}
+
+	// Empty check
+	if id == "" {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := SchemasClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_schemas_discoverer.getSchemasDiscoverer", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region check
+		return nil, nil
+	}
+
+	params := &schemas.DescribeDiscovererInput{
+		DiscovererId: aws.String(id),
+	}
+
+	op, err := svc.DescribeDiscoverer(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_schemas_discoverer.getSchemasDiscoverer", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
@@ -0,0 +1,177 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/schemas"
+	"github.com/aws/aws-sdk-go-v2/service/schemas/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsSchemasRegistry(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_schemas_registry",
+		Description: "AWS EventBridge Schemas Registry",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("registry_name"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"NotFoundException", "BadRequestException"}),
+			},
+			Hydrate: getSchemasRegistry,
+			Tags:    map[string]string{"service": "schemas", "action": "DescribeRegistry"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listSchemasRegistries,
+			Tags:    map[string]string{"service": "schemas", "action": "ListRegistries"},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getSchemasRegistry,
+				Tags: map[string]string{"service": "schemas", "action": "DescribeRegistry"},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(AWS_SCHEMAS_SERVICE_ID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "registry_name",
+				Description: "The name of the registry.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the registry.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RegistryArn"),
+			},
+			{
+				Name:        "description",
+				Description: "The description of the registry.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getSchemasRegistry,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RegistryName"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("RegistryArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listSchemasRegistries(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := SchemasClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_schemas_registry.listSchemasRegistries", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region check
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &schemas.ListRegistriesInput{
+		Limit: aws.Int32(maxLimit),
+	}
+
+	paginator := schemas.NewListRegistriesPaginator(svc, input, func(o *schemas.ListRegistriesPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_schemas_registry.listSchemasRegistries", "api_error", err)
+			return nil, err
+		}
+
+		for _, registry := range output.Registries {
+			d.StreamListItem(ctx, registry)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getSchemasRegistry(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var name string
+	if h.Item != nil {
+		name = aws.ToString(h.Item.(types.RegistrySummary).RegistryName)
+	} else {
+		name = d.EqualsQualString("registry_name")
+	}
+
+	// Empty check
+	if name == "" {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := SchemasClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_schemas_registry.getSchemasRegistry", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region check
+		return nil, nil
+	}
+
+	params := &schemas.DescribeRegistryInput{
+		RegistryName: aws.String(name),
+	}
+
+	op, err := svc.DescribeRegistry(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_schemas_registry.getSchemasRegistry", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
@@ -0,0 +1,258 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/schemas"
+	"github.com/aws/aws-sdk-go-v2/service/schemas/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsSchemasSchema(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_schemas_schema",
+		Description: "AWS EventBridge Schemas Schema",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"registry_name", "schema_name"}),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"NotFoundException", "BadRequestException"}),
+			},
+			Hydrate: getSchemasSchema,
+			Tags:    map[string]string{"service": "schemas", "action": "DescribeSchema"},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listSchemasRegistries,
+			Hydrate:       listSchemasSchemas,
+			Tags:          map[string]string{"service": "schemas", "action": "ListSchemas"},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"NotFoundException"}),
+			},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "registry_name", Require: plugin.Optional},
+			},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getSchemasSchema,
+				Tags: map[string]string{"service": "schemas", "action": "DescribeSchema"},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(AWS_SCHEMAS_SERVICE_ID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "schema_name",
+				Description: "The name of the schema.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "registry_name",
+				Description: "The name of the registry the schema belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the schema.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SchemaArn"),
+			},
+			{
+				Name:        "last_modified",
+				Description: "The date and time that the schema was modified.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "version_count",
+				Description: "The number of versions available for the schema.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "schema_version",
+				Description: "The version number of the latest version of the schema.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getSchemasSchema,
+			},
+			{
+				Name:        "type",
+				Description: "The type of the schema, either OpenApi3 or JSONSchemaDraft4.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getSchemasSchema,
+			},
+			{
+				Name:        "version_created_date",
+				Description: "The date the latest version of the schema was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Hydrate:     getSchemasSchema,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the schema.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getSchemasSchema,
+			},
+			{
+				Name:        "content",
+				Description: "The source of the latest version of the schema.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getSchemasSchema,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SchemaName"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("SchemaArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+// SchemasSchemaInfo holds the registry name along with the schema, since the
+// schema itself only references its registry through its ARN
+type SchemasSchemaInfo struct {
+	RegistryName *string
+	VersionCount *int64
+	schemas.DescribeSchemaOutput
+}
+
+//// LIST FUNCTION
+
+func listSchemasSchemas(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	registryName := h.Item.(types.RegistrySummary).RegistryName
+
+	// Minimize the API call with the given registry name
+	if d.EqualsQualString("registry_name") != "" && d.EqualsQualString("registry_name") != aws.ToString(registryName) {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := SchemasClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_schemas_schema.listSchemasSchemas", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region check
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &schemas.ListSchemasInput{
+		RegistryName: registryName,
+		Limit:        aws.Int32(maxLimit),
+	}
+
+	paginator := schemas.NewListSchemasPaginator(svc, input, func(o *schemas.ListSchemasPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_schemas_schema.listSchemasSchemas", "api_error", err)
+			return nil, err
+		}
+
+		for _, schema := range output.Schemas {
+			d.StreamListItem(ctx, SchemasSchemaInfo{
+				RegistryName: registryName,
+				VersionCount: schema.VersionCount,
+				DescribeSchemaOutput: schemas.DescribeSchemaOutput{
+					SchemaName:   schema.SchemaName,
+					SchemaArn:    schema.SchemaArn,
+					LastModified: schema.LastModified,
+					Tags:         schema.Tags,
+				},
+			})
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getSchemasSchema(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var registryName, schemaName string
+	var versionCount *int64
+	if h.Item != nil {
+		schema := h.Item.(SchemasSchemaInfo)
+		registryName = aws.ToString(schema.RegistryName)
+		schemaName = aws.ToString(schema.SchemaName)
+		versionCount = schema.VersionCount
+	} else {
+		registryName = d.EqualsQualString("registry_name")
+		schemaName = d.EqualsQualString("schema_name")
+	}
+
+	// Empty check
+	if registryName == "" || schemaName == "" {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := SchemasClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_schemas_schema.getSchemasSchema", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region check
+		return nil, nil
+	}
+
+	params := &schemas.DescribeSchemaInput{
+		RegistryName: aws.String(registryName),
+		SchemaName:   aws.String(schemaName),
+	}
+
+	op, err := svc.DescribeSchema(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_schemas_schema.getSchemasSchema", "api_error", err)
+		return nil, err
+	}
+
+	return SchemasSchemaInfo{
+		RegistryName:         aws.String(registryName),
+		VersionCount:         versionCount,
+		DescribeSchemaOutput: *op,
+	}, nil
+}
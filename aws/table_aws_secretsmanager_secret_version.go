@@ -0,0 +1,227 @@
+package aws
+
+import (
+	"context"
+	"encoding/base64"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+// SecretsManagerSecretVersionInfo is a version of a secret, along with the
+// secret it belongs to
+type SecretsManagerSecretVersionInfo struct {
+	types.SecretVersionsListEntry
+	SecretArn  *string
+	SecretName *string
+}
+
+//// TABLE DEFINITION
+
+func tableAwsSecretsManagerSecretVersion(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_secretsmanager_secret_version",
+		Description: "AWS Secrets Manager Secret Version",
+		List: &plugin.ListConfig{
+			ParentHydrate: listSecretsManagerSecrets,
+			Hydrate:       listSecretsManagerSecretVersions,
+			Tags:          map[string]string{"service": "secretsmanager", "action": "ListSecretVersionIds"},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException"}),
+			},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "secret_arn", Require: plugin.Optional},
+				{Name: "include_deprecated", Require: plugin.Optional, Operators: []string{"="}},
+			},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getSecretsManagerSecretVersionValue,
+				Tags: map[string]string{"service": "secretsmanager", "action": "GetSecretValue"},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(AWS_SECRETSMANAGER_SERVICE_ID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "secret_name",
+				Description: "The friendly name of the secret.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "secret_arn",
+				Description: "The Amazon Resource Name (ARN) of the secret.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "version_id",
+				Description: "The unique version identifier of this version of the secret.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "version_stages",
+				Description: "The staging labels that are currently attached to this version of the secret, such as AWSCURRENT, AWSPENDING and AWSPREVIOUS. Deprecated versions have no staging labels.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "created_date",
+				Description: "The date and time this version of the secret was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "last_accessed_date",
+				Description: "The date that this version of the secret was last accessed. The resolution of this field is at the date level.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "kms_key_ids",
+				Description: "The KMS keys used to encrypt this version of the secret.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "include_deprecated",
+				Description: "If true, deprecated versions that have no staging labels are included. Secrets Manager keeps deprecated versions until they are removed by the service. Defaults to false.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromQual("include_deprecated"),
+			},
+			{
+				Name:        "secret_string",
+				Description: "The decrypted secret value, if it is a string. Only returned when secretsmanager_include_secret_values is true in the connection config.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getSecretsManagerSecretVersionValue,
+				Transform:   transform.FromField("SecretString"),
+			},
+			{
+				Name:        "secret_binary",
+				Description: "The base64-encoded decrypted secret value, if it is binary. Only returned when secretsmanager_include_secret_values is true in the connection config.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getSecretsManagerSecretVersionValue,
+				Transform:   transform.FromField("SecretBinary").Transform(secretsManagerSecretBinaryToBase64),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("VersionId"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listSecretsManagerSecretVersions(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	secret := h.Item.(types.SecretListEntry)
+
+	// Minimize the API call with the given secret ARN
+	if d.EqualsQualString("secret_arn") != "" && d.EqualsQualString("secret_arn") != aws.ToString(secret.ARN) {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := SecretsManagerClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_secretsmanager_secret_version.listSecretsManagerSecretVersions", "connection_error", err)
+		return nil, err
+	}
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &secretsmanager.ListSecretVersionIdsInput{
+		SecretId:   secret.ARN,
+		MaxResults: aws.Int32(maxLimit),
+	}
+	if d.EqualsQuals["include_deprecated"] != nil {
+		input.IncludeDeprecated = aws.Bool(d.EqualsQuals["include_deprecated"].GetBoolValue())
+	}
+
+	paginator := secretsmanager.NewListSecretVersionIdsPaginator(svc, input, func(o *secretsmanager.ListSecretVersionIdsPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_secretsmanager_secret_version.listSecretsManagerSecretVersions", "api_error", err)
+			return nil, err
+		}
+
+		for _, version := range output.Versions {
+			d.StreamListItem(ctx, &SecretsManagerSecretVersionInfo{
+				SecretVersionsListEntry: version,
+				SecretArn:               secret.ARN,
+				SecretName:              secret.Name,
+			})
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getSecretsManagerSecretVersionValue(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Secret values are only read if the connection config explicitly opts in
+	awsSpcConfig := GetConfig(d.Connection)
+	if awsSpcConfig.SecretsManagerIncludeSecretValues == nil || !*awsSpcConfig.SecretsManagerIncludeSecretValues {
+		return nil, nil
+	}
+
+	version := h.Item.(*SecretsManagerSecretVersionInfo)
+
+	// Create session
+	svc, err := SecretsManagerClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_secretsmanager_secret_version.getSecretsManagerSecretVersionValue", "connection_error", err)
+		return nil, err
+	}
+
+	params := &secretsmanager.GetSecretValueInput{
+		SecretId:  version.SecretArn,
+		VersionId: version.VersionId,
+	}
+
+	op, err := svc.GetSecretValue(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_secretsmanager_secret_version.getSecretsManagerSecretVersionValue", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func secretsManagerSecretBinaryToBase64(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	secretBinary, ok := d.Value.([]byte)
+	if !ok || secretBinary == nil {
+		return nil, nil
+	}
+	return base64.StdEncoding.EncodeToString(secretBinary), nil
+}
@@ -0,0 +1,277 @@
+package aws
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/securityhub"
+	"github.com/aws/aws-sdk-go-v2/service/securityhub/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsSecurityHubAutomationRule(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_securityhub_automation_rule",
+		Description: "AWS Security Hub Automation Rule",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("arn"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException", "InvalidInputException", "InvalidAccessException"}),
+			},
+			Hydrate: getSecurityHubAutomationRule,
+			Tags:    map[string]string{"service": "securityhub", "action": "BatchGetAutomationRules"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listSecurityHubAutomationRules,
+			Tags:    map[string]string{"service": "securityhub", "action": "ListAutomationRules"},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"InvalidAccessException"}),
+			},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getSecurityHubAutomationRule,
+				Tags: map[string]string{"service": "securityhub", "action": "BatchGetAutomationRules"},
+			},
+			{
+				Func: getSecurityHubAutomationRuleTags,
+				Tags: map[string]string{"service": "securityhub", "action": "ListTagsForResource"},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(AWS_SECURITYHUB_SERVICE_ID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "rule_name",
+				Description: "The name of the rule.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the rule.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RuleArn"),
+			},
+			{
+				Name:        "rule_status",
+				Description: "Whether the rule is active after it is created. Can be ENABLED or DISABLED.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "rule_order",
+				Description: "An integer ranging from 1 to 1000 that represents the order in which the rule action is applied to findings. Security Hub applies rules with lower values first.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "is_terminal",
+				Description: "Specifies whether a rule is the last to be applied with respect to a finding that matches the rule criteria.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "description",
+				Description: "A description of the rule.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "created_at",
+				Description: "A timestamp that indicates when the rule was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "created_by",
+				Description: "The principal that created the rule.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "updated_at",
+				Description: "A timestamp that indicates when the rule was most recently updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "criteria",
+				Description: "A set of Amazon Web Services Security Finding Format finding field attributes and corresponding expected values that Security Hub uses to filter findings.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getSecurityHubAutomationRule,
+			},
+			{
+				Name:        "actions",
+				Description: "One or more actions to update finding fields if a finding matches the rule criteria.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getSecurityHubAutomationRule,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RuleName"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getSecurityHubAutomationRuleTags,
+				Transform:   transform.FromField("Tags"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("RuleArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listSecurityHubAutomationRules(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := SecurityHubClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_securityhub_automation_rule.listSecurityHubAutomationRules", "client_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &securityhub.ListAutomationRulesInput{
+		MaxResults: aws.Int32(maxLimit),
+	}
+
+	// API doesn't support aws-sdk-go-v2 paginator as of date.
+	pagesLeft := true
+	for pagesLeft {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := svc.ListAutomationRules(ctx, input)
+		if err != nil {
+			// Handle error for accounts that are not subscribed to AWS Security Hub
+			if strings.Contains(err.Error(), "not subscribed") {
+				return nil, nil
+			}
+			plugin.Logger(ctx).Error("aws_securityhub_automation_rule.listSecurityHubAutomationRules", "api_error", err)
+			return nil, err
+		}
+
+		for _, rule := range output.AutomationRulesMetadata {
+			d.StreamListItem(ctx, rule)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if output.NextToken != nil {
+			input.NextToken = output.NextToken
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getSecurityHubAutomationRule(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var arn string
+	if h.Item != nil {
+		switch item := h.Item.(type) {
+		case types.AutomationRulesMetadata:
+			arn = aws.ToString(item.RuleArn)
+		case types.AutomationRulesConfig:
+			return item, nil
+		}
+	} else {
+		arn = d.EqualsQualString("arn")
+	}
+
+	// Entry check
+	if arn == "" {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := SecurityHubClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_securityhub_automation_rule.getSecurityHubAutomationRule", "client_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &securityhub.BatchGetAutomationRulesInput{
+		AutomationRulesArns: []string{arn},
+	}
+
+	op, err := svc.BatchGetAutomationRules(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_securityhub_automation_rule.getSecurityHubAutomationRule", "api_error", err)
+		return nil, err
+	}
+
+	if len(op.Rules) > 0 {
+		return op.Rules[0], nil
+	}
+
+	return nil, nil
+}
+
+func getSecurityHubAutomationRuleTags(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var arn *string
+	switch item := h.Item.(type) {
+	case types.AutomationRulesMetadata:
+		arn = item.RuleArn
+	case types.AutomationRulesConfig:
+		arn = item.RuleArn
+	}
+
+	// Create session
+	svc, err := SecurityHubClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_securityhub_automation_rule.getSecurityHubAutomationRuleTags", "client_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &securityhub.ListTagsForResourceInput{
+		ResourceArn: arn,
+	}
+
+	op, err := svc.ListTagsForResource(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_securityhub_automation_rule.getSecurityHubAutomationRuleTags", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
@@ -0,0 +1,289 @@
+package aws
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/securityhub"
+	"github.com/aws/aws-sdk-go-v2/service/securityhub/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsSecurityHubConfigurationPolicy(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_securityhub_configuration_policy",
+		Description: "AWS Security Hub Configuration Policy",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException", "InvalidInputException", "InvalidAccessException"}),
+			},
+			Hydrate: getSecurityHubConfigurationPolicy,
+			Tags:    map[string]string{"service": "securityhub", "action": "GetConfigurationPolicy"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listSecurityHubConfigurationPolicies,
+			Tags:    map[string]string{"service": "securityhub", "action": "ListConfigurationPolicies"},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"InvalidAccessException"}),
+			},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getSecurityHubConfigurationPolicy,
+				Tags: map[string]string{"service": "securityhub", "action": "GetConfigurationPolicy"},
+			},
+			{
+				Func: getSecurityHubConfigurationPolicyTags,
+				Tags: map[string]string{"service": "securityhub", "action": "ListTagsForResource"},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(AWS_SECURITYHUB_SERVICE_ID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the configuration policy.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The universally unique identifier (UUID) of the configuration policy.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the configuration policy.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the configuration policy.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "service_enabled",
+				Description: "Indicates whether the configuration policy enables or disables Security Hub in the target accounts.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getSecurityHubConfigurationPolicy,
+				Transform:   transform.FromP(securityHubConfigurationPolicyField, "ServiceEnabled"),
+			},
+			{
+				Name:        "created_at",
+				Description: "The date and time when the configuration policy was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Hydrate:     getSecurityHubConfigurationPolicy,
+			},
+			{
+				Name:        "updated_at",
+				Description: "The date and time when the configuration policy was last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "enabled_standard_identifiers",
+				Description: "The ARNs of the standards that are enabled in the target accounts.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getSecurityHubConfigurationPolicy,
+				Transform:   transform.FromP(securityHubConfigurationPolicyField, "EnabledStandardIdentifiers"),
+			},
+			{
+				Name:        "security_controls_configuration",
+				Description: "The security controls that are enabled or disabled in the target accounts, and their custom parameters.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getSecurityHubConfigurationPolicy,
+				Transform:   transform.FromP(securityHubConfigurationPolicyField, "SecurityControlsConfiguration"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getSecurityHubConfigurationPolicyTags,
+				Transform:   transform.FromField("Tags"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listSecurityHubConfigurationPolicies(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := SecurityHubClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_securityhub_configuration_policy.listSecurityHubConfigurationPolicies", "client_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &securityhub.ListConfigurationPoliciesInput{
+		MaxResults: aws.Int32(maxLimit),
+	}
+
+	paginator := securityhub.NewListConfigurationPoliciesPaginator(svc, input, func(o *securityhub.ListConfigurationPoliciesPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			// Handle error for accounts that are not subscribed to AWS Security Hub
+			if strings.Contains(err.Error(), "not subscribed") {
+				return nil, nil
+			}
+			plugin.Logger(ctx).Error("aws_securityhub_configuration_policy.listSecurityHubConfigurationPolicies", "api_error", err)
+			return nil, err
+		}
+
+		for _, policy := range output.ConfigurationPolicySummaries {
+			d.StreamListItem(ctx, policy)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getSecurityHubConfigurationPolicy(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var id string
+	if h.Item != nil {
+		switch item := h.Item.(type) {
+		case types.ConfigurationPolicySummary:
+			id = aws.ToString(item.Id)
+		case *securityhub.GetConfigurationPolicyOutput:
+			return item, nil
+		}
+	} else {
+		id = d.EqualsQualString("id")
+	}
+
+	// Entry check
+	if id == "" {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := SecurityHubClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_securityhub_configuration_policy.getSecurityHubConfigurationPolicy", "client_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &securityhub.GetConfigurationPolicyInput{
+		Identifier: aws.String(id),
+	}
+
+	op, err := svc.GetConfigurationPolicy(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_securityhub_configuration_policy.getSecurityHubConfigurationPolicy", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
+
+func getSecurityHubConfigurationPolicyTags(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var arn *string
+	switch item := h.Item.(type) {
+	case types.ConfigurationPolicySummary:
+		arn = item.Arn
+	case *securityhub.GetConfigurationPolicyOutput:
+		arn = item.Arn
+	}
+
+	// Create session
+	svc, err := SecurityHubClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_securityhub_configuration_policy.getSecurityHubConfigurationPolicyTags", "client_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &securityhub.ListTagsForResourceInput{
+		ResourceArn: arn,
+	}
+
+	op, err := svc.ListTagsForResource(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_securityhub_configuration_policy.getSecurityHubConfigurationPolicyTags", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+// securityHubConfigurationPolicyField returns a field of the Security Hub
+// policy, which is the only member of the configuration policy union
+func securityHubConfigurationPolicyField(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	policy, ok := d.HydrateItem.(*securityhub.GetConfigurationPolicyOutput)
+	if !ok {
+		return nil, nil
+	}
+	member, ok := policy.ConfigurationPolicy.(*types.PolicyMemberSecurityHub)
+	if !ok {
+		return nil, nil
+	}
+
+	switch d.Param.(string) {
+	case "ServiceEnabled":
+		return member.Value.ServiceEnabled, nil
+	case "EnabledStandardIdentifiers":
+		return member.Value.EnabledStandardIdentifiers, nil
+	case "SecurityControlsConfiguration":
+		return member.Value.SecurityControlsConfiguration, nil
+	}
+	return nil, nil
+}
@@ -0,0 +1,153 @@
+package aws
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/securityhub"
+	"github.com/aws/aws-sdk-go-v2/service/securityhub/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsSecurityHubConfigurationPolicyAssociation(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_securityhub_configuration_policy_association",
+		Description: "AWS Security Hub Configuration Policy Association",
+		List: &plugin.ListConfig{
+			Hydrate: listSecurityHubConfigurationPolicyAssociations,
+			Tags:    map[string]string{"service": "securityhub", "action": "ListConfigurationPolicyAssociations"},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"InvalidAccessException"}),
+			},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "association_status", Require: plugin.Optional},
+				{Name: "association_type", Require: plugin.Optional},
+				{Name: "configuration_policy_id", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(AWS_SECURITYHUB_SERVICE_ID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "target_id",
+				Description: "The identifier of the target account, organizational unit, or the root.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "target_type",
+				Description: "Specifies whether the target is an AWS account, organizational unit, or the root.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "configuration_policy_id",
+				Description: "The universally unique identifier (UUID) of the configuration policy, or SELF_MANAGED_SECURITY_HUB for self-managed targets.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "association_status",
+				Description: "The current status of the association between the target and the configuration. Can be PENDING, SUCCESS or FAILED.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "association_status_message",
+				Description: "The explanation for a FAILED value for association_status.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "association_type",
+				Description: "Indicates whether the association was directly applied by the Security Hub delegated administrator (APPLIED) or inherited from a parent (INHERITED).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "updated_at",
+				Description: "The date and time when the configuration policy association was last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listSecurityHubConfigurationPolicyAssociations(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := SecurityHubClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_securityhub_configuration_policy_association.listSecurityHubConfigurationPolicyAssociations", "client_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &securityhub.ListConfigurationPolicyAssociationsInput{
+		MaxResults: aws.Int32(maxLimit),
+	}
+
+	filters := &types.AssociationFilters{}
+	hasFilter := false
+	if d.EqualsQualString("association_status") != "" {
+		filters.AssociationStatus = types.ConfigurationPolicyAssociationStatus(d.EqualsQualString("association_status"))
+		hasFilter = true
+	}
+	if d.EqualsQualString("association_type") != "" {
+		filters.AssociationType = types.AssociationType(d.EqualsQualString("association_type"))
+		hasFilter = true
+	}
+	if d.EqualsQualString("configuration_policy_id") != "" {
+		filters.ConfigurationPolicyId = aws.String(d.EqualsQualString("configuration_policy_id"))
+		hasFilter = true
+	}
+	if hasFilter {
+		input.Filters = filters
+	}
+
+	paginator := securityhub.NewListConfigurationPolicyAssociationsPaginator(svc, input, func(o *securityhub.ListConfigurationPolicyAssociationsPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			// Handle error for accounts that are not subscribed to AWS Security Hub
+			if strings.Contains(err.Error(), "not subscribed") {
+				return nil, nil
+			}
+			plugin.Logger(ctx).Error("aws_securityhub_configuration_policy_association.listSecurityHubConfigurationPolicyAssociations", "api_error", err)
+			return nil, err
+		}
+
+		for _, association := range output.ConfigurationPolicyAssociationSummaries {
+			d.StreamListItem(ctx, association)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,279 @@
+package aws
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/securityhub"
+	"github.com/aws/aws-sdk-go-v2/service/securityhub/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+// SecurityHubSecurityControlInfo is a security control merged with its
+// definition, which carries the fields that BatchGetSecurityControls omits
+type SecurityHubSecurityControlInfo struct {
+	types.SecurityControl
+	CurrentRegionAvailability types.RegionAvailabilityStatus
+	CustomizableProperties    []types.SecurityControlProperty
+	ParameterDefinitions      map[string]types.ParameterDefinition
+}
+
+//// TABLE DEFINITION
+
+func tableAwsSecurityHubSecurityControl(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_securityhub_security_control",
+		Description: "AWS Security Hub Security Control",
+		List: &plugin.ListConfig{
+			Hydrate: listSecurityHubSecurityControls,
+			Tags:    map[string]string{"service": "securityhub", "action": "ListSecurityControlDefinitions"},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"InvalidAccessException"}),
+			},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "security_control_id", Require: plugin.Optional},
+			},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: listSecurityHubSecurityControlStandardsAssociations,
+				Tags: map[string]string{"service": "securityhub", "action": "ListStandardsControlAssociations"},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(AWS_SECURITYHUB_SERVICE_ID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "security_control_id",
+				Description: "The unique identifier of the security control across standards, such as APIGateway.3.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the security control across standards.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SecurityControlArn"),
+			},
+			{
+				Name:        "title",
+				Description: "The title of the security control.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the security control across standards.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "security_control_status",
+				Description: "The enablement status of the security control. Can be ENABLED or DISABLED.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "severity_rating",
+				Description: "The severity of the security control.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "update_status",
+				Description: "Identifies whether customizable properties of the security control are reflected in Security Hub findings. Can be READY or UPDATING.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "current_region_availability",
+				Description: "Specifies whether the security control is available in the current region. Can be AVAILABLE or UNAVAILABLE.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "last_update_reason",
+				Description: "The most recent reason for updating the customizable properties of the security control.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "remediation_url",
+				Description: "A link to Security Hub documentation that explains how to remediate a failed finding for the security control.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "customizable_properties",
+				Description: "Security control properties that you can customize.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "parameters",
+				Description: "The current values of the control parameters, and whether they have been customized.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "parameter_definitions",
+				Description: "The name, description and options for customizing each parameter of the security control.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "standards_control_associations",
+				Description: "The enablement status of the security control in each standard it belongs to.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listSecurityHubSecurityControlStandardsAssociations,
+				Transform:   transform.FromValue(),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("SecurityControlArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listSecurityHubSecurityControls(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := SecurityHubClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_securityhub_security_control.listSecurityHubSecurityControls", "client_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	securityControlId := d.EqualsQualString("security_control_id")
+
+	// BatchGetSecurityControls accepts at most 100 IDs, so the page size
+	// of the definitions is kept within that limit
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil && securityControlId == "" {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &securityhub.ListSecurityControlDefinitionsInput{
+		MaxResults: aws.Int32(maxLimit),
+	}
+
+	paginator := securityhub.NewListSecurityControlDefinitionsPaginator(svc, input, func(o *securityhub.ListSecurityControlDefinitionsPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			// Handle error for accounts that are not subscribed to AWS Security Hub
+			if strings.Contains(err.Error(), "not subscribed") {
+				return nil, nil
+			}
+			plugin.Logger(ctx).Error("aws_securityhub_security_control.listSecurityHubSecurityControls", "api_error", err)
+			return nil, err
+		}
+
+		var definitions []types.SecurityControlDefinition
+		var ids []string
+		for _, definition := range output.SecurityControlDefinitions {
+			if securityControlId != "" && aws.ToString(definition.SecurityControlId) != securityControlId {
+				continue
+			}
+			definitions = append(definitions, definition)
+			ids = append(ids, aws.ToString(definition.SecurityControlId))
+		}
+		if len(ids) == 0 {
+			continue
+		}
+
+		// Get the account level status of the controls in the page
+		controls, err := svc.BatchGetSecurityControls(ctx, &securityhub.BatchGetSecurityControlsInput{
+			SecurityControlIds: ids,
+		})
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_securityhub_security_control.listSecurityHubSecurityControls", "batch_get_error", err)
+			return nil, err
+		}
+		controlById := map[string]types.SecurityControl{}
+		for _, control := range controls.SecurityControls {
+			controlById[aws.ToString(control.SecurityControlId)] = control
+		}
+
+		for _, definition := range definitions {
+			control, ok := controlById[aws.ToString(definition.SecurityControlId)]
+			if !ok {
+				// Controls that could not be processed still have a definition
+				control = types.SecurityControl{
+					SecurityControlId: definition.SecurityControlId,
+					Title:             definition.Title,
+					Description:       definition.Description,
+					RemediationUrl:    definition.RemediationUrl,
+					SeverityRating:    definition.SeverityRating,
+				}
+			}
+			d.StreamListItem(ctx, &SecurityHubSecurityControlInfo{
+				SecurityControl:           control,
+				CurrentRegionAvailability: definition.CurrentRegionAvailability,
+				CustomizableProperties:    definition.CustomizableProperties,
+				ParameterDefinitions:      definition.ParameterDefinitions,
+			})
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func listSecurityHubSecurityControlStandardsAssociations(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	control := h.Item.(*SecurityHubSecurityControlInfo)
+
+	// Create session
+	svc, err := SecurityHubClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_securityhub_security_control.listSecurityHubSecurityControlStandardsAssociations", "client_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	input := &securityhub.ListStandardsControlAssociationsInput{
+		SecurityControlId: control.SecurityControlId,
+		MaxResults:        aws.Int32(100),
+	}
+
+	paginator := securityhub.NewListStandardsControlAssociationsPaginator(svc, input, func(o *securityhub.ListStandardsControlAssociationsPaginatorOptions) {
+		o.Limit = 100
+		o.StopOnDuplicateToken = true
+	})
+
+	var associations []types.StandardsControlAssociationSummary
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_securityhub_security_control.listSecurityHubSecurityControlStandardsAssociations", "api_error", err)
+			return nil, err
+		}
+		associations = append(associations, output.StandardsControlAssociationSummaries...)
+	}
+
+	return associations, nil
+}
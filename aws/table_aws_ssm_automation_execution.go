@@ -0,0 +1,489 @@
+package aws
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsSSMAutomationExecution(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_ssm_automation_execution",
+		Description: "AWS SSM Automation Execution",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("automation_execution_id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"AutomationExecutionNotFoundException", "ValidationException"}),
+			},
+			Hydrate: getAwsSSMAutomationExecution,
+			Tags:    map[string]string{"service": "ssm", "action": "GetAutomationExecution"},
+		},
+		List: &plugin.ListConfig{
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"InvalidFilterKey", "InvalidFilterValue"}),
+			},
+			Hydrate: listAwsSSMAutomationExecutions,
+			Tags:    map[string]string{"service": "ssm", "action": "DescribeAutomationExecutions"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "automation_execution_status", Require: plugin.Optional},
+				{Name: "automation_type", Require: plugin.Optional},
+				{Name: "parent_automation_execution_id", Require: plugin.Optional},
+				{Name: "ops_item_id", Require: plugin.Optional},
+				{Name: "execution_start_time", Require: plugin.Optional, Operators: []string{">", ">=", "<", "<="}},
+			},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getAwsSSMAutomationExecution,
+				Tags: map[string]string{"service": "ssm", "action": "GetAutomationExecution"},
+			},
+			{
+				Func: getAwsSSMAutomationStepExecutions,
+				Tags: map[string]string{"service": "ssm", "action": "DescribeAutomationStepExecutions"},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(AWS_SSM_SERVICE_ID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "automation_execution_id",
+				Description: "The execution ID.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the automation execution.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getSSMAutomationExecutionARN,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "document_name",
+				Description: "The name of the Automation runbook used during execution.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "document_version",
+				Description: "The document version used during the execution.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "automation_execution_status",
+				Description: "The status of the execution, e.g. Pending, InProgress, Waiting, Success, TimedOut, Cancelled or Failed.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "automation_type",
+				Description: "The type of the automation, either Local or CrossAccount. CrossAccount is an automation that runs in multiple Regions and accounts.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "automation_subtype",
+				Description: "The subtype of the Automation operation, e.g. ChangeRequest for Change Manager.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "mode",
+				Description: "The automation execution mode, e.g. Auto or Interactive.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "executed_by",
+				Description: "The IAM role ARN of the user who ran the automation.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "execution_start_time",
+				Description: "The time the execution started.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "execution_end_time",
+				Description: "The time the execution finished. This isn't populated if the execution is still in progress.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "scheduled_time",
+				Description: "The date and time the automation operation is scheduled to start.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "current_step_name",
+				Description: "The name of the step that is currently running.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "current_action",
+				Description: "The action of the step that is currently running.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "failure_message",
+				Description: "A message describing why the execution failed, if it failed.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "parent_automation_execution_id",
+				Description: "The execution ID of the parent automation.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "association_id",
+				Description: "The ID of a State Manager association used in the Automation operation.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "ops_item_id",
+				Description: "The ID of an OpsItem that is created to represent a Change Manager change request.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "change_request_name",
+				Description: "The name of the Change Manager change request.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "target",
+				Description: "The target of the execution.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "target_parameter_name",
+				Description: "The parameter name used to target resources in a rate control execution.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "max_concurrency",
+				Description: "The maximum number of targets allowed to run the automation in parallel.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "max_errors",
+				Description: "The maximum number of errors allowed before the system stops running the automation on additional targets.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "log_file",
+				Description: "An S3 bucket where execution information is stored.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "target_locations_url",
+				Description: "A publicly accessible URL for a file that contains the target locations of the execution.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "progress_counters",
+				Description: "The number of total, successful, failed, cancelled and timed out steps of the execution.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getAwsSSMAutomationExecution,
+			},
+			{
+				Name:        "parameters",
+				Description: "The key-value map of execution parameters, which were supplied when calling StartAutomationExecution.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getAwsSSMAutomationExecution,
+			},
+			{
+				Name:        "variables",
+				Description: "Variables defined for the automation.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getAwsSSMAutomationExecution,
+			},
+			{
+				Name:        "outputs",
+				Description: "The list of execution outputs as defined in the Automation runbook.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "step_executions",
+				Description: "The steps of the execution, in order, with their action, status, inputs, outputs and failure details.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getAwsSSMAutomationStepExecutions,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "targets",
+				Description: "The targets defined by the user when starting the automation.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "target_maps",
+				Description: "The specified key-value mapping of document parameters to target resources.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "resolved_targets",
+				Description: "A list of targets that resolved during the execution.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "target_locations",
+				Description: "The combination of Amazon Web Services Regions and accounts where the automation ran.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getAwsSSMAutomationExecution,
+			},
+			{
+				Name:        "runbooks",
+				Description: "Information about the Automation runbooks that are run during a runbook workflow in Change Manager.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "alarm_configuration",
+				Description: "The details for the CloudWatch alarm applied to the automation operation.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "triggered_alarms",
+				Description: "The CloudWatch alarm that was invoked by the automation.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AutomationExecutionId"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getSSMAutomationExecutionARN,
+				Transform:   transform.FromValue().Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAwsSSMAutomationExecutions(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := SSMClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ssm_automation_execution.listAwsSSMAutomationExecutions", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region check
+		return nil, nil
+	}
+
+	maxItems := int32(50)
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxItems {
+			if limit < 1 {
+				maxItems = int32(1)
+			} else {
+				maxItems = int32(limit)
+			}
+		}
+	}
+
+	input := &ssm.DescribeAutomationExecutionsInput{
+		MaxResults: aws.Int32(maxItems),
+	}
+
+	filters := buildSSMAutomationExecutionFilter(d.Quals)
+	if len(filters) > 0 {
+		input.Filters = filters
+	}
+
+	paginator := ssm.NewDescribeAutomationExecutionsPaginator(svc, input, func(o *ssm.DescribeAutomationExecutionsPaginatorOptions) {
+		o.Limit = maxItems
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_ssm_automation_execution.listAwsSSMAutomationExecutions", "api_error", err)
+			return nil, err
+		}
+
+		for _, execution := range output.AutomationExecutionMetadataList {
+			d.StreamListItem(ctx, execution)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getAwsSSMAutomationExecution(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var id string
+	switch item := h.Item.(type) {
+	case types.AutomationExecutionMetadata:
+		id = aws.ToString(item.AutomationExecutionId)
+	case types.AutomationExecution:
+		return item, nil
+	default:
+		id = d.EqualsQualString("automation_execution_id")
+	}
+
+	// Empty input id check
+	if id == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := SSMClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ssm_automation_execution.getAwsSSMAutomationExecution", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region check
+		return nil, nil
+	}
+
+	output, err := svc.GetAutomationExecution(ctx, &ssm.GetAutomationExecutionInput{
+		AutomationExecutionId: aws.String(id),
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ssm_automation_execution.getAwsSSMAutomationExecution", "api_error", err)
+		return nil, err
+	}
+
+	if output.AutomationExecution != nil {
+		return *output.AutomationExecution, nil
+	}
+
+	return nil, nil
+}
+
+func getAwsSSMAutomationStepExecutions(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	id := ssmAutomationExecutionID(h.Item)
+
+	// Create Session
+	svc, err := SSMClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ssm_automation_execution.getAwsSSMAutomationStepExecutions", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region check
+		return nil, nil
+	}
+
+	// GetAutomationExecution truncates the step executions, so they are listed
+	// separately to return all the steps of the execution
+	input := &ssm.DescribeAutomationStepExecutionsInput{
+		AutomationExecutionId: aws.String(id),
+		MaxResults:            aws.Int32(50),
+	}
+
+	paginator := ssm.NewDescribeAutomationStepExecutionsPaginator(svc, input, func(o *ssm.DescribeAutomationStepExecutionsPaginatorOptions) {
+		o.Limit = 50
+		o.StopOnDuplicateToken = true
+	})
+
+	steps := []types.StepExecution{}
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_ssm_automation_execution.getAwsSSMAutomationStepExecutions", "api_error", err)
+			return nil, err
+		}
+		steps = append(steps, output.StepExecutions...)
+	}
+
+	return steps, nil
+}
+
+func getSSMAutomationExecutionARN(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	region := d.EqualsQualString(matrixKeyRegion)
+	id := ssmAutomationExecutionID(h.Item)
+
+	c, err := getCommonColumns(ctx, d, h)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ssm_automation_execution.getSSMAutomationExecutionARN", "common_data_error", err)
+		return nil, err
+	}
+	commonColumnData := c.(*awsCommonColumnData)
+	arn := "arn:" + commonColumnData.Partition + ":ssm:" + region + ":" + commonColumnData.AccountId + ":automation-execution/" + id
+
+	return arn, nil
+}
+
+func ssmAutomationExecutionID(item interface{}) string {
+	switch item := item.(type) {
+	case types.AutomationExecutionMetadata:
+		return aws.ToString(item.AutomationExecutionId)
+	case types.AutomationExecution:
+		return aws.ToString(item.AutomationExecutionId)
+	}
+	return ""
+}
+
+//// UTILITY FUNCTION
+
+// Build ssm automation execution list call input filter
+func buildSSMAutomationExecutionFilter(quals plugin.KeyColumnQualMap) []types.AutomationExecutionFilter {
+	filters := make([]types.AutomationExecutionFilter, 0)
+
+	filterQuals := map[string]types.AutomationExecutionFilterKey{
+		"automation_execution_status":    types.AutomationExecutionFilterKeyExecutionStatus,
+		"automation_type":                types.AutomationExecutionFilterKeyAutomationType,
+		"parent_automation_execution_id": types.AutomationExecutionFilterKeyParentExecutionId,
+		"ops_item_id":                    types.AutomationExecutionFilterKeyOpsItemId,
+	}
+
+	for columnName, filterKey := range filterQuals {
+		if quals[columnName] != nil {
+			value := getQualsValueByColumn(quals, columnName, "string")
+			val, ok := value.(string)
+			if ok {
+				filters = append(filters, types.AutomationExecutionFilter{
+					Key:    filterKey,
+					Values: []string{val},
+				})
+			}
+		}
+	}
+
+	if quals["execution_start_time"] != nil {
+		for _, q := range quals["execution_start_time"].Quals {
+			timestamp := q.Value.GetTimestampValue().AsTime().Format(time.RFC3339)
+			switch q.Operator {
+			case ">=", ">":
+				filters = append(filters, types.AutomationExecutionFilter{
+					Key:    types.AutomationExecutionFilterKeyStartTimeAfter,
+					Values: []string{timestamp},
+				})
+			case "<", "<=":
+				filters = append(filters, types.AutomationExecutionFilter{
+					Key:    types.AutomationExecutionFilterKeyStartTimeBefore,
+					Values: []string{timestamp},
+				})
+			}
+		}
+	}
+
+	return filters
+}
@@ -0,0 +1,278 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsSSMChangeRequest(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_ssm_change_request",
+		Description: "AWS SSM Change Manager Change Request",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("automation_execution_id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"AutomationExecutionNotFoundException", "ValidationException"}),
+			},
+			Hydrate: getAwsSSMChangeRequest,
+			Tags:    map[string]string{"service": "ssm", "action": "GetAutomationExecution"},
+		},
+		List: &plugin.ListConfig{
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"InvalidFilterKey", "InvalidFilterValue"}),
+			},
+			Hydrate: listAwsSSMChangeRequests,
+			Tags:    map[string]string{"service": "ssm", "action": "DescribeAutomationExecutions"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "automation_execution_status", Require: plugin.Optional},
+				{Name: "ops_item_id", Require: plugin.Optional},
+				{Name: "execution_start_time", Require: plugin.Optional, Operators: []string{">", ">=", "<", "<="}},
+			},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getAwsSSMChangeRequest,
+				Tags: map[string]string{"service": "ssm", "action": "GetAutomationExecution"},
+			},
+			{
+				Func: getAwsSSMChangeRequestApprovals,
+				Tags: map[string]string{"service": "ssm", "action": "DescribeAutomationStepExecutions"},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(AWS_SSM_SERVICE_ID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "change_request_name",
+				Description: "The name of the change request.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "automation_execution_id",
+				Description: "The ID of the automation execution of the change request.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the automation execution of the change request.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getSSMAutomationExecutionARN,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "ops_item_id",
+				Description: "The ID of the OpsItem that represents the change request.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "document_name",
+				Description: "The name of the change template used by the change request.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "document_version",
+				Description: "The version of the change template used by the change request.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "automation_execution_status",
+				Description: "The status of the change request, e.g. PendingApproval, Approved, Rejected, Scheduled, RunbookInProgress, CompletedWithSuccess or CompletedWithFailure.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "executed_by",
+				Description: "The IAM role ARN of the user who requested the change.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "scheduled_time",
+				Description: "The date and time the runbooks of the change request are scheduled to start.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "execution_start_time",
+				Description: "The time the change request was submitted.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "execution_end_time",
+				Description: "The time the change request finished. This isn't populated if the change request is still in progress.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "current_step_name",
+				Description: "The name of the step that is currently running.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "failure_message",
+				Description: "A message describing why the change request failed, if it failed.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "approvals",
+				Description: "The approval steps of the change request, with their status, approvers and approver decisions.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getAwsSSMChangeRequestApprovals,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "runbooks",
+				Description: "The runbooks that are run when the change request is approved, with their parameters and targets.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "parameters",
+				Description: "The parameters of the change request.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getAwsSSMChangeRequest,
+			},
+			{
+				Name:        "outputs",
+				Description: "The list of execution outputs of the change request.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "alarm_configuration",
+				Description: "The details for the CloudWatch alarm applied to the change request.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "triggered_alarms",
+				Description: "The CloudWatch alarm that was invoked by the change request.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ChangeRequestName"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getSSMAutomationExecutionARN,
+				Transform:   transform.FromValue().Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAwsSSMChangeRequests(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := SSMClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ssm_change_request.listAwsSSMChangeRequests", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region check
+		return nil, nil
+	}
+
+	maxItems := int32(50)
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxItems {
+			if limit < 1 {
+				maxItems = int32(1)
+			} else {
+				maxItems = int32(limit)
+			}
+		}
+	}
+
+	// Change requests are automation executions of the ChangeRequest subtype
+	filters := buildSSMAutomationExecutionFilter(d.Quals)
+	filters = append(filters, types.AutomationExecutionFilter{
+		Key:    types.AutomationExecutionFilterKeyAutomationSubtype,
+		Values: []string{string(types.AutomationSubtypeChangeRequest)},
+	})
+
+	input := &ssm.DescribeAutomationExecutionsInput{
+		Filters:    filters,
+		MaxResults: aws.Int32(maxItems),
+	}
+
+	paginator := ssm.NewDescribeAutomationExecutionsPaginator(svc, input, func(o *ssm.DescribeAutomationExecutionsPaginatorOptions) {
+		o.Limit = maxItems
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_ssm_change_request.listAwsSSMChangeRequests", "api_error", err)
+			return nil, err
+		}
+
+		for _, execution := range output.AutomationExecutionMetadataList {
+			d.StreamListItem(ctx, execution)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getAwsSSMChangeRequest(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	execution, err := getAwsSSMAutomationExecution(ctx, d, h)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ssm_change_request.getAwsSSMChangeRequest", "api_error", err)
+		return nil, err
+	}
+
+	// Other automation executions can be requested by ID, only return change requests
+	if execution, ok := execution.(types.AutomationExecution); ok && execution.AutomationSubtype == types.AutomationSubtypeChangeRequest {
+		return execution, nil
+	}
+
+	return nil, nil
+}
+
+func getAwsSSMChangeRequestApprovals(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	steps, err := getAwsSSMAutomationStepExecutions(ctx, d, h)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ssm_change_request.getAwsSSMChangeRequestApprovals", "api_error", err)
+		return nil, err
+	}
+
+	stepExecutions, ok := steps.([]types.StepExecution)
+	if !ok {
+		return nil, nil
+	}
+
+	// The approval levels of a change template are aws:approve steps
+	approvals := []types.StepExecution{}
+	for _, step := range stepExecutions {
+		if aws.ToString(step.Action) == "aws:approve" {
+			approvals = append(approvals, step)
+		}
+	}
+
+	return approvals, nil
+}
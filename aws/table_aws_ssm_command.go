@@ -0,0 +1,290 @@
+package aws
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsSSMCommand(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_ssm_command",
+		Description: "AWS SSM Command",
+		List: &plugin.ListConfig{
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"InvalidCommandId", "InvalidFilterKey"}),
+			},
+			Hydrate: listAwsSSMCommands,
+			Tags:    map[string]string{"service": "ssm", "action": "ListCommands"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "command_id", Require: plugin.Optional},
+				{Name: "document_name", Require: plugin.Optional},
+				{Name: "status", Require: plugin.Optional},
+				{Name: "requested_date_time", Require: plugin.Optional, Operators: []string{">", ">=", "<", "<="}},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(AWS_SSM_SERVICE_ID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "command_id",
+				Description: "A unique identifier for the command.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "document_name",
+				Description: "The name of the document requested for execution.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "document_version",
+				Description: "The Systems Manager document version.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "comment",
+				Description: "User-specified information about the command, such as a brief description of what the command should do.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "The status of the command, e.g. Pending, InProgress, Success, Cancelled, Failed or TimedOut.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status_details",
+				Description: "A detailed status of the command execution.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "requested_date_time",
+				Description: "The date and time the command was requested.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "expires_after",
+				Description: "The time after which the command can no longer be run, if it hasn't started yet.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "timeout_seconds",
+				Description: "The maximum number of seconds the command can run on a managed node.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "target_count",
+				Description: "The number of targets for the command.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "completed_count",
+				Description: "The number of targets for which the command invocation reached a terminal state.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "error_count",
+				Description: "The number of targets for which the status is Failed or Execution Timed Out.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "delivery_timed_out_count",
+				Description: "The number of targets for which the status is Delivery Timed Out.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "max_concurrency",
+				Description: "The maximum number of managed nodes that are allowed to run the command at the same time.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "max_errors",
+				Description: "The maximum number of errors allowed before the system stops sending the command to additional targets.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "service_role",
+				Description: "The IAM service role that Run Command uses to act on your behalf when sending notifications about command status changes.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "output_s3_bucket_name",
+				Description: "The S3 bucket where the responses to the command executions are stored.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "output_s3_key_prefix",
+				Description: "The S3 directory path inside the bucket where the responses to the command executions are stored.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "output_s3_region",
+				Description: "The Region of the S3 bucket where the responses to the command executions are stored.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "instance_ids",
+				Description: "The managed node IDs against which the command was requested.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "targets",
+				Description: "An array of search criteria that targets managed nodes using a key-value pair.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "parameters",
+				Description: "The parameter values to be inserted in the document when running the command.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "cloud_watch_output_config",
+				Description: "The CloudWatch Logs information where the command output is sent.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "notification_config",
+				Description: "Configurations for sending notifications about command status changes.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "alarm_configuration",
+				Description: "The details for the CloudWatch alarm applied to the command.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "triggered_alarms",
+				Description: "The CloudWatch alarm that was invoked by the command.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CommandId"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAwsSSMCommands(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := SSMClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ssm_command.listAwsSSMCommands", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region check
+		return nil, nil
+	}
+
+	maxItems := int32(50)
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxItems {
+			if limit < 1 {
+				maxItems = int32(1)
+			} else {
+				maxItems = int32(limit)
+			}
+		}
+	}
+
+	input := &ssm.ListCommandsInput{
+		MaxResults: aws.Int32(maxItems),
+	}
+
+	filters := buildSSMCommandFilter(d.Quals)
+	if len(filters) > 0 {
+		input.Filters = filters
+	}
+	if d.EqualsQualString("command_id") != "" {
+		input.CommandId = aws.String(d.EqualsQualString("command_id"))
+	}
+
+	paginator := ssm.NewListCommandsPaginator(svc, input, func(o *ssm.ListCommandsPaginatorOptions) {
+		o.Limit = maxItems
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_ssm_command.listAwsSSMCommands", "api_error", err)
+			return nil, err
+		}
+
+		for _, command := range output.Commands {
+			d.StreamListItem(ctx, command)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// UTILITY FUNCTION
+
+// Build ssm command and command invocation list call input filter
+func buildSSMCommandFilter(quals plugin.KeyColumnQualMap) []types.CommandFilter {
+	filters := make([]types.CommandFilter, 0)
+
+	filterQuals := map[string]types.CommandFilterKey{
+		"document_name": types.CommandFilterKeyDocumentName,
+		"status":        types.CommandFilterKeyStatus,
+	}
+
+	for columnName, filterKey := range filterQuals {
+		if quals[columnName] != nil {
+			value := getQualsValueByColumn(quals, columnName, "string")
+			val, ok := value.(string)
+			if ok {
+				filters = append(filters, types.CommandFilter{
+					Key:   filterKey,
+					Value: aws.String(val),
+				})
+			}
+		}
+	}
+
+	if quals["requested_date_time"] != nil {
+		for _, q := range quals["requested_date_time"].Quals {
+			timestamp := q.Value.GetTimestampValue().AsTime().Format(time.RFC3339)
+			switch q.Operator {
+			case ">=", ">":
+				filters = append(filters, types.CommandFilter{
+					Key:   types.CommandFilterKeyInvokedAfter,
+					Value: aws.String(timestamp),
+				})
+			case "<", "<=":
+				filters = append(filters, types.CommandFilter{
+					Key:   types.CommandFilterKeyInvokedBefore,
+					Value: aws.String(timestamp),
+				})
+			}
+		}
+	}
+
+	return filters
+}
@@ -0,0 +1,227 @@
+package aws
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsSSMCommandInvocation(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_ssm_command_invocation",
+		Description: "AWS SSM Command Invocation",
+		List: &plugin.ListConfig{
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"InvalidCommandId", "InvalidInstanceId", "InvalidFilterKey"}),
+			},
+			Hydrate: listAwsSSMCommandInvocations,
+			Tags:    map[string]string{"service": "ssm", "action": "ListCommandInvocations"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "command_id", Require: plugin.Optional},
+				{Name: "instance_id", Require: plugin.Optional},
+				{Name: "document_name", Require: plugin.Optional},
+				{Name: "status", Require: plugin.Optional},
+				{Name: "requested_date_time", Require: plugin.Optional, Operators: []string{">", ">=", "<", "<="}},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(AWS_SSM_SERVICE_ID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "command_id",
+				Description: "The command against which this invocation was requested.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "instance_id",
+				Description: "The managed node ID in which this invocation was requested.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "instance_name",
+				Description: "The fully qualified host name of the managed node.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "document_name",
+				Description: "The document name that was requested for execution.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "document_version",
+				Description: "The Systems Manager document version.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "comment",
+				Description: "User-specified information about the command, such as a brief description of what the command should do.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "Whether or not the invocation succeeded, e.g. Pending, InProgress, Delayed, Success, Cancelled, Failed or TimedOut.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status_details",
+				Description: "A detailed status of the command execution for the invocation.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "requested_date_time",
+				Description: "The time and date the request was sent to the managed node.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "output",
+				Description: "The output of the invocation, with the output of each plugin on a new line. The output of each plugin is truncated to 2500 characters, the complete output is available at standard_output_url.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CommandPlugins").Transform(ssmCommandInvocationOutput),
+			},
+			{
+				Name:        "standard_output_url",
+				Description: "The S3 URL where the complete standard output of the first plugin of the invocation is stored, if an S3 bucket was specified for the command.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "standard_error_url",
+				Description: "The S3 URL where the complete standard error of the first plugin of the invocation is stored, if an S3 bucket was specified for the command.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "trace_output",
+				Description: "Gets the trace output sent by the agent.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "service_role",
+				Description: "The IAM service role that Run Command uses to act on your behalf when sending notifications about command status changes on a per managed node basis.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "command_plugins",
+				Description: "The plugins run by the invocation, with their status, response code, truncated output and the S3 location of their complete output.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "cloud_watch_output_config",
+				Description: "The CloudWatch Logs information where the invocation output is sent.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "notification_config",
+				Description: "Configurations for sending notifications about command status changes on a per managed node basis.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CommandId"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAwsSSMCommandInvocations(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := SSMClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ssm_command_invocation.listAwsSSMCommandInvocations", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region check
+		return nil, nil
+	}
+
+	maxItems := int32(50)
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxItems {
+			if limit < 1 {
+				maxItems = int32(1)
+			} else {
+				maxItems = int32(limit)
+			}
+		}
+	}
+
+	// Details returns the plugins of each invocation along with their output
+	input := &ssm.ListCommandInvocationsInput{
+		Details:    true,
+		MaxResults: aws.Int32(maxItems),
+	}
+
+	filters := buildSSMCommandFilter(d.Quals)
+	if len(filters) > 0 {
+		input.Filters = filters
+	}
+	if d.EqualsQualString("command_id") != "" {
+		input.CommandId = aws.String(d.EqualsQualString("command_id"))
+	}
+	if d.EqualsQualString("instance_id") != "" {
+		input.InstanceId = aws.String(d.EqualsQualString("instance_id"))
+	}
+
+	paginator := ssm.NewListCommandInvocationsPaginator(svc, input, func(o *ssm.ListCommandInvocationsPaginatorOptions) {
+		o.Limit = maxItems
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_ssm_command_invocation.listAwsSSMCommandInvocations", "api_error", err)
+			return nil, err
+		}
+
+		for _, invocation := range output.CommandInvocations {
+			d.StreamListItem(ctx, invocation)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func ssmCommandInvocationOutput(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	commandPlugins, ok := d.Value.([]types.CommandPlugin)
+	if !ok || len(commandPlugins) == 0 {
+		return nil, nil
+	}
+
+	outputs := []string{}
+	for _, commandPlugin := range commandPlugins {
+		if commandPlugin.Output != nil && *commandPlugin.Output != "" {
+			outputs = append(outputs, *commandPlugin.Output)
+		}
+	}
+	if len(outputs) == 0 {
+		return nil, nil
+	}
+	return strings.Join(outputs, "\n"), nil
+}
@@ -0,0 +1,495 @@
+package aws
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsSSMOpsItem(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_ssm_ops_item",
+		Description: "AWS SSM OpsItem",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("ops_item_id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"OpsItemNotFoundException", "ValidationException"}),
+			},
+			Hydrate: getAwsSSMOpsItem,
+			Tags:    map[string]string{"service": "ssm", "action": "GetOpsItem"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listAwsSSMOpsItems,
+			Tags:    map[string]string{"service": "ssm", "action": "DescribeOpsItems"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "status", Require: plugin.Optional},
+				{Name: "source", Require: plugin.Optional},
+				{Name: "severity", Require: plugin.Optional},
+				{Name: "category", Require: plugin.Optional},
+				{Name: "ops_item_type", Require: plugin.Optional},
+				{Name: "priority", Require: plugin.Optional},
+				{Name: "created_time", Require: plugin.Optional, Operators: []string{">", ">=", "<", "<="}},
+				{Name: "last_modified_time", Require: plugin.Optional, Operators: []string{">", ">=", "<", "<="}},
+			},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getAwsSSMOpsItem,
+				Tags: map[string]string{"service": "ssm", "action": "GetOpsItem"},
+			},
+			{
+				Func: getAwsSSMOpsItemRelatedItems,
+				Tags: map[string]string{"service": "ssm", "action": "ListOpsItemRelatedItems"},
+			},
+			{
+				Func: getAwsSSMOpsItemTags,
+				Tags: map[string]string{"service": "ssm", "action": "ListTagsForResource"},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(AWS_SSM_SERVICE_ID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "ops_item_id",
+				Description: "The ID of the OpsItem.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the OpsItem.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getAwsSSMOpsItem,
+				Transform:   transform.FromField("OpsItemArn"),
+			},
+			{
+				Name:        "title",
+				Description: "A short heading that describes the nature of the OpsItem and the impacted resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the OpsItem.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getAwsSSMOpsItem,
+			},
+			{
+				Name:        "status",
+				Description: "The status of the OpsItem, e.g. Open, InProgress or Resolved.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "ops_item_type",
+				Description: "The type of OpsItem, e.g. /aws/issue, /aws/changerequest or /aws/insight.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "source",
+				Description: "The origin of the OpsItem, e.g. EC2, CloudWatch or SSM.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "severity",
+				Description: "The severity of the OpsItem, from 1 (highest) to 4 (lowest).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "category",
+				Description: "A list of OpsItems by category, e.g. Availability, Cost, Performance, Recovery or Security.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "priority",
+				Description: "The importance of the OpsItem in relation to other OpsItems, from 1 (highest) to 5 (lowest).",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "created_by",
+				Description: "The Amazon Resource Name (ARN) of the IAM entity that created the OpsItem.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "created_time",
+				Description: "The date and time the OpsItem was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "last_modified_by",
+				Description: "The Amazon Resource Name (ARN) of the IAM entity that last updated the OpsItem.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "last_modified_time",
+				Description: "The date and time the OpsItem was last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "actual_start_time",
+				Description: "The time a runbook workflow started. Currently reported only for the OpsItem type /aws/changerequest.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "actual_end_time",
+				Description: "The time a runbook workflow ended. Currently reported only for the OpsItem type /aws/changerequest.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "planned_start_time",
+				Description: "The time specified in a change request for a runbook workflow to start. Currently supported only for the OpsItem type /aws/changerequest.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "planned_end_time",
+				Description: "The time specified in a change request for a runbook workflow to end. Currently supported only for the OpsItem type /aws/changerequest.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "version",
+				Description: "The version of this OpsItem. Each time the OpsItem is edited the version number increments by one.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getAwsSSMOpsItem,
+			},
+			{
+				Name:        "operational_data",
+				Description: "Operational data is custom data that provides useful reference details about the OpsItem, as a map of keys to their type and value.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "related_resources",
+				Description: "The resources impacted by the OpsItem, as stored in the /aws/resources key of its operational data.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("OperationalData").Transform(ssmOpsItemRelatedResources),
+			},
+			{
+				Name:        "related_items",
+				Description: "The resources, automations and incidents related to the OpsItem.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getAwsSSMOpsItemRelatedItems,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "related_ops_items",
+				Description: "One or more OpsItems that share something in common with the current OpsItem.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getAwsSSMOpsItem,
+			},
+			{
+				Name:        "notifications",
+				Description: "The Amazon Simple Notification Service (Amazon SNS) topic ARNs where notifications are sent when the OpsItem is edited or changed.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getAwsSSMOpsItem,
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags assigned to the OpsItem.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getAwsSSMOpsItemTags,
+				Transform:   transform.FromField("TagList"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getAwsSSMOpsItemTags,
+				Transform:   transform.FromField("TagList").Transform(ssmMaintenanceWindowTagListToTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getAwsSSMOpsItem,
+				Transform:   transform.FromField("OpsItemArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAwsSSMOpsItems(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := SSMClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ssm_ops_item.listAwsSSMOpsItems", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region check
+		return nil, nil
+	}
+
+	maxItems := int32(50)
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxItems {
+			if limit < 1 {
+				maxItems = int32(1)
+			} else {
+				maxItems = int32(limit)
+			}
+		}
+	}
+
+	input := &ssm.DescribeOpsItemsInput{
+		MaxResults: aws.Int32(maxItems),
+	}
+
+	filters := buildSSMOpsItemFilter(d.Quals)
+	if len(filters) > 0 {
+		input.OpsItemFilters = filters
+	}
+
+	paginator := ssm.NewDescribeOpsItemsPaginator(svc, input, func(o *ssm.DescribeOpsItemsPaginatorOptions) {
+		o.Limit = maxItems
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_ssm_ops_item.listAwsSSMOpsItems", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range output.OpsItemSummaries {
+			d.StreamListItem(ctx, item)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getAwsSSMOpsItem(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var id string
+	switch item := h.Item.(type) {
+	case types.OpsItemSummary:
+		id = aws.ToString(item.OpsItemId)
+	case types.OpsItem:
+		return item, nil
+	default:
+		id = d.EqualsQualString("ops_item_id")
+	}
+
+	// Empty input id check
+	if id == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := SSMClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ssm_ops_item.getAwsSSMOpsItem", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region check
+		return nil, nil
+	}
+
+	output, err := svc.GetOpsItem(ctx, &ssm.GetOpsItemInput{
+		OpsItemId: aws.String(id),
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ssm_ops_item.getAwsSSMOpsItem", "api_error", err)
+		return nil, err
+	}
+
+	if output.OpsItem != nil {
+		return *output.OpsItem, nil
+	}
+
+	return nil, nil
+}
+
+func getAwsSSMOpsItemRelatedItems(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	id := ssmOpsItemID(h.Item)
+
+	// Create Session
+	svc, err := SSMClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ssm_ops_item.getAwsSSMOpsItemRelatedItems", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region check
+		return nil, nil
+	}
+
+	input := &ssm.ListOpsItemRelatedItemsInput{
+		OpsItemId:  aws.String(id),
+		MaxResults: aws.Int32(50),
+	}
+
+	paginator := ssm.NewListOpsItemRelatedItemsPaginator(svc, input, func(o *ssm.ListOpsItemRelatedItemsPaginatorOptions) {
+		o.Limit = 50
+		o.StopOnDuplicateToken = true
+	})
+
+	relatedItems := []types.OpsItemRelatedItemSummary{}
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_ssm_ops_item.getAwsSSMOpsItemRelatedItems", "api_error", err)
+			return nil, err
+		}
+		relatedItems = append(relatedItems, output.Summaries...)
+	}
+
+	return relatedItems, nil
+}
+
+func getAwsSSMOpsItemTags(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	id := ssmOpsItemID(h.Item)
+
+	// Create Session
+	svc, err := SSMClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ssm_ops_item.getAwsSSMOpsItemTags", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region check
+		return nil, nil
+	}
+
+	// Build the params
+	params := &ssm.ListTagsForResourceInput{
+		ResourceType: types.ResourceTypeForTaggingOpsItem,
+		ResourceId:   aws.String(id),
+	}
+
+	// Get call
+	op, err := svc.ListTagsForResource(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ssm_ops_item.getAwsSSMOpsItemTags", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
+
+func ssmOpsItemID(item interface{}) string {
+	switch item := item.(type) {
+	case types.OpsItemSummary:
+		return aws.ToString(item.OpsItemId)
+	case types.OpsItem:
+		return aws.ToString(item.OpsItemId)
+	}
+	return ""
+}
+
+//// TRANSFORM FUNCTIONS
+
+// The resources impacted by an OpsItem are stored as a JSON list in the
+// /aws/resources key of its operational data
+func ssmOpsItemRelatedResources(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	operationalData, ok := d.Value.(map[string]types.OpsItemDataValue)
+	if !ok {
+		return nil, nil
+	}
+
+	resources, ok := operationalData["/aws/resources"]
+	if !ok || resources.Value == nil {
+		return nil, nil
+	}
+
+	var result interface{}
+	if err := json.Unmarshal([]byte(*resources.Value), &result); err != nil {
+		return nil, nil
+	}
+	return result, nil
+}
+
+//// UTILITY FUNCTION
+
+// Build ssm ops item list call input filter
+func buildSSMOpsItemFilter(quals plugin.KeyColumnQualMap) []types.OpsItemFilter {
+	filters := make([]types.OpsItemFilter, 0)
+
+	filterQuals := map[string]types.OpsItemFilterKey{
+		"status":        types.OpsItemFilterKeyStatus,
+		"source":        types.OpsItemFilterKeySource,
+		"severity":      types.OpsItemFilterKeySeverity,
+		"category":      types.OpsItemFilterKeyCategory,
+		"ops_item_type": types.OpsItemFilterKeyOpsitemType,
+	}
+
+	for columnName, filterKey := range filterQuals {
+		if quals[columnName] != nil {
+			value := getQualsValueByColumn(quals, columnName, "string")
+			val, ok := value.(string)
+			if ok {
+				filters = append(filters, types.OpsItemFilter{
+					Key:      filterKey,
+					Operator: types.OpsItemFilterOperatorEqual,
+					Values:   []string{val},
+				})
+			}
+		}
+	}
+
+	if quals["priority"] != nil {
+		for _, q := range quals["priority"].Quals {
+			filters = append(filters, types.OpsItemFilter{
+				Key:      types.OpsItemFilterKeyPriority,
+				Operator: types.OpsItemFilterOperatorEqual,
+				Values:   []string{strconv.FormatInt(q.Value.GetInt64Value(), 10)},
+			})
+		}
+	}
+
+	timeQuals := map[string]types.OpsItemFilterKey{
+		"created_time":       types.OpsItemFilterKeyCreatedTime,
+		"last_modified_time": types.OpsItemFilterKeyLastModifiedTime,
+	}
+
+	for columnName, filterKey := range timeQuals {
+		if quals[columnName] != nil {
+			for _, q := range quals[columnName].Quals {
+				timestamp := q.Value.GetTimestampValue().AsTime().Format(time.RFC3339)
+				switch q.Operator {
+				case ">=", ">":
+					filters = append(filters, types.OpsItemFilter{
+						Key:      filterKey,
+						Operator: types.OpsItemFilterOperatorGreaterThan,
+						Values:   []string{timestamp},
+					})
+				case "<", "<=":
+					filters = append(filters, types.OpsItemFilter{
+						Key:      filterKey,
+						Operator: types.OpsItemFilterOperatorLessThan,
+						Values:   []string{timestamp},
+					})
+				}
+			}
+		}
+	}
+
+	return filters
+}
@@ -0,0 +1,237 @@
+package aws
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsSSMOpsMetadata(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_ssm_ops_metadata",
+		Description: "AWS SSM OpsMetadata",
+		List: &plugin.ListConfig{
+			Hydrate: listAwsSSMOpsMetadata,
+			Tags:    map[string]string{"service": "ssm", "action": "ListOpsMetadata"},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getAwsSSMOpsMetadataValues,
+				Tags: map[string]string{"service": "ssm", "action": "GetOpsMetadata"},
+			},
+			{
+				Func: getAwsSSMOpsMetadataTags,
+				Tags: map[string]string{"service": "ssm", "action": "ListTagsForResource"},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(AWS_SSM_SERVICE_ID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "resource_id",
+				Description: "The ID of the Application Manager application, e.g. a CloudFormation stack or a resource group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the OpsMetadata object.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("OpsMetadataArn"),
+			},
+			{
+				Name:        "creation_date",
+				Description: "The date the OpsMetadata object was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "last_modified_date",
+				Description: "The date the OpsMetadata object was last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "last_modified_user",
+				Description: "The user name who last updated the OpsMetadata object.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "metadata",
+				Description: "The metadata of the Application Manager application, as a map of keys to their value.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getAwsSSMOpsMetadataValues,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags assigned to the OpsMetadata object.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getAwsSSMOpsMetadataTags,
+				Transform:   transform.FromField("TagList"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ResourceId"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getAwsSSMOpsMetadataTags,
+				Transform:   transform.FromField("TagList").Transform(ssmMaintenanceWindowTagListToTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("OpsMetadataArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAwsSSMOpsMetadata(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := SSMClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ssm_ops_metadata.listAwsSSMOpsMetadata", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region check
+		return nil, nil
+	}
+
+	maxItems := int32(50)
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxItems {
+			if limit < 1 {
+				maxItems = int32(1)
+			} else {
+				maxItems = int32(limit)
+			}
+		}
+	}
+
+	input := &ssm.ListOpsMetadataInput{
+		MaxResults: aws.Int32(maxItems),
+	}
+
+	paginator := ssm.NewListOpsMetadataPaginator(svc, input, func(o *ssm.ListOpsMetadataPaginatorOptions) {
+		o.Limit = maxItems
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_ssm_ops_metadata.listAwsSSMOpsMetadata", "api_error", err)
+			return nil, err
+		}
+
+		for _, metadata := range output.OpsMetadataList {
+			d.StreamListItem(ctx, metadata)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getAwsSSMOpsMetadataValues(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	opsMetadata := h.Item.(types.OpsMetadata)
+
+	// Create Session
+	svc, err := SSMClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ssm_ops_metadata.getAwsSSMOpsMetadataValues", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region check
+		return nil, nil
+	}
+
+	input := &ssm.GetOpsMetadataInput{
+		OpsMetadataArn: opsMetadata.OpsMetadataArn,
+		MaxResults:     aws.Int32(50),
+	}
+
+	metadata := map[string]string{}
+	for {
+		output, err := svc.GetOpsMetadata(ctx, input)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_ssm_ops_metadata.getAwsSSMOpsMetadataValues", "api_error", err)
+			return nil, err
+		}
+		for key, value := range output.Metadata {
+			metadata[key] = aws.ToString(value.Value)
+		}
+		if output.NextToken == nil {
+			break
+		}
+		input.NextToken = output.NextToken
+	}
+
+	return metadata, nil
+}
+
+func getAwsSSMOpsMetadataTags(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	opsMetadata := h.Item.(types.OpsMetadata)
+
+	// The resource ID used for tagging is the part of the ARN after opsmetadata/
+	parts := strings.SplitN(aws.ToString(opsMetadata.OpsMetadataArn), ":opsmetadata/", 2)
+	if len(parts) != 2 {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := SSMClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ssm_ops_metadata.getAwsSSMOpsMetadataTags", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region check
+		return nil, nil
+	}
+
+	// Build the params
+	params := &ssm.ListTagsForResourceInput{
+		ResourceType: types.ResourceTypeForTaggingOpsmetadata,
+		ResourceId:   aws.String(parts[1]),
+	}
+
+	// Get call
+	op, err := svc.ListTagsForResource(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ssm_ops_metadata.getAwsSSMOpsMetadataTags", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
@@ -0,0 +1,272 @@
+package aws
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+type SSMSessionInfo struct {
+	types.Session
+	State types.SessionState
+}
+
+//// TABLE DEFINITION
+
+func tableAwsSSMSession(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_ssm_session",
+		Description: "AWS SSM Session",
+		List: &plugin.ListConfig{
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"InvalidFilterKey", "ValidationException"}),
+			},
+			Hydrate: listAwsSSMSessions,
+			Tags:    map[string]string{"service": "ssm", "action": "DescribeSessions"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "state", Require: plugin.Optional},
+				{Name: "session_id", Require: plugin.Optional},
+				{Name: "target", Require: plugin.Optional},
+				{Name: "owner", Require: plugin.Optional},
+				{Name: "status", Require: plugin.Optional},
+				{Name: "start_date", Require: plugin.Optional, Operators: []string{">", ">=", "<", "<="}},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(AWS_SSM_SERVICE_ID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "session_id",
+				Description: "The ID of the session.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the session.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getSSMSessionARN,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "state",
+				Description: "Indicates whether the session is currently Active, or is part of the History of terminated sessions.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "target",
+				Description: "The managed node that the session connected to, e.g. an instance ID.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "owner",
+				Description: "The ID of the Amazon Web Services user that started the session.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "The status of the session, e.g. Connected, Connecting, Disconnected, Terminated, Terminating or Failed.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "start_date",
+				Description: "The date and time when the session began.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "end_date",
+				Description: "The date and time when the session was terminated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "document_name",
+				Description: "The name of the Session Manager SSM document used to define the parameters and plugin settings for the session, e.g. SSM-SessionManagerRunShell.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "access_type",
+				Description: "Standard access type is the default for Session Manager sessions. JustInTime is the access type for Just-in-time node access.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "reason",
+				Description: "The reason for connecting to the managed node.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "max_session_duration",
+				Description: "The maximum duration of the session before it terminates.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "details",
+				Description: "Reserved for future use.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "output_url",
+				Description: "The S3 bucket or CloudWatch Logs log group where the session output is stored.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SessionId"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getSSMSessionARN,
+				Transform:   transform.FromValue().Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAwsSSMSessions(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := SSMClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ssm_session.listAwsSSMSessions", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region check
+		return nil, nil
+	}
+
+	maxItems := int32(200)
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxItems {
+			if limit < 1 {
+				maxItems = int32(1)
+			} else {
+				maxItems = int32(limit)
+			}
+		}
+	}
+
+	filters := buildSSMSessionFilter(d.Quals)
+
+	// The API lists either the active sessions or the history of terminated
+	// sessions, so both are listed unless the state is specified
+	states := []types.SessionState{types.SessionStateActive, types.SessionStateHistory}
+	if d.EqualsQualString("state") != "" {
+		states = []types.SessionState{types.SessionState(d.EqualsQualString("state"))}
+	}
+
+	for _, state := range states {
+		input := &ssm.DescribeSessionsInput{
+			State:      state,
+			MaxResults: aws.Int32(maxItems),
+		}
+		if len(filters) > 0 {
+			input.Filters = filters
+		}
+
+		paginator := ssm.NewDescribeSessionsPaginator(svc, input, func(o *ssm.DescribeSessionsPaginatorOptions) {
+			o.Limit = maxItems
+			o.StopOnDuplicateToken = true
+		})
+
+		for paginator.HasMorePages() {
+			// apply rate limiting
+			d.WaitForListRateLimit(ctx)
+
+			output, err := paginator.NextPage(ctx)
+			if err != nil {
+				plugin.Logger(ctx).Error("aws_ssm_session.listAwsSSMSessions", "api_error", err)
+				return nil, err
+			}
+
+			for _, session := range output.Sessions {
+				d.StreamListItem(ctx, SSMSessionInfo{session, state})
+
+				// Context may get cancelled due to manual cancellation or if the limit has been reached
+				if d.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getSSMSessionARN(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	region := d.EqualsQualString(matrixKeyRegion)
+	session := h.Item.(SSMSessionInfo)
+
+	c, err := getCommonColumns(ctx, d, h)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ssm_session.getSSMSessionARN", "common_data_error", err)
+		return nil, err
+	}
+	commonColumnData := c.(*awsCommonColumnData)
+	arn := "arn:" + commonColumnData.Partition + ":ssm:" + region + ":" + commonColumnData.AccountId + ":session/" + aws.ToString(session.SessionId)
+
+	return arn, nil
+}
+
+//// UTILITY FUNCTION
+
+// Build ssm session list call input filter
+func buildSSMSessionFilter(quals plugin.KeyColumnQualMap) []types.SessionFilter {
+	filters := make([]types.SessionFilter, 0)
+
+	filterQuals := map[string]types.SessionFilterKey{
+		"session_id": types.SessionFilterKeySessionId,
+		"target":     types.SessionFilterKeyTargetId,
+		"owner":      types.SessionFilterKeyOwner,
+		"status":     types.SessionFilterKeyStatus,
+	}
+
+	for columnName, filterKey := range filterQuals {
+		if quals[columnName] != nil {
+			value := getQualsValueByColumn(quals, columnName, "string")
+			val, ok := value.(string)
+			if ok {
+				filters = append(filters, types.SessionFilter{
+					Key:   filterKey,
+					Value: aws.String(val),
+				})
+			}
+		}
+	}
+
+	if quals["start_date"] != nil {
+		for _, q := range quals["start_date"].Quals {
+			timestamp := q.Value.GetTimestampValue().AsTime().Format(time.RFC3339)
+			switch q.Operator {
+			case ">=", ">":
+				filters = append(filters, types.SessionFilter{
+					Key:   types.SessionFilterKeyInvokedAfter,
+					Value: aws.String(timestamp),
+				})
+			case "<", "<=":
+				filters = append(filters, types.SessionFilter{
+					Key:   types.SessionFilterKeyInvokedBefore,
+					Value: aws.String(timestamp),
+				})
+			}
+		}
+	}
+
+	return filters
+}
@@ -0,0 +1,191 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/verifiedpermissions"
+	"github.com/aws/aws-sdk-go-v2/service/verifiedpermissions/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+func tableAwsVerifiedPermissionsAuthorizationCheck(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_verifiedpermissions_authorization_check",
+		Description: "AWS Verified Permissions Authorization Check",
+		List: &plugin.ListConfig{
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "policy_store_id"},
+				{Name: "principal_entity_type"},
+				{Name: "principal_entity_id"},
+				{Name: "action_type"},
+				{Name: "action_id"},
+				{Name: "resource_entity_type"},
+				{Name: "resource_entity_id"},
+				{Name: "context", Require: plugin.Optional},
+				{Name: "entities", Require: plugin.Optional},
+			},
+			Hydrate: listVerifiedPermissionsAuthorizationCheck,
+			Tags:    map[string]string{"service": "verifiedpermissions", "action": "IsAuthorized"},
+			// The policy store only exists in one of the queried regions
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException"}),
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(AWS_VERIFIEDPERMISSIONS_SERVICE_ID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			// "Key" Columns
+			{
+				Name:        "policy_store_id",
+				Description: "The ID of the policy store whose policies are evaluated.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "principal_entity_type",
+				Description: "The entity type of the principal of the authorization request, e.g. PhotoFlash::User.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "principal_entity_id",
+				Description: "The entity identifier of the principal of the authorization request.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "action_type",
+				Description: "The type of the action of the authorization request, e.g. PhotoFlash::Action.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "action_id",
+				Description: "The ID of the action of the authorization request, e.g. ViewPhoto.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "resource_entity_type",
+				Description: "The entity type of the resource of the authorization request.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "resource_entity_id",
+				Description: "The entity identifier of the resource of the authorization request.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "context",
+				Description: "The additional context of the authorization request, in Cedar JSON format.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromQual("context"),
+			},
+			{
+				Name:        "entities",
+				Description: "The principal and resource entities, with their attributes, that the policies can examine, in Cedar JSON format.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromQual("entities"),
+			},
+			{
+				Name:        "decision",
+				Description: "The authorization decision for this request. Can be ALLOW or DENY.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "determining_policies",
+				Description: "The IDs of the policies that determined the authorization decision.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "errors",
+				Description: "The errors that occurred while making the authorization decision, for example a policy that references an attribute that does not exist.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromGo(),
+			},
+		}),
+	}
+}
+
+type awsVerifiedPermissionsAuthorizationCheckResult struct {
+	PolicyStoreId       string
+	PrincipalEntityType string
+	PrincipalEntityId   string
+	ActionType          string
+	ActionId            string
+	ResourceEntityType  string
+	ResourceEntityId    string
+	Decision            types.Decision
+	DeterminingPolicies []string
+	Errors              []string
+}
+
+func listVerifiedPermissionsAuthorizationCheck(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	row := awsVerifiedPermissionsAuthorizationCheckResult{
+		PolicyStoreId:       d.EqualsQualString("policy_store_id"),
+		PrincipalEntityType: d.EqualsQualString("principal_entity_type"),
+		PrincipalEntityId:   d.EqualsQualString("principal_entity_id"),
+		ActionType:          d.EqualsQualString("action_type"),
+		ActionId:            d.EqualsQualString("action_id"),
+		ResourceEntityType:  d.EqualsQualString("resource_entity_type"),
+		ResourceEntityId:    d.EqualsQualString("resource_entity_id"),
+	}
+
+	// Create Session
+	svc, err := VerifiedPermissionsClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_verifiedpermissions_authorization_check.listVerifiedPermissionsAuthorizationCheck", "client_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &verifiedpermissions.IsAuthorizedInput{
+		PolicyStoreId: aws.String(row.PolicyStoreId),
+		Principal: &types.EntityIdentifier{
+			EntityType: aws.String(row.PrincipalEntityType),
+			EntityId:   aws.String(row.PrincipalEntityId),
+		},
+		Action: &types.ActionIdentifier{
+			ActionType: aws.String(row.ActionType),
+			ActionId:   aws.String(row.ActionId),
+		},
+		Resource: &types.EntityIdentifier{
+			EntityType: aws.String(row.ResourceEntityType),
+			EntityId:   aws.String(row.ResourceEntityId),
+		},
+	}
+	if d.EqualsQuals["context"] != nil {
+		params.Context = &types.ContextDefinitionMemberCedarJson{Value: d.EqualsQuals["context"].GetJsonbValue()}
+	}
+	if d.EqualsQuals["entities"] != nil {
+		params.Entities = &types.EntitiesDefinitionMemberCedarJson{Value: d.EqualsQuals["entities"].GetJsonbValue()}
+	}
+
+	op, err := svc.IsAuthorized(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_verifiedpermissions_authorization_check.listVerifiedPermissionsAuthorizationCheck", "api_error", err)
+		return nil, err
+	}
+
+	row.Decision = op.Decision
+	for _, policy := range op.DeterminingPolicies {
+		row.DeterminingPolicies = append(row.DeterminingPolicies, aws.ToString(policy.PolicyId))
+	}
+	for _, e := range op.Errors {
+		row.Errors = append(row.Errors, aws.ToString(e.ErrorDescription))
+	}
+
+	d.StreamListItem(ctx, row)
+
+	return nil, nil
+}
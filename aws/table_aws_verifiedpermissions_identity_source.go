@@ -0,0 +1,189 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/verifiedpermissions"
+	"github.com/aws/aws-sdk-go-v2/service/verifiedpermissions/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsVerifiedPermissionsIdentitySource(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_verifiedpermissions_identity_source",
+		Description: "AWS Verified Permissions Identity Source",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"policy_store_id", "identity_source_id"}),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException", "ValidationException"}),
+			},
+			Hydrate: getVerifiedPermissionsIdentitySource,
+			Tags:    map[string]string{"service": "verifiedpermissions", "action": "GetIdentitySource"},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listVerifiedPermissionsPolicyStores,
+			Hydrate:       listVerifiedPermissionsIdentitySources,
+			Tags:          map[string]string{"service": "verifiedpermissions", "action": "ListIdentitySources"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "policy_store_id", Require: plugin.Optional},
+				{Name: "principal_entity_type", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(AWS_VERIFIEDPERMISSIONS_SERVICE_ID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "identity_source_id",
+				Description: "The unique identifier of the identity source.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "policy_store_id",
+				Description: "The unique identifier of the policy store that contains the identity source.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "principal_entity_type",
+				Description: "The Cedar entity type of the principals returned from the identity provider associated with the identity source.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "created_date",
+				Description: "The date and time the identity source was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "last_updated_date",
+				Description: "The date and time the identity source was most recently updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "configuration",
+				Description: "The configuration of the identity source, either a CognitoUserPoolConfiguration or an OpenIdConnectConfiguration.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Configuration").Transform(verifiedPermissionsUnionToMap),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("IdentitySourceId"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listVerifiedPermissionsIdentitySources(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	store := h.Item.(types.PolicyStoreItem)
+
+	// Minimize the API call with the given policy store ID
+	if d.EqualsQualString("policy_store_id") != "" && d.EqualsQualString("policy_store_id") != aws.ToString(store.PolicyStoreId) {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := VerifiedPermissionsClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_verifiedpermissions_identity_source.listVerifiedPermissionsIdentitySources", "client_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(50)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &verifiedpermissions.ListIdentitySourcesInput{
+		PolicyStoreId: store.PolicyStoreId,
+		MaxResults:    aws.Int32(maxLimit),
+	}
+	if d.EqualsQualString("principal_entity_type") != "" {
+		input.Filters = []types.IdentitySourceFilter{
+			{PrincipalEntityType: aws.String(d.EqualsQualString("principal_entity_type"))},
+		}
+	}
+
+	paginator := verifiedpermissions.NewListIdentitySourcesPaginator(svc, input, func(o *verifiedpermissions.ListIdentitySourcesPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_verifiedpermissions_identity_source.listVerifiedPermissionsIdentitySources", "api_error", err)
+			return nil, err
+		}
+
+		for _, source := range output.IdentitySources {
+			d.StreamListItem(ctx, source)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getVerifiedPermissionsIdentitySource(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	storeId := d.EqualsQualString("policy_store_id")
+	sourceId := d.EqualsQualString("identity_source_id")
+
+	// Empty check
+	if storeId == "" || sourceId == "" {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := VerifiedPermissionsClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_verifiedpermissions_identity_source.getVerifiedPermissionsIdentitySource", "client_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &verifiedpermissions.GetIdentitySourceInput{
+		PolicyStoreId:    aws.String(storeId),
+		IdentitySourceId: aws.String(sourceId),
+	}
+
+	op, err := svc.GetIdentitySource(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_verifiedpermissions_identity_source.getVerifiedPermissionsIdentitySource", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
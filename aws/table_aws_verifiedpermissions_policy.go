@@ -0,0 +1,296 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/verifiedpermissions"
+	"github.com/aws/aws-sdk-go-v2/service/verifiedpermissions/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsVerifiedPermissionsPolicy(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_verifiedpermissions_policy",
+		Description: "AWS Verified Permissions Policy",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"policy_store_id", "policy_id"}),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException", "ValidationException"}),
+			},
+			Hydrate: getVerifiedPermissionsPolicy,
+			Tags:    map[string]string{"service": "verifiedpermissions", "action": "GetPolicy"},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listVerifiedPermissionsPolicyStores,
+			Hydrate:       listVerifiedPermissionsPolicies,
+			Tags:          map[string]string{"service": "verifiedpermissions", "action": "ListPolicies"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "policy_store_id", Require: plugin.Optional},
+				{Name: "policy_type", Require: plugin.Optional},
+				{Name: "policy_template_id", Require: plugin.Optional},
+			},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getVerifiedPermissionsPolicy,
+				Tags: map[string]string{"service": "verifiedpermissions", "action": "GetPolicy"},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(AWS_VERIFIEDPERMISSIONS_SERVICE_ID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "policy_id",
+				Description: "The unique identifier of the policy.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "policy_store_id",
+				Description: "The unique identifier of the policy store that contains the policy.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "policy_type",
+				Description: "The type of the policy. Can be STATIC or TEMPLATE_LINKED.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "effect",
+				Description: "The effect of the decision that the policy returns to an authorization request. Can be Permit or Forbid.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the static policy.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Definition").TransformP(verifiedPermissionsPolicyDefinitionField, "Description"),
+			},
+			{
+				Name:        "policy_template_id",
+				Description: "The unique identifier of the policy template that the template-linked policy was created from.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Definition").TransformP(verifiedPermissionsPolicyDefinitionField, "PolicyTemplateId"),
+			},
+			{
+				Name:        "statement",
+				Description: "The Cedar statement of the static policy.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getVerifiedPermissionsPolicy,
+				Transform:   transform.FromField("Definition").TransformP(verifiedPermissionsPolicyDefinitionField, "Statement"),
+			},
+			{
+				Name:        "principal_entity_type",
+				Description: "The entity type of the principal in the policy scope.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Principal.EntityType"),
+			},
+			{
+				Name:        "principal_entity_id",
+				Description: "The entity identifier of the principal in the policy scope.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Principal.EntityId"),
+			},
+			{
+				Name:        "resource_entity_type",
+				Description: "The entity type of the resource in the policy scope.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Resource.EntityType"),
+			},
+			{
+				Name:        "resource_entity_id",
+				Description: "The entity identifier of the resource in the policy scope.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Resource.EntityId"),
+			},
+			{
+				Name:        "actions",
+				Description: "The actions that the policy permits or forbids.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "created_date",
+				Description: "The date and time the policy was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "last_updated_date",
+				Description: "The date and time the policy was most recently updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "definition",
+				Description: "The policy definition, either Static or TemplateLinked.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Definition").Transform(verifiedPermissionsUnionToMap),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("PolicyId"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listVerifiedPermissionsPolicies(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	store := h.Item.(types.PolicyStoreItem)
+
+	// Minimize the API call with the given policy store ID
+	if d.EqualsQualString("policy_store_id") != "" && d.EqualsQualString("policy_store_id") != aws.ToString(store.PolicyStoreId) {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := VerifiedPermissionsClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_verifiedpermissions_policy.listVerifiedPermissionsPolicies", "client_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(50)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &verifiedpermissions.ListPoliciesInput{
+		PolicyStoreId: store.PolicyStoreId,
+		MaxResults:    aws.Int32(maxLimit),
+	}
+	if d.EqualsQualString("policy_type") != "" || d.EqualsQualString("policy_template_id") != "" {
+		input.Filter = &types.PolicyFilter{
+			PolicyType: types.PolicyType(d.EqualsQualString("policy_type")),
+		}
+		if d.EqualsQualString("policy_template_id") != "" {
+			input.Filter.PolicyTemplateId = aws.String(d.EqualsQualString("policy_template_id"))
+		}
+	}
+
+	paginator := verifiedpermissions.NewListPoliciesPaginator(svc, input, func(o *verifiedpermissions.ListPoliciesPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_verifiedpermissions_policy.listVerifiedPermissionsPolicies", "api_error", err)
+			return nil, err
+		}
+
+		for _, policy := range output.Policies {
+			d.StreamListItem(ctx, policy)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getVerifiedPermissionsPolicy(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var storeId, policyId string
+	if h.Item != nil {
+		switch item := h.Item.(type) {
+		case types.PolicyItem:
+			storeId = aws.ToString(item.PolicyStoreId)
+			policyId = aws.ToString(item.PolicyId)
+		case *verifiedpermissions.GetPolicyOutput:
+			return item, nil
+		}
+	} else {
+		storeId = d.EqualsQualString("policy_store_id")
+		policyId = d.EqualsQualString("policy_id")
+	}
+
+	// Empty check
+	if storeId == "" || policyId == "" {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := VerifiedPermissionsClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_verifiedpermissions_policy.getVerifiedPermissionsPolicy", "client_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &verifiedpermissions.GetPolicyInput{
+		PolicyStoreId: aws.String(storeId),
+		PolicyId:      aws.String(policyId),
+	}
+
+	op, err := svc.GetPolicy(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_verifiedpermissions_policy.getVerifiedPermissionsPolicy", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+// verifiedPermissionsPolicyDefinitionField returns a field of a policy
+// definition, which is a Static or TemplateLinked member in both the list and
+// get responses
+func verifiedPermissionsPolicyDefinitionField(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	field := d.Param.(string)
+
+	switch definition := d.Value.(type) {
+	case *types.PolicyDefinitionItemMemberStatic:
+		if field == "Description" {
+			return definition.Value.Description, nil
+		}
+	case *types.PolicyDefinitionItemMemberTemplateLinked:
+		if field == "PolicyTemplateId" {
+			return definition.Value.PolicyTemplateId, nil
+		}
+	case *types.PolicyDefinitionDetailMemberStatic:
+		switch field {
+		case "Description":
+			return definition.Value.Description, nil
+		case "Statement":
+			return definition.Value.Statement, nil
+		}
+	case *types.PolicyDefinitionDetailMemberTemplateLinked:
+		if field == "PolicyTemplateId" {
+			return definition.Value.PolicyTemplateId, nil
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,251 @@
+package aws
+
+import (
+	"context"
+	"reflect"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/verifiedpermissions"
+	"github.com/aws/aws-sdk-go-v2/service/verifiedpermissions/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsVerifiedPermissionsPolicyStore(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_verifiedpermissions_policy_store",
+		Description: "AWS Verified Permissions Policy Store",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("policy_store_id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException", "ValidationException"}),
+			},
+			Hydrate: getVerifiedPermissionsPolicyStore,
+			Tags:    map[string]string{"service": "verifiedpermissions", "action": "GetPolicyStore"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listVerifiedPermissionsPolicyStores,
+			Tags:    map[string]string{"service": "verifiedpermissions", "action": "ListPolicyStores"},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getVerifiedPermissionsPolicyStore,
+				Tags: map[string]string{"service": "verifiedpermissions", "action": "GetPolicyStore"},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(AWS_VERIFIEDPERMISSIONS_SERVICE_ID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "policy_store_id",
+				Description: "The unique identifier of the policy store.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the policy store.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the policy store.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "created_date",
+				Description: "The date and time the policy store was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "last_updated_date",
+				Description: "The date and time the policy store was most recently updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "validation_mode",
+				Description: "The schema validation mode of the policy store. Can be OFF or STRICT.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getVerifiedPermissionsPolicyStore,
+				Transform:   transform.FromField("ValidationSettings.Mode"),
+			},
+			{
+				Name:        "cedar_version",
+				Description: "The version of the Cedar language used with policies, policy templates and schemas in the policy store.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getVerifiedPermissionsPolicyStore,
+			},
+			{
+				Name:        "deletion_protection",
+				Description: "Specifies whether the policy store can be deleted. Can be ENABLED or DISABLED.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getVerifiedPermissionsPolicyStore,
+			},
+			{
+				Name:        "encryption_state",
+				Description: "The encryption configuration of the policy store, either Default or the KMS key used.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getVerifiedPermissionsPolicyStore,
+				Transform:   transform.FromField("EncryptionState").Transform(verifiedPermissionsUnionToMap),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("PolicyStoreId"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getVerifiedPermissionsPolicyStore,
+				Transform:   transform.FromField("Tags"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listVerifiedPermissionsPolicyStores(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := VerifiedPermissionsClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_verifiedpermissions_policy_store.listVerifiedPermissionsPolicyStores", "client_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(50)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &verifiedpermissions.ListPolicyStoresInput{
+		MaxResults: aws.Int32(maxLimit),
+	}
+
+	paginator := verifiedpermissions.NewListPolicyStoresPaginator(svc, input, func(o *verifiedpermissions.ListPolicyStoresPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_verifiedpermissions_policy_store.listVerifiedPermissionsPolicyStores", "api_error", err)
+			return nil, err
+		}
+
+		for _, store := range output.PolicyStores {
+			d.StreamListItem(ctx, store)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getVerifiedPermissionsPolicyStore(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var id string
+	if h.Item != nil {
+		switch item := h.Item.(type) {
+		case types.PolicyStoreItem:
+			id = aws.ToString(item.PolicyStoreId)
+		case *verifiedpermissions.GetPolicyStoreOutput:
+			return item, nil
+		}
+	} else {
+		id = d.EqualsQualString("policy_store_id")
+	}
+
+	// Empty check
+	if id == "" {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := VerifiedPermissionsClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_verifiedpermissions_policy_store.getVerifiedPermissionsPolicyStore", "client_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &verifiedpermissions.GetPolicyStoreInput{
+		PolicyStoreId: aws.String(id),
+		Tags:          true,
+	}
+
+	op, err := svc.GetPolicyStore(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_verifiedpermissions_policy_store.getVerifiedPermissionsPolicyStore", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+// verifiedPermissionsUnionToMap renders a member of a Verified Permissions
+// union as a map keyed by the member name, e.g. a
+// ConfigurationDetailMemberCognitoUserPoolConfiguration becomes
+// {"CognitoUserPoolConfiguration": {...}}
+func verifiedPermissionsUnionToMap(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	if d.Value == nil {
+		return nil, nil
+	}
+
+	v := reflect.ValueOf(d.Value)
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil, nil
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return d.Value, nil
+	}
+
+	_, member, found := strings.Cut(v.Type().Name(), "Member")
+	value := v.FieldByName("Value")
+	if !found || !value.IsValid() {
+		return d.Value, nil
+	}
+	return map[string]interface{}{member: value.Interface()}, nil
+}
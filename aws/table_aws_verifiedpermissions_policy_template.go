@@ -0,0 +1,200 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/verifiedpermissions"
+	"github.com/aws/aws-sdk-go-v2/service/verifiedpermissions/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsVerifiedPermissionsPolicyTemplate(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_verifiedpermissions_policy_template",
+		Description: "AWS Verified Permissions Policy Template",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"policy_store_id", "policy_template_id"}),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException", "ValidationException"}),
+			},
+			Hydrate: getVerifiedPermissionsPolicyTemplate,
+			Tags:    map[string]string{"service": "verifiedpermissions", "action": "GetPolicyTemplate"},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listVerifiedPermissionsPolicyStores,
+			Hydrate:       listVerifiedPermissionsPolicyTemplates,
+			Tags:          map[string]string{"service": "verifiedpermissions", "action": "ListPolicyTemplates"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "policy_store_id", Require: plugin.Optional},
+			},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getVerifiedPermissionsPolicyTemplate,
+				Tags: map[string]string{"service": "verifiedpermissions", "action": "GetPolicyTemplate"},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(AWS_VERIFIEDPERMISSIONS_SERVICE_ID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "policy_template_id",
+				Description: "The unique identifier of the policy template.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "policy_store_id",
+				Description: "The unique identifier of the policy store that contains the policy template.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the policy template.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "statement",
+				Description: "The body of the policy template, written in the Cedar policy language.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getVerifiedPermissionsPolicyTemplate,
+			},
+			{
+				Name:        "created_date",
+				Description: "The date and time the policy template was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "last_updated_date",
+				Description: "The date and time the policy template was most recently updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("PolicyTemplateId"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listVerifiedPermissionsPolicyTemplates(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	store := h.Item.(types.PolicyStoreItem)
+
+	// Minimize the API call with the given policy store ID
+	if d.EqualsQualString("policy_store_id") != "" && d.EqualsQualString("policy_store_id") != aws.ToString(store.PolicyStoreId) {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := VerifiedPermissionsClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_verifiedpermissions_policy_template.listVerifiedPermissionsPolicyTemplates", "client_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(50)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &verifiedpermissions.ListPolicyTemplatesInput{
+		PolicyStoreId: store.PolicyStoreId,
+		MaxResults:    aws.Int32(maxLimit),
+	}
+
+	paginator := verifiedpermissions.NewListPolicyTemplatesPaginator(svc, input, func(o *verifiedpermissions.ListPolicyTemplatesPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_verifiedpermissions_policy_template.listVerifiedPermissionsPolicyTemplates", "api_error", err)
+			return nil, err
+		}
+
+		for _, template := range output.PolicyTemplates {
+			d.StreamListItem(ctx, template)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getVerifiedPermissionsPolicyTemplate(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var storeId, templateId string
+	if h.Item != nil {
+		switch item := h.Item.(type) {
+		case types.PolicyTemplateItem:
+			storeId = aws.ToString(item.PolicyStoreId)
+			templateId = aws.ToString(item.PolicyTemplateId)
+		case *verifiedpermissions.GetPolicyTemplateOutput:
+			return item, nil
+		}
+	} else {
+		storeId = d.EqualsQualString("policy_store_id")
+		templateId = d.EqualsQualString("policy_template_id")
+	}
+
+	// Empty check
+	if storeId == "" || templateId == "" {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := VerifiedPermissionsClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_verifiedpermissions_policy_template.getVerifiedPermissionsPolicyTemplate", "client_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &verifiedpermissions.GetPolicyTemplateInput{
+		PolicyStoreId:    aws.String(storeId),
+		PolicyTemplateId: aws.String(templateId),
+	}
+
+	op, err := svc.GetPolicyTemplate(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_verifiedpermissions_policy_template.getVerifiedPermissionsPolicyTemplate", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
@@ -0,0 +1,110 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/verifiedpermissions"
+	"github.com/aws/aws-sdk-go-v2/service/verifiedpermissions/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsVerifiedPermissionsSchema(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_verifiedpermissions_schema",
+		Description: "AWS Verified Permissions Schema",
+		List: &plugin.ListConfig{
+			ParentHydrate: listVerifiedPermissionsPolicyStores,
+			Hydrate:       listVerifiedPermissionsSchemas,
+			Tags:          map[string]string{"service": "verifiedpermissions", "action": "GetSchema"},
+			// A policy store without a schema returns ResourceNotFoundException
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException"}),
+			},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "policy_store_id", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(AWS_VERIFIEDPERMISSIONS_SERVICE_ID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "policy_store_id",
+				Description: "The unique identifier of the policy store that contains the schema.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "namespaces",
+				Description: "The namespaces of the entities referenced by the schema.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "created_date",
+				Description: "The date and time the schema was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "last_updated_date",
+				Description: "The date and time the schema was most recently updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "schema",
+				Description: "The body of the schema, written in Cedar schema JSON.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Schema").Transform(transform.UnmarshalJSON),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("PolicyStoreId"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listVerifiedPermissionsSchemas(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	store := h.Item.(types.PolicyStoreItem)
+
+	// Minimize the API call with the given policy store ID
+	if d.EqualsQualString("policy_store_id") != "" && d.EqualsQualString("policy_store_id") != aws.ToString(store.PolicyStoreId) {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := VerifiedPermissionsClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_verifiedpermissions_schema.listVerifiedPermissionsSchemas", "client_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// apply rate limiting
+	d.WaitForListRateLimit(ctx)
+
+	params := &verifiedpermissions.GetSchemaInput{
+		PolicyStoreId: store.PolicyStoreId,
+	}
+
+	op, err := svc.GetSchema(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_verifiedpermissions_schema.listVerifiedPermissionsSchemas", "api_error", err)
+		return nil, err
+	}
+
+	d.StreamListItem(ctx, op)
+
+	return nil, nil
+}
@@ -0,0 +1,709 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/connection"
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/memoize"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsVpcCidrOverlap(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_vpc_cidr_overlap",
+		Description: "AWS VPC CIDR Overlap, comparing the VPCs of every region and every connection in an aggregator.",
+		List: &plugin.ListConfig{
+			Hydrate: listVpcCidrOverlaps,
+			Tags:    map[string]string{"service": "ec2", "action": "DescribeVpcs"},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(AWS_EC2_SERVICE_ID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "cidr_block",
+				Description: "The CIDR block associated with the VPC.",
+				Type:        proto.ColumnType_CIDR,
+			},
+			{
+				Name:        "vpc_id",
+				Description: "The ID of the VPC.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "owner_id",
+				Description: "The ID of the AWS account that owns the VPC.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "vpc_region",
+				Description: "The region of the VPC.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "overlapping_cidr_block",
+				Description: "The CIDR block of the other VPC that overlaps with cidr_block.",
+				Type:        proto.ColumnType_CIDR,
+			},
+			{
+				Name:        "overlapping_vpc_id",
+				Description: "The ID of the other VPC.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "overlapping_owner_id",
+				Description: "The ID of the AWS account that owns the other VPC.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "overlapping_vpc_region",
+				Description: "The region of the other VPC.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "overlapping_connection_name",
+				Description: "The name of the Steampipe connection the other VPC was found in. Null if the other VPC is only known through a peering connection.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("OverlappingConnectionName").Transform(transform.NullIfZeroValue),
+			},
+			{
+				Name:        "overlap_cidr_block",
+				Description: "The address range shared by both CIDR blocks.",
+				Type:        proto.ColumnType_CIDR,
+			},
+			{
+				Name:        "ip_version",
+				Description: "The IP version of the overlapping CIDR blocks (4 or 6).",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "is_connected",
+				Description: "True if the VPCs are peered or attached to the same transit gateway, so the overlap can cause routing conflicts.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "has_subnet_conflict",
+				Description: "True if subnets in both VPCs use addresses in the overlapping range. Null if the other VPC is only known through a peering connection, since its subnets are not visible.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "subnet_ids",
+				Description: "The subnets of the VPC that use addresses in the overlapping range.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "overlapping_subnet_ids",
+				Description: "The subnets of the other VPC that use addresses in the overlapping range.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "vpc_peering_connection_ids",
+				Description: "The active VPC peering connections between the two VPCs.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "transit_gateway_ids",
+				Description: "The transit gateways that both VPCs are attached to.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard columns for all tables
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(vpcCidrOverlapTitle),
+			},
+		}),
+	}
+}
+
+type vpcCidrOverlap struct {
+	CidrBlock                 string
+	VpcId                     string
+	OwnerId                   string
+	VpcRegion                 string
+	OverlappingCidrBlock      string
+	OverlappingVpcId          string
+	OverlappingOwnerId        string
+	OverlappingVpcRegion      string
+	OverlappingConnectionName string
+	OverlapCidrBlock          string
+	IpVersion                 int
+	IsConnected               bool
+	HasSubnetConflict         *bool
+	SubnetIds                 []string
+	OverlappingSubnetIds      []string
+	VpcPeeringConnectionIds   []string
+	TransitGatewayIds         []string
+}
+
+// vpcCidrOverlapVpc holds the CIDR blocks of a VPC found in a connection, or
+// of a remote VPC known only through a peering connection.
+type vpcCidrOverlapVpc struct {
+	VpcId          string
+	OwnerId        string
+	Region         string
+	ConnectionName string
+	Blocks         []netip.Prefix
+	Subnets        []vpcCidrOverlapSubnet
+	Peerings       map[string][]string
+	Gateways       map[string]bool
+	Remote         bool
+}
+
+type vpcCidrOverlapSubnet struct {
+	SubnetId string
+	Block    netip.Prefix
+}
+
+// vpcCidrOverlapInventory holds the VPCs and active peering connections of one
+// region of a connection. It is cached and shared between queries, so it is
+// never modified once listed.
+type vpcCidrOverlapInventory struct {
+	Vpcs     []*vpcCidrOverlapVpc
+	Peerings []vpcCidrOverlapPeering
+}
+
+type vpcCidrOverlapPeering struct {
+	VpcPeeringConnectionId string
+	Requester              *vpcCidrOverlapVpc
+	Accepter               *vpcCidrOverlapVpc
+}
+
+//// LIST FUNCTION
+
+func listVpcCidrOverlaps(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	region := d.EqualsQualString(matrixKeyRegion)
+
+	// Every region of every connection in the aggregators this connection
+	// belongs to is compared, so collect all of them before looking for
+	// overlaps. This connection is listed first, so a VPC shared with another
+	// connection keeps this connection's name.
+	var local []string
+	vpcs := map[string]*vpcCidrOverlapVpc{}
+	var peerings []vpcCidrOverlapPeering
+	for _, connectionName := range vpcCidrOverlapConnections(d) {
+		qd, err := vpcCidrOverlapQueryData(d, connectionName)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_vpc_cidr_overlap.listVpcCidrOverlaps", "connection_name", connectionName, "connection_error", err)
+			return nil, err
+		}
+
+		regions, err := vpcCidrOverlapRegions(ctx, qd)
+		if err != nil {
+			if connectionName == d.Connection.Name {
+				plugin.Logger(ctx).Error("aws_vpc_cidr_overlap.listVpcCidrOverlaps", "regions_error", err)
+				return nil, err
+			}
+			// Other connections must not break the query for this one
+			plugin.Logger(ctx).Warn("aws_vpc_cidr_overlap.listVpcCidrOverlaps", "connection_name", connectionName, "regions_error", err)
+			continue
+		}
+
+		for _, r := range regions {
+			h := &plugin.HydrateData{Item: vpcCidrOverlapInventoryRequest{Region: r, RateLimiter: d}}
+			i, err := getVpcCidrOverlapInventory(ctx, qd, h)
+			if err != nil {
+				if connectionName == d.Connection.Name && r == region {
+					plugin.Logger(ctx).Error("aws_vpc_cidr_overlap.listVpcCidrOverlaps", "inventory_error", err)
+					return nil, err
+				}
+				plugin.Logger(ctx).Warn("aws_vpc_cidr_overlap.listVpcCidrOverlaps", "connection_name", connectionName, "region", r, "inventory_error", err)
+				continue
+			}
+			inventory := i.(*vpcCidrOverlapInventory)
+			for _, vpc := range inventory.Vpcs {
+				key := vpc.key()
+				if connectionName == d.Connection.Name && r == region {
+					local = append(local, key)
+				}
+				if _, ok := vpcs[key]; !ok {
+					vpcs[key] = vpc.copy()
+				}
+			}
+			peerings = append(peerings, inventory.Peerings...)
+		}
+	}
+
+	// Both sides of a peering connection list it, so only add it once. A
+	// side that is not in any inventory is a remote VPC, known only through
+	// the CIDR blocks the peering connection reports for it.
+	seen := map[string]bool{}
+	for _, peering := range peerings {
+		if seen[peering.VpcPeeringConnectionId] {
+			continue
+		}
+		seen[peering.VpcPeeringConnectionId] = true
+		requester := vpcCidrOverlapPeerVpc(vpcs, peering.Requester)
+		accepter := vpcCidrOverlapPeerVpc(vpcs, peering.Accepter)
+		requester.Peerings[accepter.key()] = append(requester.Peerings[accepter.key()], peering.VpcPeeringConnectionId)
+		accepter.Peerings[requester.key()] = append(accepter.Peerings[requester.key()], peering.VpcPeeringConnectionId)
+	}
+
+	for _, overlap := range findVpcCidrOverlaps(vpcs, local) {
+		d.StreamListItem(ctx, overlap)
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// findVpcCidrOverlaps compares the CIDR blocks of the local VPCs with those
+// of every other VPC using a prefix trie, and returns one row per overlapping
+// pair of blocks with the local VPC first. Remote VPCs known only through a
+// peering connection are compared with the local VPCs they are peered with.
+func findVpcCidrOverlaps(vpcs map[string]*vpcCidrOverlapVpc, local []string) []vpcCidrOverlap {
+	type entry struct {
+		vpc   *vpcCidrOverlapVpc
+		block netip.Prefix
+	}
+
+	// Sort the VPCs so the output is stable
+	keys := make([]string, 0, len(vpcs))
+	for k := range vpcs {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	local = append([]string(nil), local...)
+	sort.Strings(local)
+
+	var entries []entry
+	trie := newCidrPrefixTrie()
+	for _, k := range keys {
+		if vpcs[k].Remote {
+			continue
+		}
+		for _, block := range vpcs[k].Blocks {
+			trie.Insert(block, len(entries))
+			entries = append(entries, entry{vpc: vpcs[k], block: block})
+		}
+	}
+
+	var overlaps []vpcCidrOverlap
+	for _, k := range local {
+		vpc := vpcs[k]
+		for _, block := range vpc.Blocks {
+			matches := trie.Overlapping(block)
+			sort.Ints(matches)
+			for _, j := range matches {
+				// Skip blocks of the same VPC
+				if entries[j].vpc == vpc {
+					continue
+				}
+				if overlap, ok := newVpcCidrOverlap(vpc, block, entries[j].vpc, entries[j].block); ok {
+					overlaps = append(overlaps, overlap)
+				}
+			}
+		}
+
+		peers := make([]string, 0, len(vpc.Peerings))
+		for peer := range vpc.Peerings {
+			peers = append(peers, peer)
+		}
+		sort.Strings(peers)
+		for _, peer := range peers {
+			remote := vpcs[peer]
+			if !remote.Remote {
+				continue
+			}
+			for _, block := range vpc.Blocks {
+				for _, remoteBlock := range remote.Blocks {
+					if overlap, ok := newVpcCidrOverlap(vpc, block, remote, remoteBlock); ok {
+						overlaps = append(overlaps, overlap)
+					}
+				}
+			}
+		}
+	}
+
+	return overlaps
+}
+
+//// HYDRATE FUNCTIONS
+
+// The VPCs, subnets, peering connections and transit gateway attachments of
+// a region are listed once per connection and shared by the queries of every
+// connection they are compared with.
+var getVpcCidrOverlapInventory = plugin.HydrateFunc(getVpcCidrOverlapInventoryUncached).Memoize(memoize.WithCacheKeyFunction(getVpcCidrOverlapInventoryCacheKey), memoize.WithTtl(5*time.Minute))
+
+type vpcCidrOverlapInventoryRequest struct {
+	Region string
+	// The query data of the running query, which holds the rate limiters.
+	// The inventory may be listed for another connection, whose query data
+	// has none.
+	RateLimiter *plugin.QueryData
+}
+
+func getVpcCidrOverlapInventoryCacheKey(_ context.Context, _ *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	request := h.Item.(vpcCidrOverlapInventoryRequest)
+	return fmt.Sprintf("getVpcCidrOverlapInventory-%s", request.Region), nil
+}
+
+func getVpcCidrOverlapInventoryUncached(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	request := h.Item.(vpcCidrOverlapInventoryRequest)
+	region := request.Region
+
+	// Create session
+	svc, err := EC2ClientForRegion(ctx, d, region)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_vpc_cidr_overlap.getVpcCidrOverlapInventoryUncached", "connection_error", err)
+		return nil, err
+	}
+
+	vpcs := map[string]*vpcCidrOverlapVpc{}
+	inventory := &vpcCidrOverlapInventory{}
+
+	vpcPaginator := ec2.NewDescribeVpcsPaginator(svc, &ec2.DescribeVpcsInput{MaxResults: aws.Int32(1000)})
+	for vpcPaginator.HasMorePages() {
+		// apply rate limiting
+		request.RateLimiter.WaitForListRateLimit(ctx)
+
+		output, err := vpcPaginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_vpc_cidr_overlap.getVpcCidrOverlapInventoryUncached", "describe_vpcs_error", err)
+			return nil, err
+		}
+		for _, vpc := range output.Vpcs {
+			item := newVpcCidrOverlapVpc(*vpc.VpcId, aws.ToString(vpc.OwnerId), region)
+			item.ConnectionName = d.Connection.Name
+			for _, assoc := range vpc.CidrBlockAssociationSet {
+				if assoc.CidrBlockState != nil && assoc.CidrBlockState.State != types.VpcCidrBlockStateCodeAssociated {
+					continue
+				}
+				item.addBlock(aws.ToString(assoc.CidrBlock))
+			}
+			for _, assoc := range vpc.Ipv6CidrBlockAssociationSet {
+				if assoc.Ipv6CidrBlockState != nil && assoc.Ipv6CidrBlockState.State != types.VpcCidrBlockStateCodeAssociated {
+					continue
+				}
+				item.addBlock(aws.ToString(assoc.Ipv6CidrBlock))
+			}
+			vpcs[*vpc.VpcId] = item
+			inventory.Vpcs = append(inventory.Vpcs, item)
+		}
+	}
+
+	subnetPaginator := ec2.NewDescribeSubnetsPaginator(svc, &ec2.DescribeSubnetsInput{MaxResults: aws.Int32(1000)})
+	for subnetPaginator.HasMorePages() {
+		// apply rate limiting
+		request.RateLimiter.WaitForListRateLimit(ctx)
+
+		output, err := subnetPaginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_vpc_cidr_overlap.getVpcCidrOverlapInventoryUncached", "describe_subnets_error", err)
+			return nil, err
+		}
+		for _, subnet := range output.Subnets {
+			vpc, ok := vpcs[aws.ToString(subnet.VpcId)]
+			if !ok {
+				continue
+			}
+			vpc.addSubnet(*subnet.SubnetId, aws.ToString(subnet.CidrBlock))
+			for _, assoc := range subnet.Ipv6CidrBlockAssociationSet {
+				if assoc.Ipv6CidrBlockState != nil && assoc.Ipv6CidrBlockState.State != types.SubnetCidrBlockStateCodeAssociated {
+					continue
+				}
+				vpc.addSubnet(*subnet.SubnetId, aws.ToString(assoc.Ipv6CidrBlock))
+			}
+		}
+	}
+
+	// Peering connections also describe the remote VPC, which may live in a
+	// region or account outside the aggregator. Its CIDR blocks are taken
+	// from the peering connection so overlaps with it are still found.
+	peeringInput := &ec2.DescribeVpcPeeringConnectionsInput{
+		MaxResults: aws.Int32(1000),
+		Filters: []types.Filter{
+			{Name: aws.String("status-code"), Values: []string{"active"}},
+		},
+	}
+	peeringPaginator := ec2.NewDescribeVpcPeeringConnectionsPaginator(svc, peeringInput)
+	for peeringPaginator.HasMorePages() {
+		// apply rate limiting
+		request.RateLimiter.WaitForListRateLimit(ctx)
+
+		output, err := peeringPaginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_vpc_cidr_overlap.getVpcCidrOverlapInventoryUncached", "describe_vpc_peering_connections_error", err)
+			return nil, err
+		}
+		for _, peering := range output.VpcPeeringConnections {
+			if peering.RequesterVpcInfo == nil || peering.AccepterVpcInfo == nil {
+				continue
+			}
+			inventory.Peerings = append(inventory.Peerings, vpcCidrOverlapPeering{
+				VpcPeeringConnectionId: aws.ToString(peering.VpcPeeringConnectionId),
+				Requester:              newVpcCidrOverlapRemoteVpc(peering.RequesterVpcInfo),
+				Accepter:               newVpcCidrOverlapRemoteVpc(peering.AccepterVpcInfo),
+			})
+		}
+	}
+
+	attachmentInput := &ec2.DescribeTransitGatewayVpcAttachmentsInput{
+		MaxResults: aws.Int32(1000),
+		Filters: []types.Filter{
+			{Name: aws.String("state"), Values: []string{"available"}},
+		},
+	}
+	attachmentPaginator := ec2.NewDescribeTransitGatewayVpcAttachmentsPaginator(svc, attachmentInput)
+	for attachmentPaginator.HasMorePages() {
+		// apply rate limiting
+		request.RateLimiter.WaitForListRateLimit(ctx)
+
+		output, err := attachmentPaginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_vpc_cidr_overlap.getVpcCidrOverlapInventoryUncached", "describe_transit_gateway_vpc_attachments_error", err)
+			return nil, err
+		}
+		for _, attachment := range output.TransitGatewayVpcAttachments {
+			if vpc, ok := vpcs[aws.ToString(attachment.VpcId)]; ok {
+				vpc.Gateways[aws.ToString(attachment.TransitGatewayId)] = true
+			}
+		}
+	}
+
+	return inventory, nil
+}
+
+// newVpcCidrOverlap builds the row for a pair of blocks, if they overlap.
+func newVpcCidrOverlap(a *vpcCidrOverlapVpc, aBlock netip.Prefix, b *vpcCidrOverlapVpc, bBlock netip.Prefix) (vpcCidrOverlap, bool) {
+	shared, ok := cidrOverlap(aBlock, bBlock)
+	if !ok {
+		return vpcCidrOverlap{}, false
+	}
+
+	overlap := vpcCidrOverlap{
+		CidrBlock:                 aBlock.String(),
+		VpcId:                     a.VpcId,
+		OwnerId:                   a.OwnerId,
+		VpcRegion:                 a.Region,
+		OverlappingCidrBlock:      bBlock.String(),
+		OverlappingVpcId:          b.VpcId,
+		OverlappingOwnerId:        b.OwnerId,
+		OverlappingVpcRegion:      b.Region,
+		OverlappingConnectionName: b.ConnectionName,
+		OverlapCidrBlock:          shared.String(),
+		IpVersion:                 4,
+		VpcPeeringConnectionIds:   a.Peerings[b.key()],
+		SubnetIds:                 a.subnetsIn(shared),
+		OverlappingSubnetIds:      b.subnetsIn(shared),
+	}
+	if shared.Addr().Is6() {
+		overlap.IpVersion = 6
+	}
+	for id := range a.Gateways {
+		if b.Gateways[id] {
+			overlap.TransitGatewayIds = append(overlap.TransitGatewayIds, id)
+		}
+	}
+	sort.Strings(overlap.TransitGatewayIds)
+	overlap.IsConnected = len(overlap.VpcPeeringConnectionIds) > 0 || len(overlap.TransitGatewayIds) > 0
+
+	// The subnets of a remote VPC are unknown, so whether they conflict is too
+	if !a.Remote && !b.Remote {
+		overlap.HasSubnetConflict = aws.Bool(vpcCidrOverlapSubnetsConflict(a, b, shared))
+	}
+
+	return overlap, true
+}
+
+func newVpcCidrOverlapVpc(vpcId, ownerId, region string) *vpcCidrOverlapVpc {
+	return &vpcCidrOverlapVpc{
+		VpcId:    vpcId,
+		OwnerId:  ownerId,
+		Region:   region,
+		Peerings: map[string][]string{},
+		Gateways: map[string]bool{},
+	}
+}
+
+// newVpcCidrOverlapRemoteVpc returns one side of a peering connection, with
+// the CIDR blocks the peering connection reports for it.
+func newVpcCidrOverlapRemoteVpc(info *types.VpcPeeringConnectionVpcInfo) *vpcCidrOverlapVpc {
+	vpc := newVpcCidrOverlapVpc(aws.ToString(info.VpcId), aws.ToString(info.OwnerId), aws.ToString(info.Region))
+	vpc.Remote = true
+	for _, block := range info.CidrBlockSet {
+		vpc.addBlock(aws.ToString(block.CidrBlock))
+	}
+	if len(info.CidrBlockSet) == 0 {
+		vpc.addBlock(aws.ToString(info.CidrBlock))
+	}
+	for _, block := range info.Ipv6CidrBlockSet {
+		vpc.addBlock(aws.ToString(block.Ipv6CidrBlock))
+	}
+	return vpc
+}
+
+// vpcCidrOverlapPeerVpc returns the VPC for one side of a peering connection,
+// adding it as a remote VPC if it is not in any inventory.
+func vpcCidrOverlapPeerVpc(vpcs map[string]*vpcCidrOverlapVpc, peer *vpcCidrOverlapVpc) *vpcCidrOverlapVpc {
+	key := peer.key()
+	if vpc, ok := vpcs[key]; ok {
+		return vpc
+	}
+	vpc := peer.copy()
+	vpcs[key] = vpc
+	return vpc
+}
+
+// key identifies a VPC across accounts and regions.
+func (v *vpcCidrOverlapVpc) key() string {
+	return v.OwnerId + "/" + v.Region + "/" + v.VpcId
+}
+
+// copy returns a VPC with its own peering connections, since the inventory
+// the VPC comes from is cached and shared between queries.
+func (v *vpcCidrOverlapVpc) copy() *vpcCidrOverlapVpc {
+	vpc := *v
+	vpc.Peerings = map[string][]string{}
+	return &vpc
+}
+
+func (v *vpcCidrOverlapVpc) addBlock(cidr string) {
+	if prefix, err := netip.ParsePrefix(cidr); err == nil {
+		v.Blocks = append(v.Blocks, prefix.Masked())
+	}
+}
+
+func (v *vpcCidrOverlapVpc) addSubnet(subnetId, cidr string) {
+	if prefix, err := netip.ParsePrefix(cidr); err == nil {
+		v.Subnets = append(v.Subnets, vpcCidrOverlapSubnet{SubnetId: subnetId, Block: prefix.Masked()})
+	}
+}
+
+func (v *vpcCidrOverlapVpc) subnetsIn(prefix netip.Prefix) []string {
+	var ids []string
+	for _, subnet := range v.Subnets {
+		if subnet.Block.Overlaps(prefix) {
+			ids = append(ids, subnet.SubnetId)
+		}
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+func vpcCidrOverlapSubnetsConflict(a, b *vpcCidrOverlapVpc, prefix netip.Prefix) bool {
+	for _, sa := range a.Subnets {
+		if !sa.Block.Overlaps(prefix) {
+			continue
+		}
+		for _, sb := range b.Subnets {
+			if sb.Block.Overlaps(prefix) && sa.Block.Overlaps(sb.Block) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+//// UTILITY FUNCTIONS
+
+// vpcCidrOverlapConnections returns the connection being queried followed by
+// the other connections of every aggregator it belongs to, in name order.
+func vpcCidrOverlapConnections(d *plugin.QueryData) []string {
+	names := map[string]bool{}
+	for _, connectionData := range d.Table.Plugin.ConnectionMap {
+		if _, ok := connectionData.AggregatedTablesByConnection[d.Connection.Name]; !ok {
+			continue
+		}
+		for name := range connectionData.AggregatedTablesByConnection {
+			if name != d.Connection.Name {
+				names[name] = true
+			}
+		}
+	}
+
+	others := make([]string, 0, len(names))
+	for name := range names {
+		others = append(others, name)
+	}
+	sort.Strings(others)
+	return append([]string{d.Connection.Name}, others...)
+}
+
+var (
+	vpcCidrOverlapConnectionCaches     = map[*plugin.Connection]*connection.ConnectionCache{}
+	vpcCidrOverlapConnectionCachesLock sync.Mutex
+)
+
+// vpcCidrOverlapQueryData returns query data for listing the inventory of a
+// connection. Memoize caches per connection, and the plugin only creates the
+// cache of a connection when that connection is queried, so the inventories
+// are kept in a cache of their own. It is keyed by the connection itself, so
+// a change to the connection config starts a new cache.
+func vpcCidrOverlapQueryData(d *plugin.QueryData, connectionName string) (*plugin.QueryData, error) {
+	connectionData, ok := d.Table.Plugin.ConnectionMap[connectionName]
+	if !ok {
+		return nil, fmt.Errorf("connection %s not found", connectionName)
+	}
+	conn := connectionData.Connection
+
+	vpcCidrOverlapConnectionCachesLock.Lock()
+	defer vpcCidrOverlapConnectionCachesLock.Unlock()
+
+	cache, ok := vpcCidrOverlapConnectionCaches[conn]
+	if !ok {
+		for c := range vpcCidrOverlapConnectionCaches {
+			if c.Name == connectionName {
+				delete(vpcCidrOverlapConnectionCaches, c)
+			}
+		}
+		var err error
+		cache, err = connection.NewConnectionCache(connectionName, 10000)
+		if err != nil {
+			return nil, err
+		}
+		vpcCidrOverlapConnectionCaches[conn] = cache
+	}
+
+	return &plugin.QueryData{
+		Connection:        conn,
+		ConnectionCache:   cache,
+		ConnectionManager: connection.NewManager(cache),
+	}, nil
+}
+
+// vpcCidrOverlapRegions returns the regions of a connection that the table
+// would query, as SupportedRegionMatrix does.
+func vpcCidrOverlapRegions(ctx context.Context, d *plugin.QueryData) ([]string, error) {
+	queryRegions, err := listQueryRegionsForConnection(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+	serviceRegions, err := listRegionsForService(ctx, d, AWS_EC2_SERVICE_ID)
+	if err != nil {
+		return nil, err
+	}
+
+	var regions []string
+	for _, region := range queryRegions {
+		if slices.Contains(serviceRegions, region) {
+			regions = append(regions, region)
+		}
+	}
+	return regions, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func vpcCidrOverlapTitle(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	overlap := d.HydrateItem.(vpcCidrOverlap)
+	return overlap.VpcId + " " + overlap.CidrBlock + " / " + overlap.OverlappingVpcId + " " + overlap.OverlappingCidrBlock, nil
+}
@@ -0,0 +1,333 @@
+package aws
+
+import (
+	"context"
+	"encoding/binary"
+	"math"
+	"net/netip"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+// AWS reserves the first four and the last IPv4 address of every subnet.
+const subnetReservedIpAddressCount = 5
+
+//// TABLE DEFINITION
+
+func tableAwsVpcSubnetCapacity(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_vpc_subnet_capacity",
+		Description: "AWS VPC Subnet Capacity",
+		List: &plugin.ListConfig{
+			Hydrate: listVpcSubnets,
+			Tags:    map[string]string{"service": "ec2", "action": "DescribeSubnets"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "availability_zone", Require: plugin.Optional},
+				{Name: "cidr_block", Require: plugin.Optional},
+				{Name: "vpc_id", Require: plugin.Optional},
+			},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getVpcSubnetCapacityUsage,
+				Tags: map[string]string{"service": "ec2", "action": "DescribeNetworkInterfaces"},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(AWS_EC2_SERVICE_ID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "subnet_id",
+				Description: "The ID of the subnet.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "vpc_id",
+				Description: "The ID of the VPC the subnet is in.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "cidr_block",
+				Description: "The IPv4 CIDR block assigned to the subnet.",
+				Type:        proto.ColumnType_CIDR,
+			},
+			{
+				Name:        "availability_zone",
+				Description: "The Availability Zone of the subnet.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "total_ip_address_count",
+				Description: "The number of IPv4 addresses in the subnet CIDR block.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.From(vpcSubnetCapacityTotalIpAddressCount),
+			},
+			{
+				Name:        "reserved_ip_address_count",
+				Description: "The number of IPv4 addresses in the subnet reserved by AWS.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromConstant(subnetReservedIpAddressCount),
+			},
+			{
+				Name:        "available_ip_address_count",
+				Description: "The number of unused private IPv4 addresses in the subnet. The IPv4 addresses for any stopped instances are considered unavailable.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "used_ip_address_count",
+				Description: "The number of IPv4 addresses in the subnet that are in use, excluding the addresses reserved by AWS.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.From(vpcSubnetCapacityUsedIpAddressCount),
+			},
+			{
+				Name:        "ip_address_utilization",
+				Description: "The percentage of usable IPv4 addresses in the subnet that are in use.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.From(vpcSubnetCapacityIpAddressUtilization),
+			},
+			{
+				Name:        "free_ip_range_count",
+				Description: "The number of contiguous ranges of free IPv4 addresses in the subnet, based on network interface addresses, delegated prefixes and subnet CIDR reservations.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getVpcSubnetCapacityUsage,
+			},
+			{
+				Name:        "largest_free_ip_range_size",
+				Description: "The number of addresses in the largest contiguous range of free IPv4 addresses in the subnet.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getVpcSubnetCapacityUsage,
+			},
+			{
+				Name:        "free_prefix_count",
+				Description: "The number of free, aligned /28 blocks in the subnet that can still be assigned as IPv4 prefixes to network interfaces.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getVpcSubnetCapacityUsage,
+			},
+			{
+				Name:        "fragmentation",
+				Description: "How fragmented the free address space of the subnet is, from 0 (all free addresses in one range) towards 1 (free addresses scattered across many small ranges).",
+				Type:        proto.ColumnType_DOUBLE,
+				Hydrate:     getVpcSubnetCapacityUsage,
+			},
+			{
+				Name:        "network_interface_count",
+				Description: "The number of network interfaces in the subnet.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getVpcSubnetCapacityUsage,
+			},
+
+			// Standard columns for all tables
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(getSubnetTurbotTitle),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("SubnetArn").Transform(arnToAkas),
+			},
+		}),
+	}
+}
+
+type vpcSubnetCapacityUsage struct {
+	FreeIpRangeCount       int
+	LargestFreeIpRangeSize int
+	FreePrefixCount        int
+	Fragmentation          float64
+	NetworkInterfaceCount  int
+}
+
+//// HYDRATE FUNCTIONS
+
+func getVpcSubnetCapacityUsage(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	subnet := h.Item.(types.Subnet)
+
+	block, err := netip.ParsePrefix(aws.ToString(subnet.CidrBlock))
+	if err != nil || !block.Addr().Is4() {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := EC2Client(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_vpc_subnet_capacity.getVpcSubnetCapacityUsage", "connection_error", err)
+		return nil, err
+	}
+
+	usage := &vpcSubnetCapacityUsage{}
+	var used []netip.Prefix
+
+	input := &ec2.DescribeNetworkInterfacesInput{
+		Filters: []types.Filter{
+			{Name: aws.String("subnet-id"), Values: []string{*subnet.SubnetId}},
+		},
+		MaxResults: aws.Int32(1000),
+	}
+	paginator := ec2.NewDescribeNetworkInterfacesPaginator(svc, input)
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_vpc_subnet_capacity.getVpcSubnetCapacityUsage", "describe_network_interfaces_error", err)
+			return nil, err
+		}
+		for _, eni := range output.NetworkInterfaces {
+			usage.NetworkInterfaceCount++
+			for _, ip := range eni.PrivateIpAddresses {
+				if addr, err := netip.ParseAddr(aws.ToString(ip.PrivateIpAddress)); err == nil {
+					used = append(used, netip.PrefixFrom(addr, 32))
+				}
+			}
+			for _, prefix := range eni.Ipv4Prefixes {
+				if p, err := netip.ParsePrefix(aws.ToString(prefix.Ipv4Prefix)); err == nil {
+					used = append(used, p)
+				}
+			}
+		}
+	}
+
+	// Addresses held by subnet CIDR reservations can't be assigned
+	// automatically, so treat them as used too.
+	reservationInput := &ec2.GetSubnetCidrReservationsInput{
+		SubnetId:   subnet.SubnetId,
+		MaxResults: aws.Int32(1000),
+	}
+	pagesLeft := true
+	for pagesLeft {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := svc.GetSubnetCidrReservations(ctx, reservationInput)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_vpc_subnet_capacity.getVpcSubnetCapacityUsage", "get_subnet_cidr_reservations_error", err)
+			return nil, err
+		}
+		for _, reservation := range output.SubnetIpv4CidrReservations {
+			if p, err := netip.ParsePrefix(aws.ToString(reservation.Cidr)); err == nil {
+				used = append(used, p)
+			}
+		}
+
+		if output.NextToken != nil {
+			reservationInput.NextToken = output.NextToken
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	ranges := subnetFreeIpRanges(block, used)
+	free := 0
+	for _, r := range ranges {
+		free += r[1] - r[0]
+		if size := r[1] - r[0]; size > usage.LargestFreeIpRangeSize {
+			usage.LargestFreeIpRangeSize = size
+		}
+		usage.FreePrefixCount += alignedBlockCount(r[0], r[1], 16)
+	}
+	usage.FreeIpRangeCount = len(ranges)
+	if free > 0 {
+		usage.Fragmentation = math.Round((1-float64(usage.LargestFreeIpRangeSize)/float64(free))*10000) / 10000
+	}
+
+	return usage, nil
+}
+
+// subnetFreeIpRanges returns the free address ranges of an IPv4 subnet as
+// [start, end) offsets from the subnet address, skipping the addresses AWS
+// reserves and anything covered by the used prefixes.
+func subnetFreeIpRanges(subnet netip.Prefix, used []netip.Prefix) [][2]int {
+	subnet = subnet.Masked()
+	size := 1 << (32 - subnet.Bits())
+	base := binary.BigEndian.Uint32(subnet.Addr().AsSlice())
+
+	taken := make([]bool, size)
+	for i := 0; i < size && i < 4; i++ {
+		taken[i] = true
+	}
+	taken[size-1] = true
+
+	for _, p := range used {
+		p = p.Masked()
+		if !p.Addr().Is4() || !p.Overlaps(subnet) {
+			continue
+		}
+		start := int(binary.BigEndian.Uint32(p.Addr().AsSlice()) - base)
+		end := start + 1<<(32-p.Bits())
+		if p.Bits() < subnet.Bits() {
+			start, end = 0, size
+		}
+		for i := start; i < end && i < size; i++ {
+			taken[i] = true
+		}
+	}
+
+	var ranges [][2]int
+	start := -1
+	for i := 0; i <= size; i++ {
+		if i < size && !taken[i] {
+			if start < 0 {
+				start = i
+			}
+			continue
+		}
+		if start >= 0 {
+			ranges = append(ranges, [2]int{start, i})
+			start = -1
+		}
+	}
+	return ranges
+}
+
+// alignedBlockCount returns how many blocks of the given size, aligned on
+// that size, fit entirely in the range [start, end).
+func alignedBlockCount(start, end, blockSize int) int {
+	first := (start + blockSize - 1) / blockSize * blockSize
+	if first >= end {
+		return 0
+	}
+	return (end - first) / blockSize
+}
+
+//// TRANSFORM FUNCTIONS
+
+func vpcSubnetCapacityTotalIpAddressCount(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	subnet := d.HydrateItem.(types.Subnet)
+	block, err := netip.ParsePrefix(aws.ToString(subnet.CidrBlock))
+	if err != nil || !block.Addr().Is4() {
+		return nil, nil
+	}
+	return 1 << (32 - block.Bits()), nil
+}
+
+func vpcSubnetCapacityUsedIpAddressCount(ctx context.Context, d *transform.TransformData) (interface{}, error) {
+	total, _ := vpcSubnetCapacityTotalIpAddressCount(ctx, d)
+	subnet := d.HydrateItem.(types.Subnet)
+	if total == nil || subnet.AvailableIpAddressCount == nil {
+		return nil, nil
+	}
+	return total.(int) - subnetReservedIpAddressCount - int(*subnet.AvailableIpAddressCount), nil
+}
+
+func vpcSubnetCapacityIpAddressUtilization(ctx context.Context, d *transform.TransformData) (interface{}, error) {
+	total, _ := vpcSubnetCapacityTotalIpAddressCount(ctx, d)
+	used, _ := vpcSubnetCapacityUsedIpAddressCount(ctx, d)
+	if total == nil || used == nil {
+		return nil, nil
+	}
+	usable := total.(int) - subnetReservedIpAddressCount
+	if usable <= 0 {
+		return nil, nil
+	}
+	return math.Round(float64(used.(int))/float64(usable)*10000) / 100, nil
+}
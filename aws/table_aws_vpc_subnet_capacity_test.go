@@ -0,0 +1,70 @@
+package aws
+
+import (
+	"net/netip"
+	"reflect"
+	"testing"
+)
+
+func TestSubnetFreeIpRanges(t *testing.T) {
+	testCases := []struct {
+		name     string
+		subnet   string
+		used     []string
+		expected [][2]int
+	}{
+		{"reserved addresses only", "10.0.0.0/28", nil, [][2]int{{4, 15}}},
+		{"unmasked subnet", "10.0.0.9/28", nil, [][2]int{{4, 15}}},
+		{"smallest subnet", "10.0.0.0/30", nil, nil},
+		{"single address in use", "10.0.0.0/28", []string{"10.0.0.8/32"}, [][2]int{{4, 8}, {9, 15}}},
+		{"cidr reservation", "10.0.0.0/24", []string{"10.0.0.64/26"}, [][2]int{{4, 64}, {128, 255}}},
+		{"partially used block", "10.0.0.0/27", []string{"10.0.0.16/32", "10.0.0.17/32", "10.0.0.20/30"}, [][2]int{{4, 16}, {18, 20}, {24, 31}}},
+		{"reservation overlapping reserved addresses", "10.0.0.0/28", []string{"10.0.0.0/29"}, [][2]int{{8, 15}}},
+		{"reservation covering the subnet", "10.0.0.0/28", []string{"10.0.0.0/16"}, nil},
+		{"address and reservation in use", "10.0.0.0/26", []string{"10.0.0.10/32", "10.0.0.32/28"}, [][2]int{{4, 10}, {11, 32}, {48, 63}}},
+		{"addresses outside the subnet", "10.0.0.0/28", []string{"10.0.1.5/32", "10.0.0.16/28"}, [][2]int{{4, 15}}},
+		{"ipv6 prefixes are ignored", "10.0.0.0/28", []string{"2600:1f18::/64"}, [][2]int{{4, 15}}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var used []netip.Prefix
+			for _, p := range tc.used {
+				used = append(used, netip.MustParsePrefix(p))
+			}
+			got := subnetFreeIpRanges(netip.MustParsePrefix(tc.subnet), used)
+			if !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("subnetFreeIpRanges(%q, %v) = %v, want %v", tc.subnet, tc.used, got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestAlignedBlockCount(t *testing.T) {
+	testCases := []struct {
+		name      string
+		start     int
+		end       int
+		blockSize int
+		expected  int
+	}{
+		{"aligned range", 0, 64, 16, 4},
+		{"after reserved addresses", 4, 255, 16, 14},
+		{"after reserved addresses of a small subnet", 4, 15, 4, 2},
+		{"range smaller than a block", 4, 15, 16, 0},
+		{"unaligned start", 18, 64, 16, 2},
+		{"unaligned end", 16, 47, 16, 1},
+		{"single addresses", 5, 9, 1, 4},
+		{"only one aligned block left", 11, 32, 16, 1},
+		{"empty range", 8, 8, 1, 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := alignedBlockCount(tc.start, tc.end, tc.blockSize)
+			if got != tc.expected {
+				t.Errorf("alignedBlockCount(%d, %d, %d) = %d, want %d", tc.start, tc.end, tc.blockSize, got, tc.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,276 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/vpclattice"
+	"github.com/aws/aws-sdk-go-v2/service/vpclattice/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsVpcLatticeListener(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_vpclattice_listener",
+		Description: "AWS VPC Lattice Listener",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"service_id", "id"}),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException"}),
+			},
+			Hydrate: getVpcLatticeListener,
+			Tags:    map[string]string{"service": "vpc-lattice", "action": "GetListener"},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listVpcLatticeServices,
+			Hydrate:       listVpcLatticeListeners,
+			Tags:          map[string]string{"service": "vpc-lattice", "action": "ListListeners"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "service_id", Require: plugin.Optional},
+			},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getVpcLatticeListenerDetails,
+				Tags: map[string]string{"service": "vpc-lattice", "action": "GetListener"},
+			},
+			{
+				Func: getVpcLatticeListenerTags,
+				Tags: map[string]string{"service": "vpc-lattice", "action": "ListTagsForResource"},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(AWS_VPC_LATTICE_SERVICE_ID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the listener.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Listener.Name"),
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the listener.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Listener.Id"),
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the listener.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Listener.Arn"),
+			},
+			{
+				Name:        "service_id",
+				Description: "The ID of the service.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "service_arn",
+				Description: "The Amazon Resource Name (ARN) of the service.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "port",
+				Description: "The listener port.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Listener.Port"),
+			},
+			{
+				Name:        "protocol",
+				Description: "The listener protocol.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Listener.Protocol"),
+			},
+			{
+				Name:        "created_at",
+				Description: "The date and time that the listener was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Listener.CreatedAt"),
+			},
+			{
+				Name:        "last_updated_at",
+				Description: "The date and time of the last update.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Listener.LastUpdatedAt"),
+			},
+			{
+				Name:        "default_action",
+				Description: "The action for the default rule of the listener.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getVpcLatticeListenerDetails,
+				Transform:   transform.FromField("DefaultAction"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Listener.Name"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getVpcLatticeListenerTags,
+				Transform:   transform.FromField("Tags"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Listener.Arn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+type VpcLatticeListenerInfo struct {
+	Listener   types.ListenerSummary
+	ServiceId  string
+	ServiceArn string
+}
+
+//// LIST FUNCTION
+
+func listVpcLatticeListeners(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	service := h.Item.(types.ServiceSummary)
+
+	// Minimize the API calls if the service ID is specified in the query
+	if d.EqualsQualString("service_id") != "" && d.EqualsQualString("service_id") != *service.Id {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := VPCLatticeClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_vpclattice_listener.listVpcLatticeListeners", "client_error", err)
+		return nil, err
+	}
+
+	// Unsupported region check
+	if svc == nil {
+		return nil, nil
+	}
+
+	maxItems := int32(100)
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxItems {
+			if limit < 1 {
+				maxItems = int32(1)
+			} else {
+				maxItems = int32(limit)
+			}
+		}
+	}
+
+	input := &vpclattice.ListListenersInput{
+		ServiceIdentifier: service.Id,
+		MaxResults:        aws.Int32(maxItems),
+	}
+
+	paginator := vpclattice.NewListListenersPaginator(svc, input, func(o *vpclattice.ListListenersPaginatorOptions) {
+		o.Limit = maxItems
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_vpclattice_listener.listVpcLatticeListeners", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range output.Items {
+			d.StreamListItem(ctx, &VpcLatticeListenerInfo{
+				Listener:   item,
+				ServiceId:  *service.Id,
+				ServiceArn: aws.ToString(service.Arn),
+			})
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getVpcLatticeListener(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	serviceId := d.EqualsQualString("service_id")
+	listenerId := d.EqualsQualString("id")
+	if serviceId == "" || listenerId == "" {
+		return nil, nil
+	}
+
+	op, err := getVpcLatticeListenerById(ctx, d, serviceId, listenerId)
+	if err != nil || op == nil {
+		return nil, err
+	}
+
+	return &VpcLatticeListenerInfo{
+		Listener: types.ListenerSummary{
+			Arn:           op.Arn,
+			CreatedAt:     op.CreatedAt,
+			Id:            op.Id,
+			LastUpdatedAt: op.LastUpdatedAt,
+			Name:          op.Name,
+			Port:          op.Port,
+			Protocol:      op.Protocol,
+		},
+		ServiceId:  aws.ToString(op.ServiceId),
+		ServiceArn: aws.ToString(op.ServiceArn),
+	}, nil
+}
+
+func getVpcLatticeListenerDetails(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	listener := h.Item.(*VpcLatticeListenerInfo)
+	return getVpcLatticeListenerById(ctx, d, listener.ServiceId, *listener.Listener.Id)
+}
+
+func getVpcLatticeListenerTags(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	listener := h.Item.(*VpcLatticeListenerInfo)
+	return getVpcLatticeResourceTags(ctx, d, *listener.Listener.Arn)
+}
+
+func getVpcLatticeListenerById(ctx context.Context, d *plugin.QueryData, serviceId string, listenerId string) (*vpclattice.GetListenerOutput, error) {
+	// Create session
+	svc, err := VPCLatticeClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_vpclattice_listener.getVpcLatticeListenerById", "client_error", err)
+		return nil, err
+	}
+
+	// Unsupported region check
+	if svc == nil {
+		return nil, nil
+	}
+
+	params := &vpclattice.GetListenerInput{
+		ServiceIdentifier:  aws.String(serviceId),
+		ListenerIdentifier: aws.String(listenerId),
+	}
+
+	op, err := svc.GetListener(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_vpclattice_listener.getVpcLatticeListenerById", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
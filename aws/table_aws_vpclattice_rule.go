@@ -0,0 +1,318 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/vpclattice"
+	"github.com/aws/aws-sdk-go-v2/service/vpclattice/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsVpcLatticeRule(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_vpclattice_rule",
+		Description: "AWS VPC Lattice Rule",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"service_id", "listener_id", "id"}),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException"}),
+			},
+			Hydrate: getVpcLatticeRule,
+			Tags:    map[string]string{"service": "vpc-lattice", "action": "GetRule"},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listVpcLatticeServices,
+			Hydrate:       listVpcLatticeRules,
+			Tags:          map[string]string{"service": "vpc-lattice", "action": "ListRules"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "service_id", Require: plugin.Optional},
+				{Name: "listener_id", Require: plugin.Optional},
+			},
+			// A listener_id qual is passed to every service, so ignore the lookups against services that do not own it
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException"}),
+			},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getVpcLatticeRuleDetails,
+				Tags: map[string]string{"service": "vpc-lattice", "action": "GetRule"},
+			},
+			{
+				Func: getVpcLatticeRuleTags,
+				Tags: map[string]string{"service": "vpc-lattice", "action": "ListTagsForResource"},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(AWS_VPC_LATTICE_SERVICE_ID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the rule.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Rule.Name"),
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the rule.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Rule.Id"),
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the rule.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Rule.Arn"),
+			},
+			{
+				Name:        "service_id",
+				Description: "The ID of the service.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "listener_id",
+				Description: "The ID of the listener.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "is_default",
+				Description: "Indicates whether this is the default listener rule.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Rule.IsDefault"),
+			},
+			{
+				Name:        "priority",
+				Description: "The priority of the rule.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Rule.Priority"),
+			},
+			{
+				Name:        "created_at",
+				Description: "The date and time that the listener rule was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Rule.CreatedAt"),
+			},
+			{
+				Name:        "last_updated_at",
+				Description: "The date and time that the listener rule was last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Rule.LastUpdatedAt"),
+			},
+			{
+				Name:        "action",
+				Description: "The action for the rule.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getVpcLatticeRuleDetails,
+			},
+			{
+				Name:        "match",
+				Description: "The rule match.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getVpcLatticeRuleDetails,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Rule.Name"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getVpcLatticeRuleTags,
+				Transform:   transform.FromField("Tags"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Rule.Arn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+type VpcLatticeRuleInfo struct {
+	Rule       types.RuleSummary
+	ServiceId  string
+	ListenerId string
+}
+
+//// LIST FUNCTION
+
+func listVpcLatticeRules(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	service := h.Item.(types.ServiceSummary)
+
+	// Minimize the API calls if the service ID is specified in the query
+	if d.EqualsQualString("service_id") != "" && d.EqualsQualString("service_id") != *service.Id {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := VPCLatticeClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_vpclattice_rule.listVpcLatticeRules", "client_error", err)
+		return nil, err
+	}
+
+	// Unsupported region check
+	if svc == nil {
+		return nil, nil
+	}
+
+	// Rules are scoped to a listener, so collect the listeners of the service first
+	var listenerIds []string
+	if d.EqualsQualString("listener_id") != "" {
+		listenerIds = append(listenerIds, d.EqualsQualString("listener_id"))
+	} else {
+		listenerPaginator := vpclattice.NewListListenersPaginator(svc, &vpclattice.ListListenersInput{
+			ServiceIdentifier: service.Id,
+		}, func(o *vpclattice.ListListenersPaginatorOptions) {
+			o.StopOnDuplicateToken = true
+		})
+
+		for listenerPaginator.HasMorePages() {
+			// apply rate limiting
+			d.WaitForListRateLimit(ctx)
+
+			output, err := listenerPaginator.NextPage(ctx)
+			if err != nil {
+				plugin.Logger(ctx).Error("aws_vpclattice_rule.listVpcLatticeRules", "list_listeners_api_error", err)
+				return nil, err
+			}
+
+			for _, listener := range output.Items {
+				listenerIds = append(listenerIds, *listener.Id)
+			}
+		}
+	}
+
+	maxItems := int32(100)
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxItems {
+			if limit < 1 {
+				maxItems = int32(1)
+			} else {
+				maxItems = int32(limit)
+			}
+		}
+	}
+
+	for _, listenerId := range listenerIds {
+		input := &vpclattice.ListRulesInput{
+			ServiceIdentifier:  service.Id,
+			ListenerIdentifier: aws.String(listenerId),
+			MaxResults:         aws.Int32(maxItems),
+		}
+
+		paginator := vpclattice.NewListRulesPaginator(svc, input, func(o *vpclattice.ListRulesPaginatorOptions) {
+			o.Limit = maxItems
+			o.StopOnDuplicateToken = true
+		})
+
+		for paginator.HasMorePages() {
+			// apply rate limiting
+			d.WaitForListRateLimit(ctx)
+
+			output, err := paginator.NextPage(ctx)
+			if err != nil {
+				plugin.Logger(ctx).Error("aws_vpclattice_rule.listVpcLatticeRules", "api_error", err)
+				return nil, err
+			}
+
+			for _, item := range output.Items {
+				d.StreamListItem(ctx, &VpcLatticeRuleInfo{
+					Rule:       item,
+					ServiceId:  *service.Id,
+					ListenerId: listenerId,
+				})
+
+				// Context may get cancelled due to manual cancellation or if the limit has been reached
+				if d.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getVpcLatticeRule(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	serviceId := d.EqualsQualString("service_id")
+	listenerId := d.EqualsQualString("listener_id")
+	ruleId := d.EqualsQualString("id")
+	if serviceId == "" || listenerId == "" || ruleId == "" {
+		return nil, nil
+	}
+
+	op, err := getVpcLatticeRuleById(ctx, d, serviceId, listenerId, ruleId)
+	if err != nil || op == nil {
+		return nil, err
+	}
+
+	return &VpcLatticeRuleInfo{
+		Rule: types.RuleSummary{
+			Arn:           op.Arn,
+			CreatedAt:     op.CreatedAt,
+			Id:            op.Id,
+			IsDefault:     op.IsDefault,
+			LastUpdatedAt: op.LastUpdatedAt,
+			Name:          op.Name,
+			Priority:      op.Priority,
+		},
+		ServiceId:  serviceId,
+		ListenerId: listenerId,
+	}, nil
+}
+
+func getVpcLatticeRuleDetails(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	rule := h.Item.(*VpcLatticeRuleInfo)
+	return getVpcLatticeRuleById(ctx, d, rule.ServiceId, rule.ListenerId, *rule.Rule.Id)
+}
+
+func getVpcLatticeRuleTags(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	rule := h.Item.(*VpcLatticeRuleInfo)
+	return getVpcLatticeResourceTags(ctx, d, *rule.Rule.Arn)
+}
+
+func getVpcLatticeRuleById(ctx context.Context, d *plugin.QueryData, serviceId string, listenerId string, ruleId string) (*vpclattice.GetRuleOutput, error) {
+	// Create session
+	svc, err := VPCLatticeClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_vpclattice_rule.getVpcLatticeRuleById", "client_error", err)
+		return nil, err
+	}
+
+	// Unsupported region check
+	if svc == nil {
+		return nil, nil
+	}
+
+	params := &vpclattice.GetRuleInput{
+		ServiceIdentifier:  aws.String(serviceId),
+		ListenerIdentifier: aws.String(listenerId),
+		RuleIdentifier:     aws.String(ruleId),
+	}
+
+	op, err := svc.GetRule(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_vpclattice_rule.getVpcLatticeRuleById", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
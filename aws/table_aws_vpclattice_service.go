@@ -0,0 +1,298 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/vpclattice"
+	"github.com/aws/aws-sdk-go-v2/service/vpclattice/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsVpcLatticeService(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_vpclattice_service",
+		Description: "AWS VPC Lattice Service",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException"}),
+			},
+			Hydrate: getVpcLatticeService,
+			Tags:    map[string]string{"service": "vpc-lattice", "action": "GetService"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listVpcLatticeServices,
+			Tags:    map[string]string{"service": "vpc-lattice", "action": "ListServices"},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getVpcLatticeService,
+				Tags: map[string]string{"service": "vpc-lattice", "action": "GetService"},
+			},
+			{
+				Func: getVpcLatticeServiceAuthPolicy,
+				Tags: map[string]string{"service": "vpc-lattice", "action": "GetAuthPolicy"},
+			},
+			{
+				Func: getVpcLatticeServiceResourcePolicy,
+				Tags: map[string]string{"service": "vpc-lattice", "action": "GetResourcePolicy"},
+			},
+			{
+				Func: getVpcLatticeServiceTags,
+				Tags: map[string]string{"service": "vpc-lattice", "action": "ListTagsForResource"},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(AWS_VPC_LATTICE_SERVICE_ID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the service.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the service.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the service.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "The status of the service.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "auth_type",
+				Description: "The type of IAM policy. NONE if no auth policy is required, AWS_IAM if the auth policy is used to control access.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getVpcLatticeService,
+			},
+			{
+				Name:        "custom_domain_name",
+				Description: "The custom domain name of the service.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "certificate_arn",
+				Description: "The Amazon Resource Name (ARN) of the certificate used for the custom domain name.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getVpcLatticeService,
+			},
+			{
+				Name:        "dns_entry",
+				Description: "The DNS name of the service.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "created_at",
+				Description: "The date and time that the service was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "last_updated_at",
+				Description: "The date and time of the last update.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "failure_code",
+				Description: "The failure code, if the service failed to be created or updated.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getVpcLatticeService,
+			},
+			{
+				Name:        "failure_message",
+				Description: "The failure message, if the service failed to be created or updated.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getVpcLatticeService,
+			},
+			{
+				Name:        "auth_policy",
+				Description: "The auth policy of the service.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getVpcLatticeServiceAuthPolicy,
+				Transform:   transform.FromField("Policy"),
+			},
+			{
+				Name:        "auth_policy_std",
+				Description: "Contains the auth policy in a canonical form for easier searching.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getVpcLatticeServiceAuthPolicy,
+				Transform:   transform.FromField("Policy").Transform(unescape).Transform(policyToCanonical),
+			},
+			{
+				Name:        "auth_policy_state",
+				Description: "The state of the auth policy. The auth policy is only active when the auth type is AWS_IAM.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getVpcLatticeServiceAuthPolicy,
+				Transform:   transform.FromField("State"),
+			},
+			{
+				Name:        "policy",
+				Description: "The resource policy of the service, used to share it with other accounts.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getVpcLatticeServiceResourcePolicy,
+				Transform:   transform.FromField("Policy"),
+			},
+			{
+				Name:        "policy_std",
+				Description: "Contains the resource policy in a canonical form for easier searching.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getVpcLatticeServiceResourcePolicy,
+				Transform:   transform.FromField("Policy").Transform(unescape).Transform(policyToCanonical),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getVpcLatticeServiceTags,
+				Transform:   transform.FromField("Tags"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listVpcLatticeServices(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+
+	// Create session
+	svc, err := VPCLatticeClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_vpclattice_service.listVpcLatticeServices", "client_error", err)
+		return nil, err
+	}
+
+	// Unsupported region check
+	if svc == nil {
+		return nil, nil
+	}
+
+	maxItems := int32(100)
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxItems {
+			if limit < 1 {
+				maxItems = int32(1)
+			} else {
+				maxItems = int32(limit)
+			}
+		}
+	}
+
+	input := &vpclattice.ListServicesInput{
+		MaxResults: aws.Int32(maxItems),
+	}
+
+	paginator := vpclattice.NewListServicesPaginator(svc, input, func(o *vpclattice.ListServicesPaginatorOptions) {
+		o.Limit = maxItems
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_vpclattice_service.listVpcLatticeServices", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range output.Items {
+			d.StreamListItem(ctx, item)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getVpcLatticeService(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var id string
+	if h.Item != nil {
+		id = vpcLatticeServiceArn(h.Item)
+	} else {
+		id = d.EqualsQualString("id")
+	}
+
+	if id == "" {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := VPCLatticeClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_vpclattice_service.getVpcLatticeService", "client_error", err)
+		return nil, err
+	}
+
+	// Unsupported region check
+	if svc == nil {
+		return nil, nil
+	}
+
+	params := &vpclattice.GetServiceInput{
+		ServiceIdentifier: aws.String(id),
+	}
+
+	op, err := svc.GetService(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_vpclattice_service.getVpcLatticeService", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
+
+func getVpcLatticeServiceAuthPolicy(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	return getVpcLatticeAuthPolicy(ctx, d, vpcLatticeServiceArn(h.Item))
+}
+
+func getVpcLatticeServiceResourcePolicy(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	return getVpcLatticeResourcePolicy(ctx, d, vpcLatticeServiceArn(h.Item))
+}
+
+func getVpcLatticeServiceTags(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	return getVpcLatticeResourceTags(ctx, d, vpcLatticeServiceArn(h.Item))
+}
+
+func vpcLatticeServiceArn(item interface{}) string {
+	switch item := item.(type) {
+	case types.ServiceSummary:
+		return aws.ToString(item.Arn)
+	case *vpclattice.GetServiceOutput:
+		return aws.ToString(item.Arn)
+	}
+	return ""
+}
@@ -0,0 +1,378 @@
+package aws
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/vpclattice"
+	"github.com/aws/aws-sdk-go-v2/service/vpclattice/types"
+	"github.com/aws/smithy-go"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsVpcLatticeServiceNetwork(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_vpclattice_service_network",
+		Description: "AWS VPC Lattice Service Network",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException"}),
+			},
+			Hydrate: getVpcLatticeServiceNetwork,
+			Tags:    map[string]string{"service": "vpc-lattice", "action": "GetServiceNetwork"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listVpcLatticeServiceNetworks,
+			Tags:    map[string]string{"service": "vpc-lattice", "action": "ListServiceNetworks"},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getVpcLatticeServiceNetwork,
+				Tags: map[string]string{"service": "vpc-lattice", "action": "GetServiceNetwork"},
+			},
+			{
+				Func: getVpcLatticeServiceNetworkAuthPolicy,
+				Tags: map[string]string{"service": "vpc-lattice", "action": "GetAuthPolicy"},
+			},
+			{
+				Func: getVpcLatticeServiceNetworkResourcePolicy,
+				Tags: map[string]string{"service": "vpc-lattice", "action": "GetResourcePolicy"},
+			},
+			{
+				Func: getVpcLatticeServiceNetworkTags,
+				Tags: map[string]string{"service": "vpc-lattice", "action": "ListTagsForResource"},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(AWS_VPC_LATTICE_SERVICE_ID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the service network.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the service network.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the service network.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "auth_type",
+				Description: "The type of IAM policy. NONE if no auth policy is required, AWS_IAM if the auth policy is used to control access.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getVpcLatticeServiceNetwork,
+			},
+			{
+				Name:        "created_at",
+				Description: "The date and time that the service network was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "last_updated_at",
+				Description: "The date and time of the last update.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "number_of_associated_services",
+				Description: "The number of services associated with the service network.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "number_of_associated_vpcs",
+				Description: "The number of VPCs associated with the service network.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("NumberOfAssociatedVPCs"),
+			},
+			{
+				Name:        "sharing_config",
+				Description: "Specifies if the service network is enabled for sharing.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getVpcLatticeServiceNetwork,
+			},
+			{
+				Name:        "auth_policy",
+				Description: "The auth policy of the service network.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getVpcLatticeServiceNetworkAuthPolicy,
+				Transform:   transform.FromField("Policy"),
+			},
+			{
+				Name:        "auth_policy_std",
+				Description: "Contains the auth policy in a canonical form for easier searching.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getVpcLatticeServiceNetworkAuthPolicy,
+				Transform:   transform.FromField("Policy").Transform(unescape).Transform(policyToCanonical),
+			},
+			{
+				Name:        "auth_policy_state",
+				Description: "The state of the auth policy. The auth policy is only active when the auth type is AWS_IAM.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getVpcLatticeServiceNetworkAuthPolicy,
+				Transform:   transform.FromField("State"),
+			},
+			{
+				Name:        "policy",
+				Description: "The resource policy of the service network, used to share it with other accounts.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getVpcLatticeServiceNetworkResourcePolicy,
+				Transform:   transform.FromField("Policy"),
+			},
+			{
+				Name:        "policy_std",
+				Description: "Contains the resource policy in a canonical form for easier searching.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getVpcLatticeServiceNetworkResourcePolicy,
+				Transform:   transform.FromField("Policy").Transform(unescape).Transform(policyToCanonical),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getVpcLatticeServiceNetworkTags,
+				Transform:   transform.FromField("Tags"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listVpcLatticeServiceNetworks(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+
+	// Create session
+	svc, err := VPCLatticeClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_vpclattice_service_network.listVpcLatticeServiceNetworks", "client_error", err)
+		return nil, err
+	}
+
+	// Unsupported region check
+	if svc == nil {
+		return nil, nil
+	}
+
+	maxItems := int32(100)
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxItems {
+			if limit < 1 {
+				maxItems = int32(1)
+			} else {
+				maxItems = int32(limit)
+			}
+		}
+	}
+
+	input := &vpclattice.ListServiceNetworksInput{
+		MaxResults: aws.Int32(maxItems),
+	}
+
+	paginator := vpclattice.NewListServiceNetworksPaginator(svc, input, func(o *vpclattice.ListServiceNetworksPaginatorOptions) {
+		o.Limit = maxItems
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_vpclattice_service_network.listVpcLatticeServiceNetworks", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range output.Items {
+			d.StreamListItem(ctx, item)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getVpcLatticeServiceNetwork(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var id string
+	if h.Item != nil {
+		id = vpcLatticeServiceNetworkArn(h.Item)
+	} else {
+		id = d.EqualsQualString("id")
+	}
+
+	if id == "" {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := VPCLatticeClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_vpclattice_service_network.getVpcLatticeServiceNetwork", "client_error", err)
+		return nil, err
+	}
+
+	// Unsupported region check
+	if svc == nil {
+		return nil, nil
+	}
+
+	params := &vpclattice.GetServiceNetworkInput{
+		ServiceNetworkIdentifier: aws.String(id),
+	}
+
+	op, err := svc.GetServiceNetwork(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_vpclattice_service_network.getVpcLatticeServiceNetwork", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
+
+func getVpcLatticeServiceNetworkAuthPolicy(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	return getVpcLatticeAuthPolicy(ctx, d, vpcLatticeServiceNetworkArn(h.Item))
+}
+
+func getVpcLatticeServiceNetworkResourcePolicy(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	return getVpcLatticeResourcePolicy(ctx, d, vpcLatticeServiceNetworkArn(h.Item))
+}
+
+func getVpcLatticeServiceNetworkTags(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	return getVpcLatticeResourceTags(ctx, d, vpcLatticeServiceNetworkArn(h.Item))
+}
+
+// The ARN is used as the identifier, since it also works for service networks
+// shared from other accounts
+func vpcLatticeServiceNetworkArn(item interface{}) string {
+	switch item := item.(type) {
+	case types.ServiceNetworkSummary:
+		return aws.ToString(item.Arn)
+	case *vpclattice.GetServiceNetworkOutput:
+		return aws.ToString(item.Arn)
+	}
+	return ""
+}
+
+//// UTILITY FUNCTIONS
+
+func getVpcLatticeAuthPolicy(ctx context.Context, d *plugin.QueryData, resourceArn string) (interface{}, error) {
+	// Create session
+	svc, err := VPCLatticeClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("getVpcLatticeAuthPolicy", "client_error", err)
+		return nil, err
+	}
+
+	// Unsupported region check
+	if svc == nil {
+		return nil, nil
+	}
+
+	params := &vpclattice.GetAuthPolicyInput{
+		ResourceIdentifier: aws.String(resourceArn),
+	}
+
+	op, err := svc.GetAuthPolicy(ctx, params)
+	if err != nil {
+		var ae smithy.APIError
+		if errors.As(err, &ae) {
+			if ae.ErrorCode() == "ResourceNotFoundException" {
+				return nil, nil
+			}
+		}
+		plugin.Logger(ctx).Error("getVpcLatticeAuthPolicy", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
+
+func getVpcLatticeResourcePolicy(ctx context.Context, d *plugin.QueryData, resourceArn string) (interface{}, error) {
+	// Create session
+	svc, err := VPCLatticeClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("getVpcLatticeResourcePolicy", "client_error", err)
+		return nil, err
+	}
+
+	// Unsupported region check
+	if svc == nil {
+		return nil, nil
+	}
+
+	params := &vpclattice.GetResourcePolicyInput{
+		ResourceArn: aws.String(resourceArn),
+	}
+
+	op, err := svc.GetResourcePolicy(ctx, params)
+	if err != nil {
+		var ae smithy.APIError
+		if errors.As(err, &ae) {
+			if ae.ErrorCode() == "ResourceNotFoundException" {
+				return nil, nil
+			}
+		}
+		plugin.Logger(ctx).Error("getVpcLatticeResourcePolicy", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
+
+func getVpcLatticeResourceTags(ctx context.Context, d *plugin.QueryData, resourceArn string) (interface{}, error) {
+	// Create session
+	svc, err := VPCLatticeClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("getVpcLatticeResourceTags", "client_error", err)
+		return nil, err
+	}
+
+	// Unsupported region check
+	if svc == nil {
+		return nil, nil
+	}
+
+	params := &vpclattice.ListTagsForResourceInput{
+		ResourceArn: aws.String(resourceArn),
+	}
+
+	op, err := svc.ListTagsForResource(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("getVpcLatticeResourceTags", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
@@ -0,0 +1,275 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/vpclattice"
+	"github.com/aws/aws-sdk-go-v2/service/vpclattice/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsVpcLatticeServiceNetworkServiceAssociation(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_vpclattice_service_network_service_association",
+		Description: "AWS VPC Lattice Service Network Service Association",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException"}),
+			},
+			Hydrate: getVpcLatticeServiceNetworkServiceAssociation,
+			Tags:    map[string]string{"service": "vpc-lattice", "action": "GetServiceNetworkServiceAssociation"},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listVpcLatticeServiceNetworks,
+			Hydrate:       listVpcLatticeServiceNetworkServiceAssociations,
+			Tags:          map[string]string{"service": "vpc-lattice", "action": "ListServiceNetworkServiceAssociations"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "service_network_id", Require: plugin.Optional},
+				{Name: "service_id", Require: plugin.Optional},
+			},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getVpcLatticeServiceNetworkServiceAssociation,
+				Tags: map[string]string{"service": "vpc-lattice", "action": "GetServiceNetworkServiceAssociation"},
+			},
+			{
+				Func: getVpcLatticeServiceNetworkServiceAssociationTags,
+				Tags: map[string]string{"service": "vpc-lattice", "action": "ListTagsForResource"},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(AWS_VPC_LATTICE_SERVICE_ID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The ID of the association.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the association.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "The status of the association.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "service_id",
+				Description: "The ID of the service.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "service_arn",
+				Description: "The Amazon Resource Name (ARN) of the service.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "service_name",
+				Description: "The name of the service.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "service_network_id",
+				Description: "The ID of the service network.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "service_network_arn",
+				Description: "The Amazon Resource Name (ARN) of the service network.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "service_network_name",
+				Description: "The name of the service network.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "created_at",
+				Description: "The date and time that the association was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "created_by",
+				Description: "The account that created the association.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "custom_domain_name",
+				Description: "The custom domain name of the service.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "failure_code",
+				Description: "The failure code.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getVpcLatticeServiceNetworkServiceAssociation,
+			},
+			{
+				Name:        "failure_message",
+				Description: "The failure message.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getVpcLatticeServiceNetworkServiceAssociation,
+			},
+			{
+				Name:        "dns_entry",
+				Description: "The DNS name of the service.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Id"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getVpcLatticeServiceNetworkServiceAssociationTags,
+				Transform:   transform.FromField("Tags"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listVpcLatticeServiceNetworkServiceAssociations(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	serviceNetwork := h.Item.(types.ServiceNetworkSummary)
+
+	// Minimize the API calls if the service network ID is specified in the query
+	if d.EqualsQualString("service_network_id") != "" && d.EqualsQualString("service_network_id") != *serviceNetwork.Id {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := VPCLatticeClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_vpclattice_service_network_service_association.listVpcLatticeServiceNetworkServiceAssociations", "client_error", err)
+		return nil, err
+	}
+
+	// Unsupported region check
+	if svc == nil {
+		return nil, nil
+	}
+
+	maxItems := int32(100)
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxItems {
+			if limit < 1 {
+				maxItems = int32(1)
+			} else {
+				maxItems = int32(limit)
+			}
+		}
+	}
+
+	input := &vpclattice.ListServiceNetworkServiceAssociationsInput{
+		ServiceNetworkIdentifier: serviceNetwork.Arn,
+		MaxResults:               aws.Int32(maxItems),
+	}
+	if d.EqualsQualString("service_id") != "" {
+		input.ServiceIdentifier = aws.String(d.EqualsQualString("service_id"))
+	}
+
+	paginator := vpclattice.NewListServiceNetworkServiceAssociationsPaginator(svc, input, func(o *vpclattice.ListServiceNetworkServiceAssociationsPaginatorOptions) {
+		o.Limit = maxItems
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_vpclattice_service_network_service_association.listVpcLatticeServiceNetworkServiceAssociations", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range output.Items {
+			d.StreamListItem(ctx, item)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getVpcLatticeServiceNetworkServiceAssociation(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var id string
+	if h.Item != nil {
+		id = vpcLatticeServiceNetworkServiceAssociationArn(h.Item)
+	} else {
+		id = d.EqualsQualString("id")
+	}
+
+	if id == "" {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := VPCLatticeClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_vpclattice_service_network_service_association.getVpcLatticeServiceNetworkServiceAssociation", "client_error", err)
+		return nil, err
+	}
+
+	// Unsupported region check
+	if svc == nil {
+		return nil, nil
+	}
+
+	params := &vpclattice.GetServiceNetworkServiceAssociationInput{
+		ServiceNetworkServiceAssociationIdentifier: aws.String(id),
+	}
+
+	op, err := svc.GetServiceNetworkServiceAssociation(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_vpclattice_service_network_service_association.getVpcLatticeServiceNetworkServiceAssociation", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
+
+func getVpcLatticeServiceNetworkServiceAssociationTags(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	return getVpcLatticeResourceTags(ctx, d, vpcLatticeServiceNetworkServiceAssociationArn(h.Item))
+}
+
+func vpcLatticeServiceNetworkServiceAssociationArn(item interface{}) string {
+	switch item := item.(type) {
+	case types.ServiceNetworkServiceAssociationSummary:
+		return aws.ToString(item.Arn)
+	case *vpclattice.GetServiceNetworkServiceAssociationOutput:
+		return aws.ToString(item.Arn)
+	}
+	return ""
+}
@@ -0,0 +1,276 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/vpclattice"
+	"github.com/aws/aws-sdk-go-v2/service/vpclattice/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsVpcLatticeServiceNetworkVpcAssociation(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_vpclattice_service_network_vpc_association",
+		Description: "AWS VPC Lattice Service Network VPC Association",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException"}),
+			},
+			Hydrate: getVpcLatticeServiceNetworkVpcAssociation,
+			Tags:    map[string]string{"service": "vpc-lattice", "action": "GetServiceNetworkVpcAssociation"},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listVpcLatticeServiceNetworks,
+			Hydrate:       listVpcLatticeServiceNetworkVpcAssociations,
+			Tags:          map[string]string{"service": "vpc-lattice", "action": "ListServiceNetworkVpcAssociations"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "service_network_id", Require: plugin.Optional},
+				{Name: "vpc_id", Require: plugin.Optional},
+			},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getVpcLatticeServiceNetworkVpcAssociation,
+				Tags: map[string]string{"service": "vpc-lattice", "action": "GetServiceNetworkVpcAssociation"},
+			},
+			{
+				Func: getVpcLatticeServiceNetworkVpcAssociationTags,
+				Tags: map[string]string{"service": "vpc-lattice", "action": "ListTagsForResource"},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(AWS_VPC_LATTICE_SERVICE_ID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The ID of the association.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the association.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "The status of the association.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "vpc_id",
+				Description: "The ID of the VPC.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "service_network_id",
+				Description: "The ID of the service network.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "service_network_arn",
+				Description: "The Amazon Resource Name (ARN) of the service network.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "service_network_name",
+				Description: "The name of the service network.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "created_at",
+				Description: "The date and time that the association was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "created_by",
+				Description: "The account that created the association.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "last_updated_at",
+				Description: "The date and time that the association was last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "private_dns_enabled",
+				Description: "Indicates if private DNS is enabled for the VPC association.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "failure_code",
+				Description: "The failure code.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getVpcLatticeServiceNetworkVpcAssociation,
+			},
+			{
+				Name:        "failure_message",
+				Description: "The failure message.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getVpcLatticeServiceNetworkVpcAssociation,
+			},
+			{
+				Name:        "dns_options",
+				Description: "The DNS options for the association.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "security_group_ids",
+				Description: "The IDs of the security groups applied to the association.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getVpcLatticeServiceNetworkVpcAssociation,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Id"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getVpcLatticeServiceNetworkVpcAssociationTags,
+				Transform:   transform.FromField("Tags"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listVpcLatticeServiceNetworkVpcAssociations(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	serviceNetwork := h.Item.(types.ServiceNetworkSummary)
+
+	// Minimize the API calls if the service network ID is specified in the query
+	if d.EqualsQualString("service_network_id") != "" && d.EqualsQualString("service_network_id") != *serviceNetwork.Id {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := VPCLatticeClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_vpclattice_service_network_vpc_association.listVpcLatticeServiceNetworkVpcAssociations", "client_error", err)
+		return nil, err
+	}
+
+	// Unsupported region check
+	if svc == nil {
+		return nil, nil
+	}
+
+	maxItems := int32(100)
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxItems {
+			if limit < 1 {
+				maxItems = int32(1)
+			} else {
+				maxItems = int32(limit)
+			}
+		}
+	}
+
+	input := &vpclattice.ListServiceNetworkVpcAssociationsInput{
+		ServiceNetworkIdentifier: serviceNetwork.Arn,
+		MaxResults:               aws.Int32(maxItems),
+	}
+	if d.EqualsQualString("vpc_id") != "" {
+		input.VpcIdentifier = aws.String(d.EqualsQualString("vpc_id"))
+	}
+
+	paginator := vpclattice.NewListServiceNetworkVpcAssociationsPaginator(svc, input, func(o *vpclattice.ListServiceNetworkVpcAssociationsPaginatorOptions) {
+		o.Limit = maxItems
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_vpclattice_service_network_vpc_association.listVpcLatticeServiceNetworkVpcAssociations", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range output.Items {
+			d.StreamListItem(ctx, item)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getVpcLatticeServiceNetworkVpcAssociation(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var id string
+	if h.Item != nil {
+		id = vpcLatticeServiceNetworkVpcAssociationArn(h.Item)
+	} else {
+		id = d.EqualsQualString("id")
+	}
+
+	if id == "" {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := VPCLatticeClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_vpclattice_service_network_vpc_association.getVpcLatticeServiceNetworkVpcAssociation", "client_error", err)
+		return nil, err
+	}
+
+	// Unsupported region check
+	if svc == nil {
+		return nil, nil
+	}
+
+	params := &vpclattice.GetServiceNetworkVpcAssociationInput{
+		ServiceNetworkVpcAssociationIdentifier: aws.String(id),
+	}
+
+	op, err := svc.GetServiceNetworkVpcAssociation(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_vpclattice_service_network_vpc_association.getVpcLatticeServiceNetworkVpcAssociation", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
+
+func getVpcLatticeServiceNetworkVpcAssociationTags(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	return getVpcLatticeResourceTags(ctx, d, vpcLatticeServiceNetworkVpcAssociationArn(h.Item))
+}
+
+func vpcLatticeServiceNetworkVpcAssociationArn(item interface{}) string {
+	switch item := item.(type) {
+	case types.ServiceNetworkVpcAssociationSummary:
+		return aws.ToString(item.Arn)
+	case *vpclattice.GetServiceNetworkVpcAssociationOutput:
+		return aws.ToString(item.Arn)
+	}
+	return ""
+}
@@ -0,0 +1,154 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/vpclattice"
+	"github.com/aws/aws-sdk-go-v2/service/vpclattice/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsVpcLatticeTarget(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_vpclattice_target",
+		Description: "AWS VPC Lattice Target",
+		List: &plugin.ListConfig{
+			ParentHydrate: listVpcLatticeTargetGroups,
+			Hydrate:       listVpcLatticeTargets,
+			Tags:          map[string]string{"service": "vpc-lattice", "action": "ListTargets"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "target_group_id", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(AWS_VPC_LATTICE_SERVICE_ID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The ID of the target. If the target group type is INSTANCE, this is an instance ID. If it is IP, this is an IP address. If it is LAMBDA, this is the ARN of a Lambda function. If it is ALB, this is the ARN of an Application Load Balancer.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Target.Id"),
+			},
+			{
+				Name:        "target_group_id",
+				Description: "The ID of the target group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "target_group_arn",
+				Description: "The Amazon Resource Name (ARN) of the target group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "port",
+				Description: "The port on which the target is listening.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Target.Port"),
+			},
+			{
+				Name:        "status",
+				Description: "The status of the target. Possible values are DRAINING, UNAVAILABLE, HEALTHY, UNHEALTHY, INITIAL and UNUSED.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Target.Status"),
+			},
+			{
+				Name:        "reason_code",
+				Description: "The code for why the target status is what it is.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Target.ReasonCode"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Target.Id"),
+			},
+		}),
+	}
+}
+
+type VpcLatticeTargetInfo struct {
+	Target         types.TargetSummary
+	TargetGroupId  string
+	TargetGroupArn string
+}
+
+//// LIST FUNCTION
+
+func listVpcLatticeTargets(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	targetGroup := h.Item.(types.TargetGroupSummary)
+
+	// Minimize the API calls if the target group ID is specified in the query
+	if d.EqualsQualString("target_group_id") != "" && d.EqualsQualString("target_group_id") != *targetGroup.Id {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := VPCLatticeClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_vpclattice_target.listVpcLatticeTargets", "client_error", err)
+		return nil, err
+	}
+
+	// Unsupported region check
+	if svc == nil {
+		return nil, nil
+	}
+
+	maxItems := int32(100)
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxItems {
+			if limit < 1 {
+				maxItems = int32(1)
+			} else {
+				maxItems = int32(limit)
+			}
+		}
+	}
+
+	input := &vpclattice.ListTargetsInput{
+		TargetGroupIdentifier: targetGroup.Id,
+		MaxResults:            aws.Int32(maxItems),
+	}
+
+	paginator := vpclattice.NewListTargetsPaginator(svc, input, func(o *vpclattice.ListTargetsPaginatorOptions) {
+		o.Limit = maxItems
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_vpclattice_target.listVpcLatticeTargets", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range output.Items {
+			d.StreamListItem(ctx, &VpcLatticeTargetInfo{
+				Target:         item,
+				TargetGroupId:  *targetGroup.Id,
+				TargetGroupArn: aws.ToString(targetGroup.Arn),
+			})
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,294 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/vpclattice"
+	"github.com/aws/aws-sdk-go-v2/service/vpclattice/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsVpcLatticeTargetGroup(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_vpclattice_target_group",
+		Description: "AWS VPC Lattice Target Group",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException"}),
+			},
+			Hydrate: getVpcLatticeTargetGroup,
+			Tags:    map[string]string{"service": "vpc-lattice", "action": "GetTargetGroup"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listVpcLatticeTargetGroups,
+			Tags:    map[string]string{"service": "vpc-lattice", "action": "ListTargetGroups"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "type", Require: plugin.Optional},
+				{Name: "vpc_identifier", Require: plugin.Optional},
+			},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getVpcLatticeTargetGroupDetails,
+				Tags: map[string]string{"service": "vpc-lattice", "action": "GetTargetGroup"},
+			},
+			{
+				Func: getVpcLatticeTargetGroupTags,
+				Tags: map[string]string{"service": "vpc-lattice", "action": "ListTagsForResource"},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(AWS_VPC_LATTICE_SERVICE_ID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the target group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the target group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the target group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type",
+				Description: "The target group type.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "The status of the target group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "port",
+				Description: "The port of the target group.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "protocol",
+				Description: "The protocol of the target group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "ip_address_type",
+				Description: "The type of IP address used for the target group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "lambda_event_structure_version",
+				Description: "The version of the event structure that the Lambda function receives. Supported only if the target group type is LAMBDA.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "vpc_identifier",
+				Description: "The ID of the VPC of the target group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "created_at",
+				Description: "The date and time that the target group was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "last_updated_at",
+				Description: "The date and time that the target group was last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "failure_code",
+				Description: "The failure code.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getVpcLatticeTargetGroupDetails,
+			},
+			{
+				Name:        "failure_message",
+				Description: "The failure message.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getVpcLatticeTargetGroupDetails,
+			},
+			{
+				Name:        "service_arns",
+				Description: "The Amazon Resource Names (ARNs) of the services that use the target group.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "config",
+				Description: "The target group configuration, including the health check settings.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getVpcLatticeTargetGroupDetails,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getVpcLatticeTargetGroupTags,
+				Transform:   transform.FromField("Tags"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listVpcLatticeTargetGroups(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+
+	// Create session
+	svc, err := VPCLatticeClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_vpclattice_target_group.listVpcLatticeTargetGroups", "client_error", err)
+		return nil, err
+	}
+
+	// Unsupported region check
+	if svc == nil {
+		return nil, nil
+	}
+
+	maxItems := int32(100)
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxItems {
+			if limit < 1 {
+				maxItems = int32(1)
+			} else {
+				maxItems = int32(limit)
+			}
+		}
+	}
+
+	input := &vpclattice.ListTargetGroupsInput{
+		MaxResults: aws.Int32(maxItems),
+	}
+	if d.EqualsQualString("type") != "" {
+		input.TargetGroupType = types.TargetGroupType(d.EqualsQualString("type"))
+	}
+	if d.EqualsQualString("vpc_identifier") != "" {
+		input.VpcIdentifier = aws.String(d.EqualsQualString("vpc_identifier"))
+	}
+
+	paginator := vpclattice.NewListTargetGroupsPaginator(svc, input, func(o *vpclattice.ListTargetGroupsPaginatorOptions) {
+		o.Limit = maxItems
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_vpclattice_target_group.listVpcLatticeTargetGroups", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range output.Items {
+			d.StreamListItem(ctx, item)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getVpcLatticeTargetGroup(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	id := d.EqualsQualString("id")
+	if id == "" {
+		return nil, nil
+	}
+
+	op, err := getVpcLatticeTargetGroupById(ctx, d, id)
+	if err != nil || op == nil {
+		return nil, err
+	}
+
+	// Return the same shape as the list call so the summary columns resolve in both cases
+	item := types.TargetGroupSummary{
+		Arn:           op.Arn,
+		CreatedAt:     op.CreatedAt,
+		Id:            op.Id,
+		LastUpdatedAt: op.LastUpdatedAt,
+		Name:          op.Name,
+		ServiceArns:   op.ServiceArns,
+		Status:        op.Status,
+		Type:          op.Type,
+	}
+	if op.Config != nil {
+		item.IpAddressType = op.Config.IpAddressType
+		item.LambdaEventStructureVersion = op.Config.LambdaEventStructureVersion
+		item.Port = op.Config.Port
+		item.Protocol = op.Config.Protocol
+		item.VpcIdentifier = op.Config.VpcIdentifier
+	}
+
+	return item, nil
+}
+
+func getVpcLatticeTargetGroupDetails(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	targetGroup := h.Item.(types.TargetGroupSummary)
+	return getVpcLatticeTargetGroupById(ctx, d, *targetGroup.Id)
+}
+
+func getVpcLatticeTargetGroupTags(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	targetGroup := h.Item.(types.TargetGroupSummary)
+	return getVpcLatticeResourceTags(ctx, d, *targetGroup.Arn)
+}
+
+func getVpcLatticeTargetGroupById(ctx context.Context, d *plugin.QueryData, id string) (*vpclattice.GetTargetGroupOutput, error) {
+	// Create session
+	svc, err := VPCLatticeClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_vpclattice_target_group.getVpcLatticeTargetGroupById", "client_error", err)
+		return nil, err
+	}
+
+	// Unsupported region check
+	if svc == nil {
+		return nil, nil
+	}
+
+	params := &vpclattice.GetTargetGroupInput{
+		TargetGroupIdentifier: aws.String(id),
+	}
+
+	op, err := svc.GetTargetGroup(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_vpclattice_target_group.getVpcLatticeTargetGroupById", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
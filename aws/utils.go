@@ -15,6 +15,8 @@ import (
 	"unicode"
 	"unicode/utf8"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
+	ec2Types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	sagemakerTypes "github.com/aws/aws-sdk-go-v2/service/sagemaker/types"
 	"github.com/turbot/go-kit/types"
 	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
@@ -163,6 +165,45 @@ func sageMakerTurbotTags(_ context.Context, d *transform.TransformData) (interfa
 	return nil, nil
 }
 
+// Transform function for EC2 resources tags
+func ec2TurbotTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	tags, ok := d.Value.([]ec2Types.Tag)
+	if !ok || tags == nil {
+		return nil, nil
+	}
+
+	turbotTagsMap := map[string]string{}
+	for _, i := range tags {
+		turbotTagsMap[aws.ToString(i.Key)] = aws.ToString(i.Value)
+	}
+	return turbotTagsMap, nil
+}
+
+// Returns the value of the Name tag of an EC2 resource, or defaultValue if
+// the resource has no Name tag
+func ec2TagNameOrDefault(tags []ec2Types.Tag, defaultValue string) string {
+	for _, i := range tags {
+		if aws.ToString(i.Key) == "Name" && i.Value != nil {
+			return aws.ToString(i.Value)
+		}
+	}
+	return defaultValue
+}
+
+// Build the akas for an EC2 resource in the query region, e.g.
+// arn:aws:ec2:us-east-1:123456789012:transit-gateway-attachment/tgw-attach-1234
+func ec2ResourceAkas(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData, resource string) ([]string, error) {
+	region := d.EqualsQualString(matrixKeyRegion)
+
+	commonData, err := getCommonColumns(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	commonColumnData := commonData.(*awsCommonColumnData)
+
+	return []string{"arn:" + commonColumnData.Partition + ":ec2:" + region + ":" + commonColumnData.AccountId + ":" + resource}, nil
+}
+
 func getQualsValueByColumn(equalQuals plugin.KeyColumnQualMap, columnName string, dataType string) interface{} {
 	var value interface{}
 	for _, q := range equalQuals[columnName].Quals {
@@ -0,0 +1,222 @@
+package aws
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+// X509Certificate is the decoded view of an X.509 certificate, shared by the
+// tables that return PEM encoded certificates
+type X509Certificate struct {
+	Subject                string
+	Issuer                 string
+	SerialNumber           string
+	NotBefore              time.Time
+	NotAfter               time.Time
+	Version                int
+	SignatureAlgorithm     string
+	PublicKeyAlgorithm     string
+	KeySize                int
+	IsCA                   bool
+	MaxPathLen             *int
+	DNSNames               []string
+	IPAddresses            []string
+	EmailAddresses         []string
+	URIs                   []string
+	KeyUsage               []string
+	ExtendedKeyUsage       []string
+	SubjectKeyId           string
+	AuthorityKeyId         string
+	CRLDistributionPoints  []string
+	OCSPServers            []string
+	IssuingCertificateURLs []string
+	PolicyIdentifiers      []string
+	FingerprintSha1        string
+	FingerprintSha256      string
+}
+
+var x509KeyUsageNames = []struct {
+	usage x509.KeyUsage
+	name  string
+}{
+	{x509.KeyUsageDigitalSignature, "digitalSignature"},
+	{x509.KeyUsageContentCommitment, "contentCommitment"},
+	{x509.KeyUsageKeyEncipherment, "keyEncipherment"},
+	{x509.KeyUsageDataEncipherment, "dataEncipherment"},
+	{x509.KeyUsageKeyAgreement, "keyAgreement"},
+	{x509.KeyUsageCertSign, "keyCertSign"},
+	{x509.KeyUsageCRLSign, "cRLSign"},
+	{x509.KeyUsageEncipherOnly, "encipherOnly"},
+	{x509.KeyUsageDecipherOnly, "decipherOnly"},
+}
+
+var x509ExtKeyUsageNames = map[x509.ExtKeyUsage]string{
+	x509.ExtKeyUsageAny:                            "any",
+	x509.ExtKeyUsageServerAuth:                     "serverAuth",
+	x509.ExtKeyUsageClientAuth:                     "clientAuth",
+	x509.ExtKeyUsageCodeSigning:                    "codeSigning",
+	x509.ExtKeyUsageEmailProtection:                "emailProtection",
+	x509.ExtKeyUsageIPSECEndSystem:                 "ipsecEndSystem",
+	x509.ExtKeyUsageIPSECTunnel:                    "ipsecTunnel",
+	x509.ExtKeyUsageIPSECUser:                      "ipsecUser",
+	x509.ExtKeyUsageTimeStamping:                   "timeStamping",
+	x509.ExtKeyUsageOCSPSigning:                    "OCSPSigning",
+	x509.ExtKeyUsageMicrosoftServerGatedCrypto:     "msSGC",
+	x509.ExtKeyUsageNetscapeServerGatedCrypto:      "nsSGC",
+	x509.ExtKeyUsageMicrosoftCommercialCodeSigning: "msCodeCom",
+	x509.ExtKeyUsageMicrosoftKernelCodeSigning:     "msKernelCode",
+}
+
+// parseX509CertificatesPem decodes every certificate of a PEM bundle, in the
+// order they appear. Blocks that are not certificates are skipped.
+func parseX509CertificatesPem(data string) ([]*X509Certificate, error) {
+	var certificates []*X509Certificate
+	rest := []byte(data)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		certificates = append(certificates, decodeX509Certificate(cert))
+	}
+
+	if len(certificates) == 0 && strings.TrimSpace(data) != "" {
+		return nil, errors.New("no PEM encoded certificate found")
+	}
+	return certificates, nil
+}
+
+func decodeX509Certificate(cert *x509.Certificate) *X509Certificate {
+	sha1Sum := sha1.Sum(cert.Raw)
+	sha256Sum := sha256.Sum256(cert.Raw)
+
+	decoded := &X509Certificate{
+		Subject:                cert.Subject.String(),
+		Issuer:                 cert.Issuer.String(),
+		SerialNumber:           x509HexString(cert.SerialNumber.Bytes()),
+		NotBefore:              cert.NotBefore,
+		NotAfter:               cert.NotAfter,
+		Version:                cert.Version,
+		SignatureAlgorithm:     cert.SignatureAlgorithm.String(),
+		PublicKeyAlgorithm:     cert.PublicKeyAlgorithm.String(),
+		IsCA:                   cert.IsCA,
+		DNSNames:               cert.DNSNames,
+		EmailAddresses:         cert.EmailAddresses,
+		SubjectKeyId:           x509HexString(cert.SubjectKeyId),
+		AuthorityKeyId:         x509HexString(cert.AuthorityKeyId),
+		CRLDistributionPoints:  cert.CRLDistributionPoints,
+		OCSPServers:            cert.OCSPServer,
+		IssuingCertificateURLs: cert.IssuingCertificateURL,
+		FingerprintSha1:        x509HexString(sha1Sum[:]),
+		FingerprintSha256:      x509HexString(sha256Sum[:]),
+	}
+
+	switch key := cert.PublicKey.(type) {
+	case *rsa.PublicKey:
+		decoded.KeySize = key.N.BitLen()
+	case *ecdsa.PublicKey:
+		decoded.KeySize = key.Curve.Params().BitSize
+	case ed25519.PublicKey:
+		decoded.KeySize = 256
+	}
+
+	// A MaxPathLen of -1 or 0 without MaxPathLenZero means it is unset
+	if cert.IsCA && (cert.MaxPathLen > 0 || cert.MaxPathLenZero) {
+		maxPathLen := cert.MaxPathLen
+		decoded.MaxPathLen = &maxPathLen
+	}
+
+	for _, ip := range cert.IPAddresses {
+		decoded.IPAddresses = append(decoded.IPAddresses, ip.String())
+	}
+	for _, uri := range cert.URIs {
+		decoded.URIs = append(decoded.URIs, uri.String())
+	}
+	for _, u := range x509KeyUsageNames {
+		if cert.KeyUsage&u.usage != 0 {
+			decoded.KeyUsage = append(decoded.KeyUsage, u.name)
+		}
+	}
+	for _, u := range cert.ExtKeyUsage {
+		if name, ok := x509ExtKeyUsageNames[u]; ok {
+			decoded.ExtendedKeyUsage = append(decoded.ExtendedKeyUsage, name)
+		}
+	}
+	for _, oid := range cert.UnknownExtKeyUsage {
+		decoded.ExtendedKeyUsage = append(decoded.ExtendedKeyUsage, oid.String())
+	}
+	for _, oid := range cert.PolicyIdentifiers {
+		decoded.PolicyIdentifiers = append(decoded.PolicyIdentifiers, oid.String())
+	}
+
+	return decoded
+}
+
+// x509HexString formats bytes as colon separated hex, the way ACM formats
+// certificate serial numbers, e.g. 0a:1b:2c
+func x509HexString(b []byte) string {
+	if len(b) == 0 {
+		return ""
+	}
+	parts := make([]string, len(b))
+	for i, v := range b {
+		parts[i] = hex.EncodeToString([]byte{v})
+	}
+	return strings.Join(parts, ":")
+}
+
+//// TRANSFORM FUNCTIONS
+
+// decodeX509CertificatePem decodes the first certificate of a PEM value
+func decodeX509CertificatePem(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	certificates, err := x509CertificatesFromTransformValue(d.Value)
+	if err != nil || len(certificates) == 0 {
+		return nil, err
+	}
+	return certificates[0], nil
+}
+
+// decodeX509CertificateChainPem decodes all the certificates of a PEM chain
+func decodeX509CertificateChainPem(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	certificates, err := x509CertificatesFromTransformValue(d.Value)
+	if err != nil || len(certificates) == 0 {
+		return nil, err
+	}
+	return certificates, nil
+}
+
+func x509CertificatesFromTransformValue(value interface{}) ([]*X509Certificate, error) {
+	var data string
+	switch v := value.(type) {
+	case string:
+		data = v
+	case *string:
+		if v == nil {
+			return nil, nil
+		}
+		data = *v
+	default:
+		return nil, nil
+	}
+	return parseX509CertificatesPem(data)
+}
@@ -0,0 +1,130 @@
+package aws
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestParseX509CertificatesPem(t *testing.T) {
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	leafKey, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	notBefore := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Example Root CA", Organization: []string{"Example"}},
+		NotBefore:             notBefore,
+		NotAfter:              notBefore.AddDate(10, 0, 0),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		MaxPathLen:            1,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+		SubjectKeyId:          []byte{1, 2, 3, 4},
+	}
+	caDer, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ca, err := x509.ParseCertificate(caDer)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	leafTemplate := &x509.Certificate{
+		SerialNumber: big.NewInt(0x0a1b2c),
+		Subject:      pkix.Name{CommonName: "www.example.com"},
+		NotBefore:    notBefore,
+		NotAfter:     notBefore.AddDate(1, 0, 0),
+		DNSNames:     []string{"www.example.com", "example.com"},
+		IPAddresses:  []net.IP{net.ParseIP("10.0.0.1")},
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	leafDer, err := x509.CreateCertificate(rand.Reader, leafTemplate, ca, &leafKey.PublicKey, caKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bundle := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: leafDer})) +
+		string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: []byte("ignored")})) +
+		string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caDer}))
+
+	certificates, err := parseX509CertificatesPem(bundle)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(certificates) != 2 {
+		t.Fatalf("expected 2 certificates, got %d", len(certificates))
+	}
+
+	leaf := certificates[0]
+	if leaf.Subject != "CN=www.example.com" {
+		t.Errorf("unexpected subject %q", leaf.Subject)
+	}
+	if leaf.Issuer != "CN=Example Root CA,O=Example" {
+		t.Errorf("unexpected issuer %q", leaf.Issuer)
+	}
+	if leaf.SerialNumber != "0a:1b:2c" {
+		t.Errorf("unexpected serial number %q", leaf.SerialNumber)
+	}
+	if !leaf.NotBefore.Equal(notBefore) || !leaf.NotAfter.Equal(notBefore.AddDate(1, 0, 0)) {
+		t.Errorf("unexpected validity %v - %v", leaf.NotBefore, leaf.NotAfter)
+	}
+	if !reflect.DeepEqual(leaf.DNSNames, []string{"www.example.com", "example.com"}) {
+		t.Errorf("unexpected DNS names %v", leaf.DNSNames)
+	}
+	if !reflect.DeepEqual(leaf.IPAddresses, []string{"10.0.0.1"}) {
+		t.Errorf("unexpected IP addresses %v", leaf.IPAddresses)
+	}
+	if !reflect.DeepEqual(leaf.KeyUsage, []string{"digitalSignature", "keyEncipherment"}) {
+		t.Errorf("unexpected key usage %v", leaf.KeyUsage)
+	}
+	if !reflect.DeepEqual(leaf.ExtendedKeyUsage, []string{"serverAuth", "clientAuth"}) {
+		t.Errorf("unexpected extended key usage %v", leaf.ExtendedKeyUsage)
+	}
+	if leaf.PublicKeyAlgorithm != "ECDSA" || leaf.KeySize != 384 {
+		t.Errorf("unexpected key %s %d", leaf.PublicKeyAlgorithm, leaf.KeySize)
+	}
+	if leaf.IsCA || leaf.MaxPathLen != nil {
+		t.Errorf("leaf certificate should not be a CA")
+	}
+
+	root := certificates[1]
+	if !root.IsCA || root.MaxPathLen == nil || *root.MaxPathLen != 1 {
+		t.Errorf("unexpected basic constraints %v %v", root.IsCA, root.MaxPathLen)
+	}
+	if root.SubjectKeyId != "01:02:03:04" || leaf.AuthorityKeyId != "01:02:03:04" {
+		t.Errorf("unexpected key identifiers %q %q", root.SubjectKeyId, leaf.AuthorityKeyId)
+	}
+	if !reflect.DeepEqual(root.KeyUsage, []string{"keyCertSign", "cRLSign"}) {
+		t.Errorf("unexpected key usage %v", root.KeyUsage)
+	}
+}
+
+func TestParseX509CertificatesPemInvalid(t *testing.T) {
+	if certificates, err := parseX509CertificatesPem(""); err != nil || certificates != nil {
+		t.Errorf("expected no certificates and no error for an empty value, got %v %v", certificates, err)
+	}
+	if _, err := parseX509CertificatesPem("not a certificate"); err == nil {
+		t.Error("expected an error for a value without PEM blocks")
+	}
+	invalid := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte("invalid")}))
+	if _, err := parseX509CertificatesPem(invalid); err == nil {
+		t.Error("expected an error for an invalid certificate")
+	}
+}
@@ -48,6 +48,8 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/databasemigrationservice v1.38.4
 	github.com/aws/aws-sdk-go-v2/service/datasync v1.50.1
 	github.com/aws/aws-sdk-go-v2/service/dax v1.19.4
+	github.com/aws/aws-sdk-go-v2/service/detective v1.38.14
+	github.com/aws/aws-sdk-go-v2/service/directconnect v1.38.16
 	github.com/aws/aws-sdk-go-v2/service/directoryservice v1.24.4
 	github.com/aws/aws-sdk-go-v2/service/dlm v1.24.4
 	github.com/aws/aws-sdk-go-v2/service/docdb v1.34.0
@@ -56,9 +58,9 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/ec2 v1.236.0
 	github.com/aws/aws-sdk-go-v2/service/ecr v1.27.4
 	github.com/aws/aws-sdk-go-v2/service/ecrpublic v1.23.4
-	github.com/aws/aws-sdk-go-v2/service/ecs v1.41.7
+	github.com/aws/aws-sdk-go-v2/service/ecs v1.77.0
 	github.com/aws/aws-sdk-go-v2/service/efs v1.28.4
-	github.com/aws/aws-sdk-go-v2/service/eks v1.42.1
+	github.com/aws/aws-sdk-go-v2/service/eks v1.80.1
 	github.com/aws/aws-sdk-go-v2/service/elasticache v1.38.1
 	github.com/aws/aws-sdk-go-v2/service/elasticbeanstalk v1.23.4
 	github.com/aws/aws-sdk-go-v2/service/elasticloadbalancing v1.24.4
@@ -72,10 +74,11 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/glacier v1.22.4
 	github.com/aws/aws-sdk-go-v2/service/globalaccelerator v1.23.1
 	github.com/aws/aws-sdk-go-v2/service/glue v1.78.0
-	github.com/aws/aws-sdk-go-v2/service/guardduty v1.41.1
+	github.com/aws/aws-sdk-go-v2/service/guardduty v1.74.2
 	github.com/aws/aws-sdk-go-v2/service/health v1.35.0
 	github.com/aws/aws-sdk-go-v2/service/iam v1.53.6
 	github.com/aws/aws-sdk-go-v2/service/identitystore v1.23.5
+	github.com/aws/aws-sdk-go-v2/service/imagebuilder v1.53.0
 	github.com/aws/aws-sdk-go-v2/service/inspector v1.21.4
 	github.com/aws/aws-sdk-go-v2/service/inspector2 v1.24.4
 	github.com/aws/aws-sdk-go-v2/service/iot v1.53.3
@@ -96,6 +99,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/mq v1.22.4
 	github.com/aws/aws-sdk-go-v2/service/neptune v1.31.6
 	github.com/aws/aws-sdk-go-v2/service/networkfirewall v1.38.5
+	github.com/aws/aws-sdk-go-v2/service/networkmanager v1.41.7
 	github.com/aws/aws-sdk-go-v2/service/oam v1.10.1
 	github.com/aws/aws-sdk-go-v2/service/opensearch v1.52.6
 	github.com/aws/aws-sdk-go-v2/service/organizations v1.46.2
@@ -112,6 +116,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/rolesanywhere v1.11.4
 	github.com/aws/aws-sdk-go-v2/service/route53 v1.62.1
 	github.com/aws/aws-sdk-go-v2/service/route53domains v1.23.4
+	github.com/aws/aws-sdk-go-v2/service/route53profiles v1.9.20
 	github.com/aws/aws-sdk-go-v2/service/route53resolver v1.27.4
 	github.com/aws/aws-sdk-go-v2/service/s3 v1.97.3
 	github.com/aws/aws-sdk-go-v2/service/s3control v1.44.4
@@ -119,6 +124,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/sagemaker v1.135.0
 	github.com/aws/aws-sdk-go-v2/service/savingsplans v1.23.3
 	github.com/aws/aws-sdk-go-v2/service/scheduler v1.8.8
+	github.com/aws/aws-sdk-go-v2/service/schemas v1.29.2
 	github.com/aws/aws-sdk-go-v2/service/secretsmanager v1.28.6
 	github.com/aws/aws-sdk-go-v2/service/securityhub v1.47.2
 	github.com/aws/aws-sdk-go-v2/service/securitylake v1.13.3
@@ -140,6 +146,8 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/synthetics v1.42.15
 	github.com/aws/aws-sdk-go-v2/service/timestreamwrite v1.25.9
 	github.com/aws/aws-sdk-go-v2/service/transfer v1.45.0
+	github.com/aws/aws-sdk-go-v2/service/verifiedpermissions v1.31.2
+	github.com/aws/aws-sdk-go-v2/service/vpclattice v1.20.9
 	github.com/aws/aws-sdk-go-v2/service/waf v1.20.4
 	github.com/aws/aws-sdk-go-v2/service/wafregional v1.21.4
 	github.com/aws/aws-sdk-go-v2/service/wafv2 v1.48.2
@@ -0,0 +1,2 @@
+pip3 install -r requirements.txt
+python3 main.py
\ No newline at end of file
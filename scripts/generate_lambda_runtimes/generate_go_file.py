@@ -0,0 +1,69 @@
+import subprocess
+
+
+def escape_string(input_string):
+    return input_string.replace("\"", "\\\"")
+
+
+def write_runtime(runtime, go_file):
+    go_file.write("""{
+""")
+    go_file.write("""Runtime: \"{0}\",
+""".format(escape_string(runtime["runtime"])))
+    go_file.write("""Name: \"{0}\",
+""".format(escape_string(runtime["name"])))
+    go_file.write("""OperatingSystem: \"{0}\",
+""".format(escape_string(runtime["operating_system"])))
+    go_file.write("""DeprecationDate: \"{0}\",
+""".format(runtime["deprecation_date"]))
+    go_file.write("""BlockFunctionCreateDate: \"{0}\",
+""".format(runtime["block_function_create_date"]))
+    go_file.write("""BlockFunctionUpdateDate: \"{0}\",
+""".format(runtime["block_function_update_date"]))
+    go_file.write("""},
+""")
+
+
+# The dev build ships a small subset of the catalog, with both deprecated and
+# supported runtimes, so the table still returns real data in dev builds and tests
+DEV_RUNTIMES = [
+    "java21",
+    "nodejs16.x",
+    "nodejs20.x",
+    "nodejs22.x",
+    "provided.al2023",
+    "python3.8",
+    "python3.12",
+    "python3.13",
+]
+
+
+def write_go_file(path, build_tag, catalog, runtimes):
+    with open(path, 'w') as go_file:
+        go_file.write("""//go:build {0}
+
+package aws
+
+func getLambdaRuntimeCatalog() LambdaRuntimeCatalog {{
+catalog := LambdaRuntimeCatalog{{
+""".format(build_tag))
+        go_file.write("""Version: \"{0}\",
+""".format(catalog["version"]))
+        go_file.write("""Source: \"{0}\",
+""".format(catalog["source"]))
+        go_file.write("""Runtimes: []LambdaRuntime{
+""")
+        for runtime in runtimes:
+            write_runtime(runtime, go_file)
+        go_file.write("""},
+}
+return catalog
+}
+""")
+    subprocess.run(["gofmt", "-w", path])
+
+
+def generate_go_file(catalog):
+    write_go_file('../../aws/lambda_runtimes.go', "!dev", catalog, catalog["runtimes"])
+    dev_runtimes = [runtime for runtime in catalog["runtimes"] if runtime["runtime"] in DEV_RUNTIMES]
+    write_go_file('../../aws/lambda_runtimes_dev.go', "dev", catalog, dev_runtimes)
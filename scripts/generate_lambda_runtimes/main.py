@@ -0,0 +1,12 @@
+from generate_go_file import generate_go_file
+from scrape_lambda_runtimes import scrape
+
+
+def main():
+    runtimes = scrape()
+    generate_go_file(runtimes)
+    print("Complete")
+
+
+if __name__ == '__main__':
+    main()
@@ -0,0 +1,61 @@
+#!/usr/bin/env python3
+
+from bs4 import BeautifulSoup
+from datetime import datetime
+import requests
+
+
+RUNTIMES_DOCUMENTATION_URL = "https://docs.aws.amazon.com/lambda/latest/dg/lambda-runtimes.html"
+
+
+def parse_date(value):
+    """Converts a date like "Apr 30, 2026" to 2026-04-30, or returns an empty string when no date is scheduled."""
+    value = " ".join(value.split())
+    for date_format in ("%b %d, %Y", "%B %d, %Y"):
+        try:
+            return datetime.strptime(value, date_format).strftime("%Y-%m-%d")
+        except ValueError:
+            pass
+    return ""
+
+
+def parse_table(table):
+    """Reads the rows of a runtimes table, whose columns are name, identifier, operating system, deprecation date, block function create and block function update."""
+    runtimes = []
+    for row in table.find_all("tr"):
+        cells = [" ".join(cell.get_text().split()) for cell in row.find_all("td")]
+        if len(cells) < 6:
+            continue
+        # Runtimes only available as container base images have no identifier
+        identifier = cells[1]
+        if identifier == "" or " " in identifier:
+            continue
+        runtimes.append({
+            "runtime": identifier,
+            "name": cells[0],
+            "operating_system": cells[2],
+            "deprecation_date": parse_date(cells[3]),
+            "block_function_create_date": parse_date(cells[4]),
+            "block_function_update_date": parse_date(cells[5]),
+        })
+    return runtimes
+
+
+def scrape():
+    html = requests.get(RUNTIMES_DOCUMENTATION_URL)
+    soup = BeautifulSoup(html.content, "html.parser")
+
+    runtimes = {}
+    for table in soup.find_all("table"):
+        headers = " ".join(header.get_text() for header in table.find_all("th")).lower()
+        if "identifier" not in headers or "deprecation" not in headers:
+            continue
+        for runtime in parse_table(table):
+            # Keep the first occurrence, the supported runtimes table comes first
+            runtimes.setdefault(runtime["runtime"], runtime)
+
+    return {
+        "version": datetime.utcnow().strftime("%Y-%m-%d"),
+        "source": RUNTIMES_DOCUMENTATION_URL,
+        "runtimes": sorted(runtimes.values(), key=lambda runtime: runtime["runtime"]),
+    }